@@ -122,6 +122,28 @@ const (
 	LabelObjectName = "object-name"
 )
 
+// Kubernetes event reasons
+const (
+	// EventReasonTriggerSucceeded is the reason of the event recorded when a trigger is executed successfully
+	EventReasonTriggerSucceeded = "TriggerSucceeded"
+	// EventReasonTriggerFailed is the reason of the event recorded when a trigger execution fails
+	EventReasonTriggerFailed = "TriggerFailed"
+	// EventReasonTriggerTimedOut is the reason of the event recorded when the policy of an executed trigger times out
+	EventReasonTriggerTimedOut = "TriggerTimedOut"
+	// EventReasonTriggerDryRun is the reason of the event recorded when a trigger is rendered in dry run mode
+	EventReasonTriggerDryRun = "TriggerDryRun"
+	// EventReasonValidationFailed is the reason of the event recorded when an event source fails the validation
+	EventReasonValidationFailed = "ValidationFailed"
+	// EventReasonEventBusDisconnected is the reason of the event recorded when the connection to the eventbus is lost
+	EventReasonEventBusDisconnected = "EventBusDisconnected"
+	// EventReasonEventBusReconnected is the reason of the event recorded when the connection to the eventbus is restored
+	EventReasonEventBusReconnected = "EventBusReconnected"
+	// EventReasonSensorReloaded is the reason of the event recorded when a sensor reloads its spec
+	EventReasonSensorReloaded = "SensorReloaded"
+	// EventReasonSensorReloadFailed is the reason of the event recorded when a sensor fails to reload its spec
+	EventReasonSensorReloadFailed = "SensorReloadFailed"
+)
+
 // various supported media types
 const (
 	MediaTypeJSON string = "application/json"
@@ -0,0 +1,47 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	exprlib "github.com/antonmedv/expr"
+	"github.com/antonmedv/expr/vm"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// CompileCondition compiles the condition expression of a resource. An empty condition compiles to nil.
+func CompileCondition(condition string) (*vm.Program, error) {
+	if condition == "" {
+		return nil, nil
+	}
+	return exprlib.Compile(condition, exprlib.Env(map[string]interface{}{}), exprlib.AllowUndefinedVariables(), exprlib.AsBool())
+}
+
+// EvaluateCondition evaluates the condition against the resource. A condition that fails to evaluate,
+// e.g. because the fields are not set yet, doesn't hold.
+func EvaluateCondition(program *vm.Program, obj *unstructured.Unstructured) (result bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = false
+		}
+	}()
+	out, err := exprlib.Run(program, obj.UnstructuredContent())
+	if err != nil {
+		return false
+	}
+	held, ok := out.(bool)
+	return ok && held
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestEvaluateCondition(t *testing.T) {
+	obj := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata": map[string]interface{}{
+				"namespace": "fake",
+				"name":      "test",
+			},
+			"status": map[string]interface{}{
+				"conditions": []interface{}{
+					map[string]interface{}{"type": "Available", "status": "False"},
+					map[string]interface{}{"type": "Ready", "status": "True"},
+				},
+			},
+		},
+	}
+
+	ready, err := CompileCondition(`any(status.conditions, {.type == "Ready" && .status == "True"})`)
+	assert.Nil(t, err)
+	assert.True(t, EvaluateCondition(ready, obj))
+
+	available, err := CompileCondition(`any(status.conditions, {.type == "Available" && .status == "True"})`)
+	assert.Nil(t, err)
+	assert.False(t, EvaluateCondition(available, obj))
+
+	missing, err := CompileCondition(`spec.replicas > 1`)
+	assert.Nil(t, err)
+	assert.False(t, EvaluateCondition(missing, obj))
+
+	empty, err := CompileCondition("")
+	assert.Nil(t, err)
+	assert.Nil(t, empty)
+
+	_, err = CompileCondition("status.phase ==")
+	assert.NotNil(t, err)
+}
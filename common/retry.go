@@ -42,7 +42,7 @@ func IsRetryableKubeAPIError(err error) bool {
 	return true
 }
 
-// GetConnectionBackoff returns a connection backoff option
+// GetConnectionBackoff returns a connection backoff option. The fields that are not set default to DefaultRetry.
 func GetConnectionBackoff(backoff *apicommon.Backoff) *wait.Backoff {
 	result := wait.Backoff{
 		Duration: DefaultRetry.Duration,
@@ -53,10 +53,12 @@ func GetConnectionBackoff(backoff *apicommon.Backoff) *wait.Backoff {
 	if backoff == nil {
 		return &result
 	}
-	if &backoff.Duration != nil {
+	if backoff.Duration > 0 {
 		result.Duration = backoff.Duration
 	}
-	result.Factor, _ = backoff.Factor.Float64()
+	if len(backoff.Factor.Value) > 0 {
+		result.Factor, _ = backoff.Factor.Float64()
+	}
 	if backoff.Jitter != nil {
 		result.Jitter, _ = backoff.Jitter.Float64()
 	}
@@ -17,7 +17,9 @@ package common
 
 import (
 	"testing"
+	"time"
 
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
 	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
 	"github.com/stretchr/testify/assert"
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -36,3 +38,18 @@ func TestRetryableKubeAPIError(t *testing.T) {
 	assert.False(t, IsRetryableKubeAPIError(errInvalid))
 	assert.False(t, IsRetryableKubeAPIError(errMethodNotSupported))
 }
+
+func TestGetConnectionBackoff(t *testing.T) {
+	backoff := GetConnectionBackoff(&apicommon.Backoff{Steps: 3})
+	assert.Equal(t, DefaultRetry.Duration, backoff.Duration)
+	assert.Equal(t, DefaultRetry.Factor, backoff.Factor)
+	assert.Equal(t, 3, backoff.Steps)
+
+	backoff = GetConnectionBackoff(&apicommon.Backoff{
+		Duration: time.Second,
+		Factor:   apicommon.Amount{Value: []byte("2")},
+	})
+	assert.Equal(t, time.Second, backoff.Duration)
+	assert.Equal(t, 2.0, backoff.Factor)
+	assert.Equal(t, DefaultRetry.Steps, backoff.Steps)
+}
@@ -26,13 +26,19 @@ import (
 	"net/http"
 	"os"
 	"strings"
+	"sync"
 
 	"github.com/pkg/errors"
+	"go.uber.org/zap"
 	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/tools/record"
 )
 
 // GetClientConfig return rest config, if path not specified, assume in cluster config
@@ -43,6 +49,52 @@ func GetClientConfig(kubeconfig string) (*rest.Config, error) {
 	return rest.InClusterConfig()
 }
 
+// NewEventRecorder returns a recorder that writes Kubernetes events for the objects registered in the scheme.
+// If the service account is not allowed to write events, a warning is logged once and the events are dropped.
+func NewEventRecorder(kubeClient kubernetes.Interface, scheme *runtime.Scheme, component string, logger *zap.SugaredLogger) record.EventRecorder {
+	broadcaster := record.NewBroadcaster()
+	broadcaster.StartRecordingToSink(&eventSink{
+		EventSink: &typedcorev1.EventSinkImpl{Interface: kubeClient.CoreV1().Events("")},
+		logger:    logger,
+	})
+	return broadcaster.NewRecorder(scheme, v1.EventSource{Component: component})
+}
+
+// eventSink drops the events the service account is not allowed to write, instead of logging every rejected event.
+type eventSink struct {
+	record.EventSink
+	logger *zap.SugaredLogger
+	once   sync.Once
+}
+
+// Create creates the event
+func (s *eventSink) Create(event *v1.Event) (*v1.Event, error) {
+	result, err := s.EventSink.Create(event)
+	return s.check(event, result, err)
+}
+
+// Update updates the event
+func (s *eventSink) Update(event *v1.Event) (*v1.Event, error) {
+	result, err := s.EventSink.Update(event)
+	return s.check(event, result, err)
+}
+
+// Patch patches the event
+func (s *eventSink) Patch(event *v1.Event, data []byte) (*v1.Event, error) {
+	result, err := s.EventSink.Patch(event, data)
+	return s.check(event, result, err)
+}
+
+func (s *eventSink) check(event, result *v1.Event, err error) (*v1.Event, error) {
+	if !apierrors.IsForbidden(err) {
+		return result, err
+	}
+	s.once.Do(func() {
+		s.logger.Warnw("not allowed to record Kubernetes events, grant the service account the permission to create and patch events", "error", err)
+	})
+	return event, nil
+}
+
 // SendSuccessResponse sends http success response
 func SendSuccessResponse(writer http.ResponseWriter, response string) {
 	writer.WriteHeader(http.StatusOK)
@@ -20,7 +20,14 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 type fakeHttpWriter struct {
@@ -59,3 +66,34 @@ func TestFormatEndpoint(t *testing.T) {
 func TestFormattedURL(t *testing.T) {
 	assert.Equal(t, "test-url/fake", FormattedURL("test-url", "fake"))
 }
+
+type forbiddenEventSink struct {
+	calls int
+}
+
+func (s *forbiddenEventSink) Create(event *corev1.Event) (*corev1.Event, error) {
+	s.calls++
+	return nil, apierrors.NewForbidden(schema.GroupResource{Resource: "events"}, event.Name, errors.New("fake"))
+}
+
+func (s *forbiddenEventSink) Update(event *corev1.Event) (*corev1.Event, error) {
+	return s.Create(event)
+}
+
+func (s *forbiddenEventSink) Patch(event *corev1.Event, data []byte) (*corev1.Event, error) {
+	return s.Create(event)
+}
+
+func TestEventSink(t *testing.T) {
+	core, logs := observer.New(zap.WarnLevel)
+	fake := &forbiddenEventSink{}
+	sink := &eventSink{EventSink: fake, logger: zap.New(core).Sugar()}
+	event := &corev1.Event{ObjectMeta: metav1.ObjectMeta{Name: "fake"}}
+	for i := 0; i < 3; i++ {
+		result, err := sink.Create(event)
+		assert.NoError(t, err)
+		assert.Equal(t, event, result)
+	}
+	assert.Equal(t, 3, fake.calls)
+	assert.Equal(t, 1, logs.Len())
+}
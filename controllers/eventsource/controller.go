@@ -2,6 +2,8 @@ package eventsource
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"go.uber.org/zap"
 	"k8s.io/apimachinery/pkg/api/equality"
@@ -73,6 +75,11 @@ func (r *reconciler) reconcile(ctx context.Context, eventSource *v1alpha1.EventS
 	r.addFinalizer(eventSource)
 
 	eventSource.Status.InitConditions()
+	if len(eventSource.Spec.SuspendedEvents) > 0 {
+		eventSource.Status.MarkEventsSuspended(fmt.Sprintf("Suspended events: %s", strings.Join(eventSource.Spec.SuspendedEvents, ", ")))
+	} else {
+		eventSource.Status.MarkActive()
+	}
 	err := ValidateEventSource(eventSource)
 	if err != nil {
 		log.Desugar().Error("validation error", zap.Error(err))
@@ -177,5 +177,11 @@ func TestReconcile(t *testing.T) {
 		err = r.reconcile(ctx, testEventSource)
 		assert.NoError(t, err)
 		assert.True(t, testEventSource.Status.IsReady())
+
+		testEventSource.Spec.SuspendedEvents = []string{"test"}
+		err = r.reconcile(ctx, testEventSource)
+		assert.NoError(t, err)
+		assert.False(t, testEventSource.Status.IsReady())
+		assert.Equal(t, "EventsSuspended", testEventSource.Status.GetCondition(v1alpha1.EventSourceConditionActive).Reason)
 	})
 }
@@ -54,6 +54,13 @@ func ValidateEventSource(eventSource *v1alpha1.EventSource) error {
 		return errors.New("event sources with rolling update and recreate update strategy can not put together")
 	}
 
+	for _, eName := range eventSource.Spec.SuspendedEvents {
+		if _, ok := eventNames[eName]; !ok {
+			eventSource.Status.MarkSourcesNotProvided("InvalidSuspendedEvents", fmt.Sprintf("suspended event \"%s\" not found", eName))
+			return errors.Errorf("suspended event \"%s\" not found in the spec", eName)
+		}
+	}
+
 	eventSource.Status.MarkSourcesProvided()
 	return nil
 }
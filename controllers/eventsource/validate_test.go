@@ -39,4 +39,17 @@ func TestValidate(t *testing.T) {
 		assert.Error(t, err)
 		assert.Equal(t, "more than one \"test\" found in the spec", err.Error())
 	})
+
+	t.Run("validate suspended events", func(t *testing.T) {
+		testEventSource := fakeEmptyEventSource()
+		testEventSource.Spec.Calendar = fakeCalendarEventSourceMap("test")
+		testEventSource.Spec.SuspendedEvents = []string{"test"}
+		err := ValidateEventSource(testEventSource)
+		assert.NoError(t, err)
+
+		testEventSource.Spec.SuspendedEvents = []string{"unknown"}
+		err = ValidateEventSource(testEventSource)
+		assert.Error(t, err)
+		assert.Equal(t, "suspended event \"unknown\" not found in the spec", err.Error())
+	})
 }
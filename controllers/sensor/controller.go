@@ -30,6 +30,7 @@ import (
 
 	"github.com/argoproj/argo-events/common"
 	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/validation"
 )
 
 const (
@@ -89,20 +90,25 @@ func (r *reconciler) reconcile(ctx context.Context, sensor *v1alpha1.Sensor) err
 	r.addFinalizer(sensor)
 
 	sensor.Status.InitConditions()
-	err := ValidateSensor(sensor)
+	err := validation.ValidateSensor(sensor)
 	if err != nil {
 		log.Error(err, "validation error")
 	}
 	args := &AdaptorArgs{
 		Image:  r.sensorImage,
 		Sensor: sensor,
-		Labels: map[string]string{
-			"controller":           "sensor-controller",
-			common.LabelSensorName: sensor.Name,
-			common.LabelOwnerName:  sensor.Name,
-		},
+		Labels: deploymentLabels(sensor),
 	}
-	return Reconcile(r.client, args, log)
+	if err := Reconcile(r.client, args, log); err != nil {
+		return err
+	}
+	// The sensor pod applies the suspend flag in place, or once the deployment is rolled if it can't watch sensors.
+	if sensor.Spec.Suspend {
+		sensor.Status.MarkSuspended()
+	} else {
+		sensor.Status.MarkActive()
+	}
+	return nil
 }
 
 func (r *reconciler) addFinalizer(s *v1alpha1.Sensor) {
@@ -129,3 +135,12 @@ func (r *reconciler) needsUpdate(old, new *v1alpha1.Sensor) bool {
 	}
 	return false
 }
+
+// deploymentLabels returns the labels of the deployment of the sensor.
+func deploymentLabels(sensor *v1alpha1.Sensor) map[string]string {
+	return map[string]string{
+		"controller":           "sensor-controller",
+		common.LabelSensorName: sensor.Name,
+		common.LabelOwnerName:  sensor.Name,
+	}
+}
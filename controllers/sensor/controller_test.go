@@ -25,6 +25,7 @@ import (
 	"k8s.io/client-go/kubernetes/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	"github.com/argoproj/argo-events/common"
 	"github.com/argoproj/argo-events/common/logging"
 	eventbusv1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
 	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
@@ -66,6 +67,19 @@ func TestReconcile(t *testing.T) {
 		err = r.reconcile(ctx, sensorObj)
 		assert.NoError(t, err)
 		assert.True(t, sensorObj.Status.IsReady())
+
+		deploy, err := getDeployment(ctx, cl, &AdaptorArgs{Sensor: sensorObj, Labels: deploymentLabels(sensorObj)})
+		assert.NoError(t, err)
+
+		suspended := sensorObj.DeepCopy()
+		suspended.Spec.Suspend = true
+		err = r.reconcile(ctx, suspended)
+		assert.NoError(t, err)
+		assert.False(t, suspended.Status.IsReady())
+		assert.False(t, suspended.Status.GetCondition(v1alpha1.SensorConditionActive).IsTrue())
+		suspendedDeploy, err := getDeployment(ctx, cl, &AdaptorArgs{Sensor: suspended, Labels: deploymentLabels(suspended)})
+		assert.NoError(t, err)
+		assert.NotEqual(t, deploy.Annotations[common.AnnotationResourceSpecHash], suspendedDeploy.Annotations[common.AnnotationResourceSpecHash])
 	})
 }
 
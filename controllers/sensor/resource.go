@@ -26,6 +26,7 @@ import (
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
 	appv1 "k8s.io/api/apps/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -72,7 +73,7 @@ func Reconcile(client client.Client, args *AdaptorArgs, logger *zap.SugaredLogge
 		logger.Errorw("event bus is not in ready status", "eventBusName", eventBusName, "error", err)
 		return errors.New("eventbus not ready")
 	}
-	expectedDeploy, err := buildDeployment(args, eventBus)
+	expectedDeploy, err := buildDeployment(args, eventBus, canWatchSensors(ctx, client, sensor, logger))
 	if err != nil {
 		sensor.Status.MarkDeployFailed("BuildDeploymentSpecFailed", "Failed to build Deployment spec.")
 		logger.Errorw("failed to build deployment spec", "error", err)
@@ -126,7 +127,44 @@ func getDeployment(ctx context.Context, cl client.Client, args *AdaptorArgs) (*a
 	return nil, apierrors.NewNotFound(schema.GroupResource{}, "")
 }
 
-func buildDeployment(args *AdaptorArgs, eventBus *eventbusv1alpha1.EventBus) (*appv1.Deployment, error) {
+// canWatchSensors checks whether the service account of the sensor pod is allowed to get, list and watch sensors
+// in the namespace of the sensor, in which case the pod applies the sensor spec changes in place.
+func canWatchSensors(ctx context.Context, cl client.Client, sensor *v1alpha1.Sensor, logger *zap.SugaredLogger) bool {
+	serviceAccountName := sensor.Spec.Template.ServiceAccountName
+	if serviceAccountName == "" {
+		serviceAccountName = "default"
+	}
+	for _, verb := range []string{"get", "list", "watch"} {
+		review := &authorizationv1.LocalSubjectAccessReview{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: sensor.Namespace,
+			},
+			Spec: authorizationv1.SubjectAccessReviewSpec{
+				User:   fmt.Sprintf("system:serviceaccount:%s:%s", sensor.Namespace, serviceAccountName),
+				Groups: []string{"system:serviceaccounts", fmt.Sprintf("system:serviceaccounts:%s", sensor.Namespace)},
+				ResourceAttributes: &authorizationv1.ResourceAttributes{
+					Namespace: sensor.Namespace,
+					Verb:      verb,
+					Group:     v1alpha1.SchemeGroupVersion.Group,
+					Resource:  "sensors",
+				},
+			},
+		}
+		if err := cl.Create(ctx, review); err != nil {
+			logger.Warnw("failed to review the sensor permissions of the service account, the deployment is rolled on sensor changes", "serviceAccountName", serviceAccountName, "error", err)
+			return false
+		}
+		if !review.Status.Allowed {
+			logger.Infow("service account can't watch sensors, the deployment is rolled on sensor changes", "serviceAccountName", serviceAccountName, "verb", verb)
+			return false
+		}
+	}
+	return true
+}
+
+// buildDeployment builds the sensor deployment. When the sensor pod can watch sensors, the sensor object is left out
+// of the spec hash, so that spec changes are applied in place by the pod instead of rolling the deployment.
+func buildDeployment(args *AdaptorArgs, eventBus *eventbusv1alpha1.EventBus, canWatchSensors bool) (*appv1.Deployment, error) {
 	deploymentSpec, err := buildDeploymentSpec(args)
 	if err != nil {
 		return nil, err
@@ -135,6 +173,7 @@ func buildDeployment(args *AdaptorArgs, eventBus *eventbusv1alpha1.EventBus) (*a
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace: args.Sensor.Namespace,
 			Name:      args.Sensor.Name,
+			UID:       args.Sensor.UID,
 		},
 		Spec: args.Sensor.Spec,
 	}
@@ -145,8 +184,7 @@ func buildDeployment(args *AdaptorArgs, eventBus *eventbusv1alpha1.EventBus) (*a
 	encodedSensorSpec := base64.StdEncoding.EncodeToString(sensorBytes)
 	envVars := []corev1.EnvVar{
 		{
-			Name:  common.EnvVarSensorObject,
-			Value: encodedSensorSpec,
+			Name: common.EnvVarSensorObject,
 		},
 		{
 			Name:  common.EnvVarEventBusSubject,
@@ -229,12 +267,27 @@ func buildDeployment(args *AdaptorArgs, eventBus *eventbusv1alpha1.EventBus) (*a
 		},
 		Spec: *deploymentSpec,
 	}
+	if !canWatchSensors {
+		setSensorObjectEnv(deployment, encodedSensorSpec)
+	}
 	if err := controllerscommon.SetObjectMeta(args.Sensor, deployment, v1alpha1.SchemaGroupVersionKind); err != nil {
 		return nil, err
 	}
+	if canWatchSensors {
+		setSensorObjectEnv(deployment, encodedSensorSpec)
+	}
 	return deployment, nil
 }
 
+func setSensorObjectEnv(deployment *appv1.Deployment, encodedSensorSpec string) {
+	containerEnvs := deployment.Spec.Template.Spec.Containers[0].Env
+	for i := range containerEnvs {
+		if containerEnvs[i].Name == common.EnvVarSensorObject {
+			containerEnvs[i].Value = encodedSensorSpec
+		}
+	}
+}
+
 func buildDeploymentSpec(args *AdaptorArgs) (*appv1.DeploymentSpec, error) {
 	replicas := int32(1)
 	sensorContainer := corev1.Container{
@@ -305,6 +358,37 @@ func secretVolumes(sensor *v1alpha1.Sensor) ([]corev1.Volume, []corev1.VolumeMou
 				resultMounts = append(resultMounts, mount)
 			}
 		}
+		if t.AWSSQS != nil {
+			if t.AWSSQS.AccessKey != nil {
+				vol, mount := common.GenerateSecretVolumeSpecs(t.AWSSQS.AccessKey)
+				resultVolumes = append(resultVolumes, vol)
+				resultMounts = append(resultMounts, mount)
+			}
+			if t.AWSSQS.SecretKey != nil {
+				vol, mount := common.GenerateSecretVolumeSpecs(t.AWSSQS.SecretKey)
+				resultVolumes = append(resultVolumes, vol)
+				resultMounts = append(resultMounts, mount)
+			}
+		}
+		if t.AWSSNS != nil {
+			if t.AWSSNS.AccessKey != nil {
+				vol, mount := common.GenerateSecretVolumeSpecs(t.AWSSNS.AccessKey)
+				resultVolumes = append(resultVolumes, vol)
+				resultMounts = append(resultMounts, mount)
+			}
+			if t.AWSSNS.SecretKey != nil {
+				vol, mount := common.GenerateSecretVolumeSpecs(t.AWSSNS.SecretKey)
+				resultVolumes = append(resultVolumes, vol)
+				resultMounts = append(resultMounts, mount)
+			}
+		}
+		if t.GCPPubSub != nil {
+			if t.GCPPubSub.CredentialSecret != nil {
+				vol, mount := common.GenerateSecretVolumeSpecs(t.GCPPubSub.CredentialSecret)
+				resultVolumes = append(resultVolumes, vol)
+				resultMounts = append(resultMounts, mount)
+			}
+		}
 		if t.HTTP != nil && t.HTTP.BasicAuth != nil {
 			if t.HTTP.BasicAuth.Password != nil {
 				vol, mount := common.GenerateSecretVolumeSpecs(t.HTTP.BasicAuth.Password)
@@ -331,6 +415,37 @@ func secretVolumes(sensor *v1alpha1.Sensor) ([]corev1.Volume, []corev1.VolumeMou
 				resultMounts = append(resultMounts, mount)
 			}
 		}
+		if t.Email != nil {
+			if t.Email.Username != nil {
+				vol, mount := common.GenerateSecretVolumeSpecs(t.Email.Username)
+				resultVolumes = append(resultVolumes, vol)
+				resultMounts = append(resultMounts, mount)
+			}
+			if t.Email.Password != nil {
+				vol, mount := common.GenerateSecretVolumeSpecs(t.Email.Password)
+				resultVolumes = append(resultVolumes, vol)
+				resultMounts = append(resultMounts, mount)
+			}
+		}
+		if t.ObjectStorage != nil && t.ObjectStorage.S3 != nil {
+			if t.ObjectStorage.S3.AccessKey != nil {
+				vol, mount := common.GenerateSecretVolumeSpecs(t.ObjectStorage.S3.AccessKey)
+				resultVolumes = append(resultVolumes, vol)
+				resultMounts = append(resultMounts, mount)
+			}
+			if t.ObjectStorage.S3.SecretKey != nil {
+				vol, mount := common.GenerateSecretVolumeSpecs(t.ObjectStorage.S3.SecretKey)
+				resultVolumes = append(resultVolumes, vol)
+				resultMounts = append(resultMounts, mount)
+			}
+		}
+		if t.Redis != nil {
+			if t.Redis.Password != nil {
+				vol, mount := common.GenerateSecretVolumeSpecs(t.Redis.Password)
+				resultVolumes = append(resultVolumes, vol)
+				resultMounts = append(resultMounts, mount)
+			}
+		}
 		var source *v1alpha1.ArtifactLocation
 		if t.ArgoWorkflow != nil && t.ArgoWorkflow.Source != nil {
 			source = t.ArgoWorkflow.Source
@@ -27,6 +27,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	"github.com/argoproj/argo-events/common"
 	"github.com/argoproj/argo-events/common/logging"
 	eventbusv1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
 	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
@@ -164,7 +165,7 @@ func Test_BuildDeployment(t *testing.T) {
 				Sensor: sObj,
 				Labels: testLabels,
 			}
-			deployment, err := buildDeployment(args, fakeEventBus)
+			deployment, err := buildDeployment(args, fakeEventBus, true)
 			assert.Nil(t, err)
 			assert.NotNil(t, deployment)
 			volumes := deployment.Spec.Template.Spec.Volumes
@@ -179,6 +180,27 @@ func Test_BuildDeployment(t *testing.T) {
 			assert.True(t, hasAuthVolume)
 		}
 	})
+
+	t.Run("test spec hash leaves out the sensor object if the pod can watch sensors", func(t *testing.T) {
+		deployment, err := buildDeployment(&AdaptorArgs{Image: testImage, Sensor: sensorObj, Labels: testLabels}, fakeEventBus, true)
+		assert.Nil(t, err)
+		updatedSensor := sensorObj.DeepCopy()
+		updatedSensor.Spec.Dependencies[0].EventName = "updated"
+		updated, err := buildDeployment(&AdaptorArgs{Image: testImage, Sensor: updatedSensor, Labels: testLabels}, fakeEventBus, true)
+		assert.Nil(t, err)
+		assert.Equal(t, deployment.Annotations[common.AnnotationResourceSpecHash], updated.Annotations[common.AnnotationResourceSpecHash])
+		assert.NotEqual(t, deployment.Spec.Template.Spec.Containers[0].Env, updated.Spec.Template.Spec.Containers[0].Env)
+	})
+
+	t.Run("test spec hash covers the sensor object if the pod can't watch sensors", func(t *testing.T) {
+		deployment, err := buildDeployment(&AdaptorArgs{Image: testImage, Sensor: sensorObj, Labels: testLabels}, fakeEventBus, false)
+		assert.Nil(t, err)
+		updatedSensor := sensorObj.DeepCopy()
+		updatedSensor.Spec.Dependencies[0].EventName = "updated"
+		updated, err := buildDeployment(&AdaptorArgs{Image: testImage, Sensor: updatedSensor, Labels: testLabels}, fakeEventBus, false)
+		assert.Nil(t, err)
+		assert.NotEqual(t, deployment.Annotations[common.AnnotationResourceSpecHash], updated.Annotations[common.AnnotationResourceSpecHash])
+	})
 }
 
 func TestResourceReconcile(t *testing.T) {
@@ -18,7 +18,8 @@ type Driver interface {
 	// Parameter - dependencyExpr, example: "(dep1 || dep2) && dep3"
 	// Parameter - dependencies, array of dependencies information
 	// Parameter - filter, a function used to filter the message
-	// Parameter - action, a function to be triggered after all conditions meet
+	// Parameter - action, a function to be triggered after all conditions meet. It is invoked on a single goroutine fed by a
+	// bounded queue, so it should hand off the work instead of executing the triggers itself
 	SubscribeEventSources(ctx context.Context, conn Connection, closeCh <-chan struct{}, dependencyExpr string, dependencies []Dependency, filter func(string, cloudevents.Event) bool, action func(map[string]cloudevents.Event)) error
 
 	// Publish a message
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/Knetic/govaluate"
@@ -18,6 +19,10 @@ import (
 	eventbusv1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
 )
 
+// actionQueueSize is the number of resolved dependency sets waiting for the action before the subscription stops
+// taking messages
+const actionQueueSize = 32
+
 type natsStreamingConnection struct {
 	natsConn *nats.Conn
 	stanConn stan.Conn
@@ -140,10 +145,17 @@ func (n *natsStreaming) SubscribeEventSources(ctx context.Context, conn Connecti
 	if !ok {
 		return errors.New("not a NATS streaming connection")
 	}
+
+	// The action runs on its own goroutine, fed by a bounded queue, so that a slow action doesn't hold the
+	// subscription callback. Once the queue is full the callback waits for room, and MaxInflight caps the number
+	// of unacknowledged messages the server delivers in the meantime.
+	queue := newActionQueue(action, actionQueueSize)
+	defer queue.close()
+
 	// use clientID as durable name?
 	durableName := n.clientID
 	sub, err := nsc.stanConn.Subscribe(n.subject, func(m *stan.Msg) {
-		n.processEventSourceMsg(m, msgHolder, filter, action, log)
+		n.processEventSourceMsg(m, msgHolder, filter, queue.push, log)
 	}, stan.DurableName(durableName),
 		stan.SetManualAckMode(),
 		stan.StartAt(pb.StartPosition_LastReceived),
@@ -253,12 +265,61 @@ func (n *natsStreaming) processEventSourceMsg(m *stan.Msg, msgHolder *eventSourc
 	for k, v := range msgHolder.msgs {
 		messages[k] = *v.event
 	}
+	msgHolder.reset(depName)
+	_ = m.Ack()
+
 	log.Debugf("Triggering actions for client %s", n.clientID)
+	// The action is queued, this only blocks while the queue is full, which holds back the delivery of the next
+	// messages on purpose instead of piling them up in memory.
+	action(messages)
+}
 
-	go action(messages)
+// actionQueue runs the action on its own goroutine for the resolved dependencies queued by the subscription callback
+type actionQueue struct {
+	action func(map[string]cloudevents.Event)
+	queue  chan map[string]cloudevents.Event
+	done   chan struct{}
 
-	msgHolder.reset(depName)
-	_ = m.Ack()
+	lock   sync.RWMutex
+	closed bool
+}
+
+func newActionQueue(action func(map[string]cloudevents.Event), size int) *actionQueue {
+	q := &actionQueue{
+		action: action,
+		queue:  make(chan map[string]cloudevents.Event, size),
+		done:   make(chan struct{}),
+	}
+	go q.run()
+	return q
+}
+
+func (q *actionQueue) run() {
+	defer close(q.done)
+	for messages := range q.queue {
+		q.action(messages)
+	}
+}
+
+// push queues the messages, waiting for room while the queue is full. The messages are already acknowledged,
+// so the action is run in place once the queue is closed rather than dropping them.
+func (q *actionQueue) push(messages map[string]cloudevents.Event) {
+	q.lock.RLock()
+	defer q.lock.RUnlock()
+	if q.closed {
+		q.action(messages)
+		return
+	}
+	q.queue <- messages
+}
+
+// close waits for the action to run for the queued messages.
+func (q *actionQueue) close() {
+	q.lock.Lock()
+	q.closed = true
+	close(q.queue)
+	q.lock.Unlock()
+	<-q.done
 }
 
 // eventSourceMessage is used by messageHolder to hold the latest message
@@ -0,0 +1,35 @@
+package driver
+
+import (
+	"sync"
+	"testing"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestActionQueue(t *testing.T) {
+	var lock sync.Mutex
+	var ran []string
+	release := make(chan struct{})
+	action := func(messages map[string]cloudevents.Event) {
+		<-release
+		lock.Lock()
+		defer lock.Unlock()
+		for name := range messages {
+			ran = append(ran, name)
+		}
+	}
+
+	q := newActionQueue(action, 2)
+	// the first push is taken by the action, the next ones fill the queue without blocking on the action
+	for _, name := range []string{"dep-1", "dep-2", "dep-3"} {
+		q.push(map[string]cloudevents.Event{name: cloudevents.NewEvent()})
+	}
+	close(release)
+
+	// closing the queue runs the queued actions, and the actions pushed afterwards run in place
+	q.close()
+	q.push(map[string]cloudevents.Event{"dep-4": cloudevents.NewEvent()})
+	assert.Equal(t, []string{"dep-1", "dep-2", "dep-3", "dep-4"}, ran)
+}
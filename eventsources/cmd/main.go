@@ -7,6 +7,7 @@ import (
 	"os"
 
 	"go.uber.org/zap"
+	"k8s.io/client-go/kubernetes"
 	"sigs.k8s.io/controller-runtime/pkg/manager/signals"
 
 	"github.com/argoproj/argo-events/common"
@@ -14,6 +15,7 @@ import (
 	"github.com/argoproj/argo-events/eventsources"
 	eventbusv1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
 	v1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventsource/v1alpha1"
+	eventsourcescheme "github.com/argoproj/argo-events/pkg/client/eventsource/clientset/versioned/scheme"
 )
 
 func main() {
@@ -53,7 +55,15 @@ func main() {
 		logger.Fatal("required environment variable 'POD_NAME' not defined")
 	}
 
-	adaptor := eventsources.NewEventSourceAdaptor(eventSource, busConfig, ebSubject, hostname)
+	kubeConfig, _ := os.LookupEnv(common.EnvVarKubeConfig)
+	restConfig, err := common.GetClientConfig(kubeConfig)
+	if err != nil {
+		logger.Desugar().Fatal("failed to get kubeconfig", zap.Error(err))
+	}
+	kubeClient := kubernetes.NewForConfigOrDie(restConfig)
+	recorder := common.NewEventRecorder(kubeClient, eventsourcescheme.Scheme, "eventsource", logger)
+
+	adaptor := eventsources.NewEventSourceAdaptor(eventSource, busConfig, ebSubject, hostname, recorder)
 	logger = logger.With(logging.LabelEventSourceName, eventSource.Name)
 	ctx := logging.WithLogger(context.Background(), logger)
 	stopCh := signals.SetupSignalHandler()
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/argoproj/argo-events/common"
 	"github.com/argoproj/argo-events/common/logging"
 	"github.com/argoproj/argo-events/eventbus"
 	eventbusdriver "github.com/argoproj/argo-events/eventbus/driver"
@@ -39,6 +40,8 @@ import (
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
 )
 
 // EventingServer is the server API for Eventing service.
@@ -230,20 +233,30 @@ type EventSourceAdaptor struct {
 	eventBusConfig  *eventbusv1alpha1.BusConfig
 	eventBusSubject string
 	hostname        string
+	recorder        record.EventRecorder
 
 	eventBusConn eventbusdriver.Connection
 }
 
 // NewEventSourceAdaptor returns a new EventSourceAdaptor
-func NewEventSourceAdaptor(eventSource *v1alpha1.EventSource, eventBusConfig *eventbusv1alpha1.BusConfig, eventBusSubject, hostname string) *EventSourceAdaptor {
+func NewEventSourceAdaptor(eventSource *v1alpha1.EventSource, eventBusConfig *eventbusv1alpha1.BusConfig, eventBusSubject, hostname string, recorder record.EventRecorder) *EventSourceAdaptor {
 	return &EventSourceAdaptor{
 		eventSource:     eventSource,
 		eventBusConfig:  eventBusConfig,
 		eventBusSubject: eventBusSubject,
 		hostname:        hostname,
+		recorder:        recorder,
 	}
 }
 
+// recordEvent records a Kubernetes event against the event source.
+func (e *EventSourceAdaptor) recordEvent(eventType, reason, messageFmt string, args ...interface{}) {
+	if e.recorder == nil {
+		return
+	}
+	e.recorder.Eventf(e.eventSource, eventType, reason, messageFmt, args...)
+}
+
 // Start function
 func (e *EventSourceAdaptor) Start(ctx context.Context, stopCh <-chan struct{}) error {
 	logger := logging.FromContext(ctx).Desugar()
@@ -267,6 +280,7 @@ func (e *EventSourceAdaptor) Start(ctx context.Context, stopCh <-chan struct{})
 	go func() {
 		logger.Info("starting eventbus connection daemon...")
 		ticker := time.NewTicker(5 * time.Second)
+		disconnected := false
 		for {
 			select {
 			case <-cctx.Done():
@@ -275,24 +289,40 @@ func (e *EventSourceAdaptor) Start(ctx context.Context, stopCh <-chan struct{})
 			case <-ticker.C:
 				if e.eventBusConn == nil || e.eventBusConn.IsClosed() {
 					logger.Info("NATS connection lost, reconnecting...")
+					if !disconnected {
+						disconnected = true
+						e.recordEvent(corev1.EventTypeWarning, common.EventReasonEventBusDisconnected, "Lost the eventbus connection")
+					}
 					e.eventBusConn, err = driver.Connect()
 					if err != nil {
 						logger.Error("failed to reconnect to eventbus", zap.Error(err))
 						continue
 					}
 					logger.Info("reconnected the NATS streaming server...")
+					disconnected = false
+					e.recordEvent(corev1.EventTypeNormal, common.EventReasonEventBusReconnected, "Reconnected the eventbus")
 				}
 			}
 		}
 	}()
 
+	suspendedEvents := make(map[string]bool)
+	for _, eventName := range e.eventSource.Spec.SuspendedEvents {
+		suspendedEvents[eventName] = true
+	}
 	for _, ss := range servers {
 		for _, server := range ss {
+			if suspendedEvents[server.GetEventName()] {
+				logger.Info("event is suspended, skipping it", zap.Any(logging.LabelEventName, server.GetEventName()),
+					zap.Any(logging.LabelEventSourceType, server.GetEventSourceType()))
+				continue
+			}
 			// Validation has been done in eventsource-controller, it's harmless to do it again here.
 			err := server.ValidateEventSource(cctx)
 			if err != nil {
 				logger.Error("Validation failed", zap.Error(err), zap.Any(logging.LabelEventName,
 					server.GetEventName()), zap.Any(logging.LabelEventSourceType, server.GetEventSourceType()))
+				e.recordEvent(corev1.EventTypeWarning, common.EventReasonValidationFailed, "Validation of %s event %s failed: %v", server.GetEventSourceType(), server.GetEventName(), err)
 				// Continue starting other event services instead of failing all of them
 				continue
 			}
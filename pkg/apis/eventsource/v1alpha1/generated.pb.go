@@ -1118,304 +1118,304 @@ func init() {
 }
 
 var fileDescriptor_c9ac5d6cd016403b = []byte{
-	// 4747 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3c, 0x4b, 0x6c, 0x23, 0x47,
-	0x76, 0x6e, 0xf1, 0x23, 0xb2, 0xa8, 0x6f, 0xcd, 0x8c, 0x4d, 0x6b, 0xed, 0xd1, 0x44, 0xc6, 0x0e,
-	0xc6, 0x59, 0x2f, 0x15, 0x4f, 0xb2, 0x89, 0xd7, 0x46, 0xbc, 0x20, 0xa5, 0xd1, 0x8c, 0xac, 0xcf,
-	0x48, 0xaf, 0x25, 0x8f, 0xbd, 0xfe, 0x6d, 0xb3, 0x59, 0x24, 0xdb, 0x6c, 0x76, 0x53, 0xdd, 0xc5,
-	0x99, 0x91, 0x83, 0x24, 0x8b, 0x00, 0xf9, 0x6c, 0x9c, 0xcd, 0xee, 0x22, 0x9b, 0x20, 0x40, 0x6e,
-	0xb9, 0x24, 0x48, 0xae, 0xb9, 0x04, 0x48, 0x0e, 0xb9, 0x19, 0xc8, 0x65, 0x8f, 0x0b, 0x04, 0x50,
-	0xd6, 0x4a, 0x4e, 0x01, 0x72, 0x49, 0x2e, 0xc6, 0x06, 0x01, 0x82, 0xfa, 0x74, 0xf5, 0x87, 0x4d,
-	0x8d, 0x64, 0x91, 0x9c, 0x4b, 0x2e, 0x33, 0xe2, 0xfb, 0x77, 0xd5, 0x7b, 0xf5, 0xea, 0x55, 0xbd,
-	0x6e, 0xb4, 0xd3, 0xb2, 0x68, 0xbb, 0x5f, 0xaf, 0x98, 0x6e, 0x77, 0xd5, 0xf0, 0x5a, 0x6e, 0xcf,
-	0x73, 0x3f, 0xe6, 0x7f, 0x7c, 0x9d, 0x3c, 0x24, 0x0e, 0xf5, 0x57, 0x7b, 0x9d, 0xd6, 0xaa, 0xd1,
-	0xb3, 0xfc, 0x55, 0xf1, 0xdb, 0xed, 0x7b, 0x26, 0x59, 0x7d, 0xf8, 0xaa, 0x61, 0xf7, 0xda, 0xc6,
-	0xab, 0xab, 0x2d, 0xe2, 0x10, 0xcf, 0xa0, 0xa4, 0x51, 0xe9, 0x79, 0x2e, 0x75, 0xf1, 0xaf, 0x87,
-	0xe2, 0x2a, 0x81, 0x38, 0xfe, 0xc7, 0x47, 0x82, 0xbd, 0xd2, 0xeb, 0xb4, 0x2a, 0x4c, 0x5c, 0x25,
-	0x22, 0xae, 0x12, 0x88, 0x5b, 0xfa, 0xd6, 0xb9, 0xad, 0x31, 0xdd, 0x6e, 0xd7, 0x75, 0x92, 0xfa,
-	0x97, 0xbe, 0x1e, 0x11, 0xd0, 0x72, 0x5b, 0xee, 0x2a, 0x07, 0xd7, 0xfb, 0x4d, 0xfe, 0x8b, 0xff,
-	0xe0, 0x7f, 0x49, 0xf2, 0x95, 0xce, 0x6b, 0x7e, 0xc5, 0x72, 0x99, 0xc8, 0x55, 0xd3, 0xf5, 0xd8,
-	0x83, 0x0d, 0x88, 0xfc, 0x95, 0x90, 0xa6, 0x6b, 0x98, 0x6d, 0xcb, 0x21, 0xde, 0x71, 0x68, 0x47,
-	0x97, 0x50, 0x23, 0x8d, 0x6b, 0x75, 0x18, 0x97, 0xd7, 0x77, 0xa8, 0xd5, 0x25, 0x03, 0x0c, 0xbf,
-	0xfa, 0x24, 0x06, 0xdf, 0x6c, 0x93, 0xae, 0x91, 0xe4, 0x5b, 0xf9, 0xbb, 0x1c, 0x9a, 0xaf, 0xee,
-	0xec, 0xef, 0xdd, 0x61, 0x03, 0xa4, 0xf3, 0xf1, 0xc4, 0x2f, 0xa2, 0x4c, 0xdf, 0xb3, 0xcb, 0xda,
-	0x0d, 0xed, 0x56, 0xb1, 0x56, 0xfa, 0xec, 0x64, 0xf9, 0x99, 0xd3, 0x93, 0xe5, 0xcc, 0x21, 0x6c,
-	0x03, 0x83, 0xe3, 0xd7, 0xd0, 0x0c, 0x79, 0x6c, 0xb6, 0x0d, 0xa7, 0x45, 0x76, 0x8d, 0x2e, 0x29,
-	0x4f, 0x71, 0xba, 0xab, 0x92, 0x6e, 0xe6, 0x4e, 0x04, 0x07, 0x31, 0xca, 0x28, 0xe7, 0xc1, 0x71,
-	0x8f, 0x94, 0x33, 0xe9, 0x9c, 0x0c, 0x07, 0x31, 0x4a, 0x7c, 0x1b, 0x21, 0xcf, 0xed, 0x53, 0xcb,
-	0x69, 0x6d, 0x91, 0xe3, 0x72, 0x96, 0xf3, 0x61, 0xc9, 0x87, 0x40, 0x61, 0x20, 0x42, 0x85, 0x7f,
-	0x13, 0x2d, 0x9a, 0xae, 0xe3, 0x10, 0x93, 0x5a, 0xae, 0x53, 0x33, 0xcc, 0x8e, 0xdb, 0x6c, 0x96,
-	0x73, 0x37, 0xb4, 0x5b, 0xa5, 0xdb, 0xaf, 0x55, 0xce, 0xed, 0x68, 0xc2, 0x53, 0x2a, 0x92, 0xbf,
-	0x76, 0xed, 0xf4, 0x64, 0x79, 0x71, 0x2d, 0x29, 0x16, 0x06, 0x35, 0xe1, 0x57, 0x50, 0xe1, 0x63,
-	0xdf, 0x75, 0x6a, 0x6e, 0xe3, 0xb8, 0x9c, 0xbf, 0xa1, 0xdd, 0x2a, 0xd4, 0x16, 0xa4, 0xc1, 0x85,
-	0xb7, 0xf4, 0xfb, 0xbb, 0x0c, 0x0e, 0x8a, 0x02, 0x9b, 0x28, 0x43, 0x6d, 0xbf, 0x3c, 0xcd, 0xcd,
-	0xbb, 0x57, 0xb9, 0x54, 0x1c, 0x54, 0x0e, 0xb6, 0xf5, 0x35, 0xd7, 0x69, 0x5a, 0xad, 0xda, 0x34,
-	0x9b, 0xb9, 0x83, 0x6d, 0x1d, 0x98, 0x74, 0xfc, 0x87, 0x1a, 0x2a, 0x30, 0x8f, 0x6b, 0x18, 0xd4,
-	0x28, 0x17, 0x6e, 0x64, 0x6e, 0x95, 0x6e, 0xbf, 0x7f, 0x49, 0x55, 0x09, 0xdf, 0xa9, 0xec, 0x48,
-	0xf1, 0x77, 0x1c, 0xea, 0x1d, 0x87, 0x4f, 0x1c, 0x80, 0x41, 0xe9, 0x5f, 0x7a, 0x03, 0xcd, 0xc6,
-	0x88, 0xf1, 0x02, 0xca, 0x74, 0xc8, 0xb1, 0x70, 0x3b, 0x60, 0x7f, 0xe2, 0xab, 0x28, 0xf7, 0xd0,
-	0xb0, 0xfb, 0xd2, 0xc5, 0x40, 0xfc, 0x78, 0x7d, 0xea, 0x35, 0x6d, 0xe5, 0xc7, 0x59, 0xf4, 0x7c,
-	0xf5, 0x93, 0xbe, 0x47, 0xb8, 0x6e, 0xff, 0x5e, 0xbf, 0x1e, 0x75, 0xe0, 0x1b, 0x28, 0xdb, 0x3c,
-	0x6a, 0x38, 0xd2, 0x83, 0x67, 0xa4, 0x11, 0xd9, 0x8d, 0xfd, 0xf5, 0x5d, 0xe0, 0x18, 0xdc, 0x43,
-	0x57, 0xfc, 0xb6, 0xe1, 0x91, 0x46, 0xd5, 0x34, 0x89, 0xef, 0x6f, 0x91, 0x63, 0xe5, 0xca, 0xa5,
-	0xdb, 0x5f, 0xad, 0x88, 0x60, 0x62, 0x8f, 0x5d, 0x61, 0x71, 0x5d, 0x79, 0xf8, 0x6a, 0x45, 0x27,
-	0xa6, 0x47, 0xe8, 0x16, 0x39, 0xd6, 0x89, 0x4d, 0x4c, 0xea, 0x7a, 0xb5, 0xe7, 0x4e, 0x4f, 0x96,
-	0xaf, 0xe8, 0x83, 0x52, 0x20, 0x4d, 0x34, 0x6e, 0xa0, 0xf9, 0x04, 0x98, 0xbb, 0xff, 0xb9, 0xb5,
-	0x5d, 0x39, 0x3d, 0x59, 0x9e, 0x4f, 0x68, 0x83, 0xa4, 0x48, 0xfc, 0x32, 0x9a, 0x6e, 0xf7, 0xeb,
-	0xfc, 0x59, 0x44, 0x90, 0xcc, 0xcb, 0x87, 0x9f, 0xbe, 0x27, 0xc0, 0x10, 0xe0, 0xf1, 0x8f, 0xa3,
-	0xce, 0x90, 0xe3, 0xce, 0xd0, 0xbc, 0xac, 0x33, 0x0c, 0x9b, 0x91, 0x49, 0xb9, 0xc5, 0x17, 0x19,
-	0x74, 0x65, 0xcd, 0xb0, 0x89, 0xd3, 0x30, 0xbc, 0xa8, 0x43, 0xbc, 0x82, 0x0a, 0x6c, 0xfd, 0x6b,
-	0xf4, 0x6d, 0x22, 0x9d, 0x42, 0x99, 0xa0, 0x4b, 0x38, 0x28, 0x0a, 0x46, 0x6d, 0x39, 0x94, 0x78,
-	0x0f, 0x0d, 0x5b, 0x2e, 0x6e, 0x8a, 0x7a, 0x53, 0xc2, 0x41, 0x51, 0xe0, 0xd7, 0xd1, 0x1c, 0x79,
-	0x6c, 0xda, 0x7d, 0xdf, 0x72, 0x9d, 0x75, 0x83, 0x12, 0xbf, 0x9c, 0xb9, 0x91, 0x61, 0xcb, 0xd3,
-	0xe9, 0xc9, 0xf2, 0xdc, 0x9d, 0x18, 0x06, 0x12, 0x94, 0x4c, 0x13, 0x5b, 0x9c, 0x3f, 0x71, 0x9d,
-	0x60, 0xbe, 0x94, 0xa6, 0x03, 0x09, 0x07, 0x45, 0x81, 0x77, 0x50, 0xa9, 0xef, 0x13, 0x6f, 0xcf,
-	0x38, 0xb6, 0x5d, 0xa3, 0xc1, 0x97, 0xb2, 0x99, 0xda, 0xd7, 0x4e, 0x4f, 0x96, 0x4b, 0x87, 0x21,
-	0xf8, 0xe7, 0x27, 0xcb, 0x65, 0xe2, 0x98, 0x6e, 0xc3, 0x72, 0x5a, 0xab, 0x6c, 0x79, 0xa9, 0x80,
-	0xf1, 0x68, 0x87, 0xf8, 0xbe, 0xd1, 0x22, 0x10, 0xe5, 0xc7, 0x7f, 0x1c, 0x75, 0x80, 0x3c, 0x77,
-	0x80, 0xef, 0x5c, 0xd2, 0x01, 0x52, 0xc6, 0x7e, 0x52, 0x53, 0xff, 0xd7, 0x79, 0x84, 0xef, 0x74,
-	0x2d, 0x4a, 0x49, 0x6c, 0xe6, 0x6f, 0xa2, 0x7c, 0xdd, 0x73, 0x3b, 0xc4, 0x93, 0xf3, 0x3e, 0x27,
-	0xf5, 0xe7, 0x6b, 0x1c, 0x0a, 0x12, 0xcb, 0x12, 0x0c, 0x4b, 0x37, 0x0e, 0xb1, 0x59, 0x64, 0x4e,
-	0xc5, 0x13, 0xcc, 0x9a, 0xc2, 0x40, 0x84, 0x0a, 0x7f, 0x03, 0x95, 0xe4, 0x2f, 0x1e, 0x70, 0x22,
-	0x9b, 0x5d, 0x91, 0x4c, 0xa5, 0xb5, 0x10, 0x05, 0x51, 0x3a, 0x7c, 0x1f, 0x15, 0xd8, 0x34, 0x38,
-	0x41, 0x90, 0x9e, 0x7b, 0x09, 0x98, 0x61, 0xe3, 0x76, 0x28, 0x59, 0x41, 0x09, 0x61, 0x02, 0x7b,
-	0x86, 0xef, 0x3f, 0x72, 0xbd, 0x86, 0xcc, 0x6f, 0x17, 0x11, 0xb8, 0x27, 0x59, 0x41, 0x09, 0x49,
-	0xcf, 0x9c, 0xf9, 0xa7, 0x92, 0x39, 0xa7, 0xcf, 0x9b, 0x39, 0x0b, 0x63, 0xcd, 0x9c, 0xdf, 0x8f,
-	0xc6, 0x4a, 0x91, 0xc7, 0xca, 0x47, 0x97, 0x54, 0x35, 0xe8, 0xac, 0x93, 0x0a, 0x95, 0x7f, 0x99,
-	0x42, 0xa5, 0x68, 0x8c, 0x7c, 0x27, 0xf2, 0x6c, 0x1a, 0x1f, 0xc6, 0x5f, 0x8a, 0xf8, 0x8f, 0xda,
-	0x4e, 0x86, 0xcf, 0xc3, 0xa8, 0x99, 0x47, 0xdd, 0xaf, 0x7f, 0x4c, 0x4c, 0xca, 0x0c, 0x09, 0x63,
-	0x25, 0x84, 0x85, 0xe6, 0xe2, 0x1e, 0xca, 0xfa, 0x3d, 0x62, 0xca, 0xfc, 0xba, 0x7b, 0xd9, 0x91,
-	0x0b, 0x6d, 0xd7, 0x7b, 0xc4, 0x0c, 0x13, 0x3c, 0xfb, 0x05, 0x5c, 0x13, 0x7e, 0x8c, 0xf2, 0x3e,
-	0x35, 0x68, 0xdf, 0x97, 0x59, 0x76, 0x6f, 0x84, 0x3a, 0xb9, 0xdc, 0x70, 0x25, 0x11, 0xbf, 0x41,
-	0xea, 0x5b, 0xf9, 0x99, 0x86, 0xe6, 0x23, 0xd4, 0xdb, 0x96, 0x4f, 0xf1, 0xfb, 0x03, 0x23, 0x5c,
-	0x39, 0xdf, 0x08, 0x33, 0x6e, 0x3e, 0xbe, 0xca, 0x19, 0x02, 0x48, 0x64, 0x74, 0x5d, 0x94, 0xb3,
-	0x28, 0xe9, 0xfa, 0xe5, 0x29, 0xee, 0x98, 0x6f, 0x8d, 0xee, 0x51, 0x6b, 0xb3, 0x52, 0x6d, 0x6e,
-	0x93, 0x29, 0x00, 0xa1, 0x67, 0xe5, 0x3f, 0xbe, 0x11, 0x7b, 0x44, 0x36, 0xec, 0x7c, 0x6f, 0xcf,
-	0x40, 0xb5, 0xbe, 0xcf, 0x57, 0x43, 0x2d, 0xb1, 0xb7, 0x8f, 0xe0, 0x20, 0x46, 0x89, 0xfb, 0xa8,
-	0x40, 0x49, 0xb7, 0x67, 0x1b, 0x34, 0xd8, 0x80, 0xdd, 0xbd, 0x6c, 0x14, 0x4b, 0x71, 0x91, 0x6c,
-	0x2a, 0x21, 0xa0, 0x54, 0xe1, 0x2e, 0x9a, 0xf6, 0x89, 0xf7, 0xd0, 0x32, 0x89, 0x74, 0x91, 0x8d,
-	0x4b, 0x6a, 0xd5, 0x85, 0xb4, 0x5a, 0x89, 0x6d, 0xb7, 0xe4, 0x0f, 0x08, 0x74, 0xe0, 0xaf, 0xa2,
-	0x69, 0x8f, 0xf4, 0x6c, 0xcb, 0x34, 0xf8, 0xa2, 0x9f, 0x13, 0x64, 0x20, 0x40, 0x10, 0xe0, 0xf0,
-	0x6f, 0xa1, 0x5c, 0xd7, 0x72, 0x2c, 0x57, 0xee, 0xc8, 0xde, 0x1d, 0x6d, 0xa8, 0x54, 0x76, 0x98,
-	0x6c, 0xb1, 0xbc, 0xa8, 0xa9, 0xe5, 0x30, 0x10, 0x6a, 0x79, 0x89, 0x60, 0xca, 0x2c, 0x2e, 0x37,
-	0x05, 0xef, 0x8f, 0xd8, 0x06, 0xb5, 0x49, 0x88, 0xaf, 0x72, 0x01, 0x18, 0x94, 0x7e, 0xfc, 0x09,
-	0xca, 0x36, 0x2d, 0x9b, 0x94, 0xa7, 0xb9, 0x1d, 0xef, 0x8c, 0xd8, 0x8e, 0x0d, 0xcb, 0x26, 0xc2,
-	0x86, 0xb0, 0x42, 0xb0, 0x6c, 0x02, 0x5c, 0x27, 0x1f, 0x08, 0x8f, 0x08, 0x19, 0x23, 0xaa, 0x95,
-	0x92, 0x06, 0x80, 0x14, 0x9f, 0x18, 0x88, 0x00, 0x0c, 0x4a, 0x3f, 0xfe, 0x3d, 0x0d, 0x4d, 0x3f,
-	0x22, 0xf5, 0xb6, 0xeb, 0x76, 0x64, 0xf6, 0x79, 0x6f, 0xc4, 0xb6, 0x3c, 0x10, 0xd2, 0x85, 0x29,
-	0xaa, 0x68, 0x90, 0x50, 0x08, 0x94, 0xb3, 0x19, 0x31, 0xba, 0x47, 0xbd, 0x32, 0x1a, 0xcb, 0x8c,
-	0x54, 0xbb, 0x47, 0xbd, 0xc4, 0x8c, 0xb0, 0xfa, 0x12, 0xb8, 0x4e, 0x16, 0x1a, 0x1d, 0xa3, 0xd9,
-	0x31, 0xca, 0xa5, 0xb1, 0x84, 0xc6, 0x16, 0x93, 0x9d, 0x08, 0x0d, 0x0e, 0x03, 0xa1, 0x96, 0x3d,
-	0x7b, 0xf7, 0x88, 0xd2, 0xf2, 0xcc, 0x58, 0x9e, 0x7d, 0xe7, 0x88, 0xd2, 0xc4, 0xb3, 0xef, 0xec,
-	0x1f, 0x1c, 0x00, 0xd7, 0xc9, 0x74, 0x3b, 0x06, 0xf5, 0xcb, 0xb3, 0x63, 0xd1, 0xbd, 0x6b, 0x50,
-	0x3f, 0xa1, 0x7b, 0xb7, 0x7a, 0xa0, 0x03, 0xd7, 0x89, 0x1f, 0xa2, 0x8c, 0xef, 0xf8, 0xe5, 0x39,
-	0xae, 0xfa, 0xc1, 0x88, 0x55, 0xeb, 0x8e, 0xd4, 0xac, 0xce, 0x99, 0xf4, 0x5d, 0x1d, 0x98, 0x42,
-	0xae, 0xf7, 0xc8, 0x2f, 0xcf, 0x8f, 0x47, 0xef, 0xd1, 0x80, 0xde, 0x7d, 0xa6, 0xf7, 0xc8, 0xc7,
-	0xbf, 0xa3, 0xa1, 0x7c, 0xaf, 0x5f, 0xd7, 0xfb, 0xf5, 0xf2, 0x02, 0xd7, 0xfd, 0xed, 0x11, 0xeb,
-	0xde, 0xe3, 0xc2, 0x85, 0x7a, 0xb5, 0x8b, 0x10, 0x40, 0x90, 0x9a, 0xb9, 0x11, 0x42, 0x6b, 0x79,
-	0x71, 0x2c, 0x46, 0xdc, 0xe5, 0xd2, 0x12, 0x46, 0x08, 0x20, 0x48, 0xcd, 0x81, 0x11, 0xb6, 0x51,
-	0x2f, 0xe3, 0x71, 0x19, 0x61, 0x1b, 0x29, 0x46, 0xd8, 0x86, 0x30, 0xc2, 0x36, 0xea, 0xcc, 0xf5,
-	0xdb, 0x8d, 0xa6, 0x5f, 0xbe, 0x32, 0x16, 0xd7, 0xbf, 0xd7, 0x68, 0x26, 0x5d, 0xff, 0xde, 0xfa,
-	0x86, 0x0e, 0x5c, 0x27, 0x5b, 0x72, 0x7c, 0xdb, 0x30, 0x3b, 0xe5, 0xab, 0x63, 0x59, 0x72, 0x74,
-	0x26, 0x3b, 0xb1, 0xe4, 0x70, 0x18, 0x08, 0xb5, 0xf8, 0xcf, 0x34, 0x54, 0xf2, 0xa9, 0xeb, 0x19,
-	0x2d, 0x72, 0xd7, 0xb3, 0x1a, 0xe5, 0x6b, 0xa3, 0xa9, 0x3c, 0x92, 0x66, 0x84, 0x1a, 0x84, 0x31,
-	0xaa, 0x86, 0x8d, 0x60, 0x20, 0x6a, 0x08, 0xfe, 0x4b, 0x0d, 0xcd, 0x19, 0xb1, 0xd3, 0x9e, 0xf2,
-	0xb3, 0xdc, 0xb6, 0xfa, 0xa8, 0x53, 0x42, 0xfc, 0x48, 0x89, 0x9b, 0xf7, 0xac, 0x34, 0x6f, 0x2e,
-	0x8e, 0x84, 0x84, 0x45, 0xdc, 0x7d, 0x7d, 0xea, 0x59, 0x3d, 0x52, 0x7e, 0x6e, 0x2c, 0xee, 0xab,
-	0x73, 0xe1, 0x09, 0xf7, 0x15, 0x40, 0x90, 0x9a, 0x79, 0xea, 0x26, 0xa2, 0xd4, 0x2b, 0x97, 0xc7,
-	0x92, 0xba, 0x83, 0x42, 0x32, 0x9e, 0xba, 0x25, 0x14, 0x02, 0xe5, 0xcc, 0x97, 0x3d, 0xd2, 0xb0,
-	0xfc, 0xf2, 0xf3, 0x63, 0xf1, 0x65, 0x60, 0xb2, 0x13, 0xbe, 0xcc, 0x61, 0x20, 0xd4, 0xb2, 0xe5,
-	0xdc, 0xf1, 0x8f, 0xca, 0x4b, 0x63, 0x59, 0xce, 0x77, 0xfd, 0xa3, 0xc4, 0x72, 0xbe, 0xab, 0xef,
-	0x03, 0x53, 0x28, 0x97, 0x73, 0xdb, 0x37, 0xbc, 0xf2, 0x57, 0xc6, 0xb4, 0x9c, 0x33, 0xe1, 0x03,
-	0xcb, 0x39, 0x03, 0x82, 0xd4, 0xcc, 0xbd, 0x80, 0x5f, 0xbd, 0x58, 0x66, 0xf9, 0x85, 0xb1, 0x78,
-	0xc1, 0x5d, 0x21, 0x3d, 0xe1, 0x05, 0x12, 0x0a, 0x81, 0xf2, 0xa5, 0x3e, 0x42, 0x61, 0x0d, 0x90,
-	0x72, 0x6a, 0xb0, 0x1f, 0x3d, 0x35, 0x28, 0xdd, 0x7e, 0xe3, 0xc2, 0xc7, 0x3d, 0xfa, 0x2f, 0x57,
-	0x3d, 0x6a, 0x35, 0x0d, 0x93, 0x46, 0x8e, 0x1c, 0x96, 0x7e, 0xa0, 0xa1, 0xd9, 0xd8, 0xbe, 0x3f,
-	0x45, 0x75, 0x3b, 0xae, 0x1a, 0x46, 0x7f, 0x16, 0x19, 0xb5, 0xe8, 0xf7, 0x35, 0x54, 0x54, 0x15,
-	0x40, 0x8a, 0x35, 0x8d, 0xb8, 0x35, 0x97, 0x3d, 0xb3, 0xe0, 0xaa, 0xd2, 0x2d, 0x61, 0x63, 0x13,
-	0x2b, 0x05, 0xc6, 0x3f, 0x36, 0x4a, 0x5d, 0xba, 0x45, 0xdf, 0xd3, 0xd0, 0x4c, 0xb4, 0x20, 0x48,
-	0x31, 0xc8, 0x8c, 0x1b, 0xb4, 0x73, 0x49, 0x83, 0xa4, 0xb6, 0x35, 0xd7, 0xa1, 0xe4, 0x31, 0x4d,
-	0xce, 0x93, 0xaa, 0x0b, 0xc6, 0x3f, 0x4f, 0x89, 0xfb, 0xac, 0xc4, 0xa8, 0xa0, 0xb0, 0x48, 0x48,
-	0x31, 0x85, 0xc4, 0x4d, 0xb9, 0x7f, 0x49, 0x53, 0x84, 0xae, 0xe1, 0xde, 0xab, 0x2a, 0x86, 0xf1,
-	0x8f, 0x0a, 0xab, 0x44, 0x86, 0x58, 0xf2, 0x07, 0x1a, 0x2a, 0xaa, 0xfa, 0x61, 0xfc, 0x83, 0xc2,
-	0xea, 0x12, 0x91, 0xe1, 0x07, 0x4d, 0xf9, 0x5d, 0x0d, 0x15, 0x82, 0x7a, 0x62, 0xfc, 0x2e, 0xab,
-	0xef, 0xea, 0x43, 0x86, 0x84, 0xdb, 0x71, 0x34, 0x31, 0x3b, 0xf6, 0x87, 0xd9, 0xf1, 0xa9, 0x86,
-	0x4a, 0x91, 0x5a, 0x23, 0xc5, 0x94, 0x66, 0xdc, 0x94, 0xcb, 0x1e, 0x92, 0x4a, 0x65, 0xc3, 0xad,
-	0x89, 0x14, 0x1d, 0xe3, 0xb7, 0x46, 0x2a, 0x3b, 0xd3, 0x9a, 0xa0, 0xfa, 0x98, 0x88, 0x35, 0x4c,
-	0xd9, 0xf0, 0x70, 0x56, 0x95, 0xc8, 0xf8, 0xc3, 0x99, 0x55, 0x38, 0x67, 0x2c, 0x72, 0x61, 0x59,
-	0x32, 0xfe, 0x78, 0x16, 0xba, 0xd2, 0x6d, 0xf9, 0x53, 0x0d, 0x2d, 0x24, 0x6b, 0x93, 0x14, 0x8b,
-	0x3a, 0x71, 0x8b, 0x0e, 0x2f, 0x6b, 0x51, 0x44, 0x63, 0xba, 0x5d, 0x7f, 0xa1, 0xa1, 0x2b, 0x29,
-	0x75, 0x49, 0x8a, 0x69, 0x4e, 0xdc, 0xb4, 0x77, 0xc6, 0x75, 0xbf, 0x9e, 0xf4, 0xec, 0x48, 0x61,
-	0x32, 0x7e, 0xcf, 0x96, 0xca, 0xd2, 0xad, 0xf9, 0xbe, 0x86, 0x66, 0xa2, 0x05, 0x4a, 0x8a, 0x39,
-	0xad, 0xb8, 0x39, 0xfb, 0x23, 0xbf, 0x57, 0x4b, 0xfa, 0x77, 0x58, 0xaa, 0x8c, 0xdf, 0xbf, 0x85,
-	0xae, 0xe1, 0x79, 0x22, 0x28, 0x5c, 0xc6, 0x9f, 0x27, 0x76, 0xf5, 0xfd, 0x33, 0xf3, 0x84, 0x2a,
-	0x62, 0x26, 0x91, 0x27, 0xb8, 0xb2, 0xe1, 0x1e, 0x13, 0x2d, 0x66, 0xc6, 0xef, 0x31, 0x81, 0xb6,
-	0x54, 0x7b, 0x56, 0x28, 0x5a, 0x1c, 0xb8, 0xfc, 0xc3, 0x1f, 0xa9, 0xeb, 0x45, 0x71, 0x9d, 0xf7,
-	0x6b, 0x17, 0xaf, 0x93, 0xce, 0xbe, 0x45, 0xfc, 0xe7, 0x0c, 0x9a, 0x4f, 0xd4, 0x0c, 0x78, 0x15,
-	0x15, 0xb9, 0x30, 0xde, 0x3b, 0x27, 0xee, 0xd7, 0x16, 0x25, 0x7b, 0xf1, 0x4e, 0x80, 0x80, 0x90,
-	0x06, 0xff, 0x48, 0x43, 0xf3, 0x8f, 0x0c, 0x6a, 0xb6, 0xf7, 0x0c, 0xda, 0x16, 0xf7, 0xda, 0x23,
-	0xca, 0x20, 0x0f, 0xe2, 0x52, 0x6b, 0xcf, 0x49, 0x3b, 0xe6, 0x13, 0x08, 0x48, 0xea, 0xc7, 0x2f,
-	0xa3, 0xe9, 0x9e, 0x6b, 0xdb, 0x96, 0xd3, 0xe2, 0xd7, 0x6e, 0x85, 0xb0, 0x56, 0xdd, 0x13, 0x60,
-	0x08, 0xf0, 0xf1, 0x76, 0xb5, 0xec, 0x48, 0xae, 0x60, 0x12, 0x43, 0x3a, 0xa9, 0x1b, 0xf7, 0x6f,
-	0x22, 0x3c, 0xe8, 0x64, 0xf8, 0xa5, 0x80, 0x5e, 0xcc, 0xa5, 0x3a, 0x36, 0x79, 0x9b, 0x01, 0x25,
-	0xfb, 0xca, 0x17, 0xd3, 0x68, 0x71, 0x60, 0x27, 0x83, 0x97, 0xd0, 0x94, 0xd5, 0xe0, 0x7c, 0x99,
-	0x1a, 0x92, 0x7c, 0x53, 0x9b, 0xeb, 0x30, 0x65, 0x35, 0x30, 0x0d, 0xef, 0x8a, 0xc6, 0x51, 0x9c,
-	0x89, 0x8b, 0xcb, 0x81, 0x9b, 0xa1, 0x97, 0x50, 0xce, 0x7d, 0xe4, 0x10, 0x4f, 0xb6, 0xc1, 0xa8,
-	0x87, 0xb9, 0xcf, 0x80, 0x20, 0x70, 0xbc, 0x8d, 0x93, 0xf4, 0x5c, 0xdf, 0xa2, 0xae, 0x37, 0xd8,
-	0xc6, 0xa9, 0x30, 0x10, 0xa1, 0xc2, 0x2b, 0x28, 0x2f, 0xac, 0xe2, 0x57, 0xa2, 0xc5, 0x1a, 0x62,
-	0xd1, 0x22, 0x92, 0x20, 0x48, 0x0c, 0xbe, 0x8f, 0x0a, 0x46, 0xcf, 0x3a, 0x70, 0x3b, 0xc4, 0x91,
-	0x7d, 0x2a, 0x17, 0xe9, 0x80, 0xa9, 0xee, 0x6d, 0x72, 0x56, 0x50, 0x42, 0xf0, 0x87, 0x68, 0x56,
-	0x3e, 0x98, 0xe0, 0x91, 0x8d, 0x99, 0xe7, 0x94, 0xba, 0x78, 0x7a, 0xb2, 0x3c, 0xfb, 0x20, 0xca,
-	0x0f, 0x71, 0x71, 0xa2, 0xc5, 0xcc, 0x27, 0x66, 0xdf, 0x23, 0xbc, 0x73, 0xa5, 0x10, 0x6d, 0x31,
-	0x13, 0x70, 0x50, 0x14, 0xf8, 0x26, 0xca, 0x1b, 0x26, 0xb5, 0x1e, 0x92, 0x72, 0x91, 0xd3, 0xaa,
-	0x45, 0xa3, 0xca, 0xa1, 0x20, 0xb1, 0xbc, 0x21, 0x89, 0x4d, 0x92, 0x5c, 0x22, 0x50, 0xa2, 0x21,
-	0x29, 0x44, 0x41, 0x94, 0x0e, 0xbf, 0x81, 0x66, 0x85, 0x83, 0xd4, 0x0c, 0x9f, 0x1c, 0xc2, 0x76,
-	0xb9, 0xc4, 0x19, 0xaf, 0x49, 0xc6, 0xd9, 0xbb, 0x51, 0x24, 0xc4, 0x69, 0x71, 0x15, 0xcd, 0x0b,
-	0xc0, 0x61, 0xcf, 0x76, 0x8d, 0x06, 0x63, 0x9f, 0xe1, 0xec, 0x6a, 0x49, 0xb8, 0x1b, 0x47, 0x43,
-	0x92, 0x1e, 0xbf, 0x85, 0x70, 0x83, 0xd8, 0x84, 0x92, 0x7b, 0xae, 0xdb, 0xb9, 0xef, 0x6c, 0x58,
-	0x8e, 0xe5, 0xb7, 0xcb, 0xb3, 0xfc, 0x51, 0x97, 0xa4, 0x14, 0xbc, 0x3e, 0x40, 0x01, 0x29, 0x5c,
-	0xf8, 0x8f, 0xa2, 0x6b, 0x86, 0xb8, 0xb2, 0xfa, 0x70, 0xd4, 0x75, 0xc4, 0xa4, 0x56, 0x8d, 0xd3,
-	0x1c, 0x0f, 0xfd, 0x78, 0xd9, 0x10, 0x0d, 0x6f, 0x6d, 0x72, 0xe1, 0xbd, 0x8a, 0x8a, 0x4c, 0x2c,
-	0x31, 0xe9, 0xe6, 0xba, 0x6c, 0x8f, 0x53, 0xb9, 0x67, 0x2f, 0x40, 0x40, 0x48, 0x13, 0x09, 0xdb,
-	0xcc, 0xd0, 0xb0, 0x7d, 0x07, 0x95, 0x0c, 0xde, 0xba, 0x2a, 0x22, 0xf7, 0x42, 0xcd, 0x70, 0xf3,
-	0xcc, 0xa5, 0xab, 0x21, 0x37, 0x44, 0x45, 0x61, 0x1d, 0x5d, 0x23, 0x8e, 0x51, 0xb7, 0x89, 0xae,
-	0x6f, 0xbf, 0x4d, 0x3c, 0xab, 0x69, 0x99, 0x06, 0xb5, 0x5c, 0x87, 0xf7, 0xc7, 0x15, 0x6a, 0x2f,
-	0x4a, 0xd3, 0xaf, 0xdd, 0x49, 0x23, 0x82, 0x74, 0x5e, 0x19, 0x27, 0xb6, 0xa1, 0xe2, 0x24, 0x3f,
-	0x10, 0x27, 0x21, 0x12, 0xe2, 0xb4, 0x43, 0x9c, 0xbc, 0x70, 0x79, 0x27, 0x2f, 0x8e, 0xca, 0xc9,
-	0xe3, 0x7e, 0x36, 0x29, 0x27, 0xff, 0xab, 0x02, 0x9a, 0x4f, 0xd4, 0xa3, 0xa9, 0xfb, 0x16, 0xed,
-	0x29, 0xef, 0x5b, 0x6e, 0xa0, 0x2c, 0x65, 0x8b, 0xea, 0x54, 0xbc, 0xa7, 0x9c, 0xaf, 0xa6, 0x1c,
-	0xc3, 0xdc, 0xc3, 0x6c, 0x13, 0xb3, 0x13, 0xf4, 0x08, 0xcb, 0x4c, 0xa8, 0xdc, 0x63, 0x2d, 0x8a,
-	0x84, 0x38, 0x2d, 0xfe, 0x1a, 0x2a, 0x1a, 0x8d, 0x86, 0x47, 0x7c, 0x9f, 0xf8, 0x7c, 0xaf, 0x53,
-	0xac, 0xcd, 0xb2, 0xd8, 0xaa, 0x06, 0x40, 0x08, 0xf1, 0x2c, 0x7b, 0xb4, 0x1b, 0x4d, 0xff, 0xd0,
-	0x27, 0x1e, 0x77, 0xe8, 0x48, 0xdb, 0x30, 0x1b, 0x4a, 0x06, 0x07, 0x45, 0x81, 0x1b, 0x68, 0xbe,
-	0xe3, 0xd5, 0xd7, 0xd6, 0x0c, 0xb3, 0x4d, 0x64, 0x36, 0xcb, 0x5f, 0xb8, 0xf3, 0x7c, 0x2b, 0x2e,
-	0x01, 0x92, 0x22, 0xa5, 0x96, 0x2d, 0x72, 0x4c, 0x8d, 0xfa, 0x97, 0xc9, 0x99, 0x81, 0x96, 0xa8,
-	0x04, 0x48, 0x8a, 0x64, 0x19, 0xae, 0xe3, 0xd5, 0x83, 0x1e, 0x58, 0x1e, 0x3e, 0x91, 0x0c, 0xb7,
-	0x15, 0xa2, 0x20, 0x4a, 0xc7, 0x06, 0xac, 0xe3, 0xd5, 0x81, 0x18, 0x76, 0x97, 0xa7, 0xd0, 0xc8,
-	0x80, 0x6d, 0x49, 0x38, 0x28, 0x0a, 0xdc, 0x43, 0x98, 0x3d, 0x1d, 0x9f, 0x77, 0xf1, 0xef, 0x8e,
-	0xd1, 0xe3, 0xd9, 0xb4, 0x74, 0xfb, 0x56, 0xda, 0xd3, 0x28, 0xa2, 0xe8, 0x03, 0x3d, 0xcb, 0x02,
-	0x7a, 0x6b, 0x40, 0x0e, 0xa4, 0xc8, 0xc6, 0xef, 0xa2, 0xe7, 0x3a, 0x5e, 0x5d, 0xf6, 0x8c, 0xed,
-	0x79, 0x96, 0x63, 0x5a, 0x3d, 0x43, 0x74, 0x15, 0x8b, 0x5c, 0xbc, 0x2c, 0xcd, 0x7d, 0x6e, 0x2b,
-	0x9d, 0x0c, 0x86, 0xf1, 0xc7, 0x37, 0xd1, 0x33, 0x23, 0xd9, 0x44, 0x27, 0xc2, 0x75, 0x52, 0x2b,
-	0xc5, 0xbf, 0x67, 0xd1, 0x42, 0xf2, 0x4c, 0xfc, 0x49, 0xef, 0x2a, 0xb1, 0xb4, 0x65, 0x78, 0xd4,
-	0xe2, 0x6b, 0x7f, 0x32, 0x6d, 0x05, 0x08, 0x08, 0x69, 0xd8, 0x36, 0x96, 0xba, 0x3d, 0xcb, 0x4c,
-	0x6e, 0x63, 0x0f, 0x18, 0x10, 0x04, 0x2e, 0xbd, 0x3f, 0x3a, 0x3b, 0xb1, 0xfe, 0x68, 0xd9, 0xf1,
-	0x9c, 0x1b, 0x6b, 0xc7, 0xf3, 0xc5, 0x5e, 0x5f, 0xfa, 0x34, 0xea, 0x65, 0xa2, 0x5d, 0xef, 0x83,
-	0x11, 0x5f, 0x7f, 0x4c, 0xca, 0xcd, 0xfe, 0x35, 0x8b, 0xe6, 0x13, 0xf7, 0x1d, 0x4f, 0xf2, 0x32,
-	0xe5, 0x34, 0x53, 0x67, 0x38, 0xcd, 0x2b, 0xa8, 0x60, 0xda, 0x16, 0x71, 0xe8, 0x66, 0x43, 0x3a,
-	0x57, 0xd8, 0xfa, 0x28, 0xe0, 0xeb, 0xa0, 0x28, 0x9e, 0xb6, 0x8b, 0x45, 0x67, 0x3f, 0x77, 0xde,
-	0x16, 0xfc, 0xfc, 0xe4, 0x5e, 0x5e, 0x9b, 0x1e, 0xc9, 0x42, 0x96, 0x98, 0xe6, 0x89, 0xbd, 0xbc,
-	0x96, 0x41, 0x4a, 0x26, 0xfe, 0x81, 0x86, 0x4a, 0x86, 0xe3, 0xb8, 0x94, 0xef, 0x31, 0xfd, 0xb2,
-	0x36, 0x92, 0x36, 0xa7, 0x40, 0x7c, 0xa5, 0x1a, 0x8a, 0x4e, 0xf4, 0xf6, 0x44, 0x30, 0x10, 0xb5,
-	0x00, 0xff, 0x06, 0xca, 0xdb, 0x46, 0x9d, 0xd8, 0x41, 0x3f, 0xb9, 0x3e, 0x2a, 0x5b, 0xb6, 0xb9,
-	0xd4, 0x44, 0xa3, 0x84, 0x00, 0x82, 0x54, 0xb9, 0xf4, 0x26, 0x5a, 0x48, 0x9a, 0x7c, 0x91, 0xb1,
-	0x5d, 0xfa, 0x26, 0x2a, 0x45, 0xd4, 0x5c, 0x68, 0x5a, 0xfe, 0x3e, 0x8b, 0x16, 0x92, 0xd7, 0x8b,
-	0x4f, 0x8a, 0xfc, 0x97, 0xd1, 0xb4, 0xdf, 0xe7, 0x6f, 0x3c, 0xc8, 0xd8, 0x57, 0xa7, 0x59, 0xba,
-	0x00, 0x43, 0x80, 0x4f, 0x8f, 0xe8, 0xcc, 0x53, 0x89, 0xe8, 0xec, 0x79, 0x23, 0x7a, 0xbc, 0x29,
-	0xe6, 0xd3, 0xc1, 0x17, 0xd0, 0x3e, 0x18, 0xf1, 0xf5, 0xf0, 0xa4, 0x42, 0xfa, 0xbf, 0xb2, 0x68,
-	0x2e, 0x7e, 0xc0, 0xce, 0xb6, 0xaa, 0x6d, 0xd7, 0xa7, 0x72, 0x03, 0x2f, 0x3d, 0x48, 0x45, 0xdf,
-	0xbd, 0x10, 0x05, 0x51, 0xba, 0xf3, 0xe5, 0x92, 0x97, 0xd1, 0xb4, 0x7c, 0xa3, 0x4c, 0xa6, 0x12,
-	0xe5, 0x76, 0xf2, 0xad, 0x33, 0x08, 0xf0, 0xff, 0x9f, 0x48, 0x06, 0xdc, 0xee, 0x7b, 0x83, 0x89,
-	0xe4, 0xbd, 0x91, 0xde, 0xad, 0x4c, 0xca, 0xe9, 0xfe, 0x37, 0x87, 0x16, 0x07, 0xae, 0xdc, 0xe3,
-	0x27, 0x35, 0xda, 0x39, 0x4e, 0x6a, 0xde, 0x44, 0x73, 0xdc, 0xab, 0xf6, 0x12, 0xe7, 0x3b, 0xaa,
-	0xcd, 0xf2, 0x20, 0x86, 0x85, 0x04, 0xf5, 0xf9, 0xb6, 0xcc, 0x6f, 0xa2, 0x39, 0xbf, 0x5f, 0xf7,
-	0x4d, 0xcf, 0xea, 0x31, 0xf7, 0xd8, 0x5c, 0x97, 0xa7, 0xbf, 0x4a, 0x89, 0x1e, 0xc3, 0x42, 0x82,
-	0x1a, 0xb7, 0xd0, 0x82, 0xe9, 0x91, 0x06, 0x71, 0xa8, 0x65, 0xd8, 0xb2, 0xbe, 0xbc, 0xd0, 0xbb,
-	0x8e, 0x57, 0x4f, 0x4f, 0x96, 0x17, 0xd6, 0x12, 0x22, 0x60, 0x40, 0x28, 0xae, 0xa3, 0x25, 0x71,
-	0xe2, 0x12, 0x35, 0x48, 0x9d, 0xd7, 0x88, 0x9d, 0xf0, 0x8a, 0x34, 0x7a, 0x69, 0x7d, 0x28, 0x25,
-	0x9c, 0x21, 0xe5, 0x82, 0x2f, 0x38, 0x6e, 0xa1, 0xf9, 0xd0, 0x4a, 0x7f, 0xc3, 0xb2, 0x83, 0xba,
-	0xf7, 0x17, 0x24, 0xd3, 0xf3, 0xeb, 0xa4, 0xe7, 0x11, 0xd3, 0xa0, 0xa4, 0xb1, 0x16, 0x27, 0x84,
-	0x24, 0xe7, 0x38, 0x8e, 0x8e, 0x06, 0x5c, 0x70, 0x52, 0xfe, 0x7f, 0x92, 0x67, 0xfe, 0x9f, 0xb8,
-	0x4a, 0xc4, 0x2b, 0x28, 0xcf, 0x5d, 0x4e, 0x6c, 0xa5, 0xe4, 0xc1, 0x23, 0xf7, 0x45, 0x1f, 0x24,
-	0xe6, 0x1c, 0x87, 0x39, 0x32, 0xef, 0x67, 0x86, 0xe4, 0x7d, 0x1d, 0x5d, 0xa3, 0xb6, 0x7f, 0xe0,
-	0xf5, 0x7d, 0xba, 0x46, 0x3c, 0xca, 0xc7, 0x76, 0xcf, 0xa0, 0x6d, 0xe9, 0xd5, 0xea, 0x7c, 0xf1,
-	0x60, 0x5b, 0x1f, 0x24, 0x82, 0x74, 0x5e, 0xe6, 0x7a, 0xd4, 0xf6, 0xab, 0xb6, 0xed, 0x3e, 0x0a,
-	0x2e, 0x01, 0xc2, 0x35, 0x56, 0xae, 0x9e, 0xca, 0xf5, 0x0e, 0xb6, 0xf5, 0x21, 0x94, 0x70, 0x86,
-	0x14, 0xbc, 0x83, 0xae, 0x50, 0xdb, 0x7f, 0xdb, 0xb0, 0xad, 0x86, 0x41, 0x09, 0xcb, 0x42, 0xfc,
-	0x20, 0x45, 0xf8, 0xf5, 0x57, 0xa4, 0xf0, 0x2b, 0x07, 0xdb, 0x7a, 0x92, 0x04, 0xd2, 0xf8, 0x26,
-	0xf3, 0xd9, 0x8a, 0xd4, 0x14, 0x56, 0x78, 0x2a, 0x29, 0xac, 0xf8, 0xc4, 0x68, 0x8d, 0x05, 0x18,
-	0x1a, 0x51, 0x80, 0x25, 0x7c, 0x7c, 0x52, 0x01, 0xf6, 0x4f, 0x59, 0xb4, 0x90, 0x6c, 0x60, 0xf8,
-	0xb2, 0xfb, 0x9a, 0xe8, 0x4b, 0xea, 0x53, 0xa3, 0x78, 0x49, 0x7d, 0x15, 0x15, 0x99, 0x0b, 0xfa,
-	0x3d, 0xc3, 0x0c, 0xde, 0xbd, 0x57, 0x79, 0x6e, 0x37, 0x40, 0x40, 0x48, 0x83, 0x97, 0xd0, 0x54,
-	0xa3, 0x2e, 0x5f, 0xbe, 0x54, 0x77, 0xa6, 0xeb, 0x35, 0x98, 0x6a, 0xd4, 0xf1, 0x2d, 0x54, 0x90,
-	0x1b, 0xa6, 0xe0, 0x9a, 0x91, 0xab, 0x95, 0xbb, 0x29, 0x1f, 0x14, 0x76, 0x32, 0x5b, 0x94, 0x31,
-	0x1c, 0xa7, 0x24, 0xe7, 0x71, 0x62, 0x2f, 0x9b, 0x67, 0xd1, 0x95, 0x94, 0x76, 0xe3, 0xf8, 0xf4,
-	0x69, 0xe7, 0x98, 0xbe, 0x23, 0x94, 0x6f, 0x5a, 0x36, 0x25, 0xde, 0x88, 0x6e, 0xb5, 0x03, 0xa3,
-	0x36, 0xb8, 0x50, 0x91, 0x26, 0xc4, 0xdf, 0x20, 0x15, 0xb1, 0x58, 0xbe, 0xda, 0xf2, 0xdc, 0x7e,
-	0xef, 0x6d, 0xe2, 0xf9, 0x6c, 0x65, 0x0d, 0xde, 0x07, 0x15, 0x65, 0xdb, 0xeb, 0xe7, 0x7b, 0x87,
-	0xfb, 0x6e, 0x8a, 0x84, 0xda, 0x0b, 0xf2, 0x59, 0xaf, 0xa6, 0x61, 0x21, 0x55, 0x2b, 0x5e, 0x43,
-	0x48, 0xf5, 0x76, 0x04, 0x97, 0x04, 0x2f, 0x9d, 0x9e, 0x2c, 0x23, 0xd5, 0xfc, 0xe1, 0xff, 0xfc,
-	0x64, 0x79, 0x31, 0x36, 0xda, 0x3c, 0xa5, 0x45, 0xd8, 0xe2, 0x5f, 0xfd, 0xc8, 0x8d, 0xe4, 0xab,
-	0x1f, 0x29, 0xd3, 0x3b, 0x29, 0xef, 0xfa, 0xdb, 0x0c, 0x9a, 0x8b, 0x4f, 0x24, 0xbe, 0x89, 0xf2,
-	0x3d, 0x8f, 0x34, 0xad, 0xc7, 0xc9, 0x2f, 0x7e, 0xec, 0x71, 0x28, 0x48, 0x2c, 0x76, 0x13, 0xc7,
-	0x1c, 0x77, 0x2f, 0xfd, 0xfa, 0xb7, 0x5c, 0xb0, 0x86, 0x1c, 0x6d, 0x30, 0x85, 0x4d, 0x8b, 0xd8,
-	0x0d, 0x71, 0x23, 0x3a, 0x0e, 0x85, 0x1b, 0x5c, 0x3c, 0x48, 0x35, 0xf8, 0x3d, 0x54, 0x34, 0x3d,
-	0xc2, 0xb6, 0x85, 0xb5, 0x63, 0x59, 0xf2, 0xfd, 0xe2, 0xf9, 0x5c, 0xf6, 0xc0, 0xea, 0x92, 0x30,
-	0x1c, 0xd7, 0x02, 0x21, 0x10, 0xca, 0xc3, 0xb7, 0x11, 0x32, 0x9a, 0x94, 0x78, 0x3a, 0x35, 0x3c,
-	0x2a, 0x37, 0x27, 0xaa, 0x95, 0xa3, 0xaa, 0x30, 0x10, 0xa1, 0x5a, 0xf9, 0x22, 0x8b, 0xe6, 0xe2,
-	0x6d, 0xd3, 0x4f, 0xe9, 0x36, 0xfb, 0x15, 0x54, 0xe0, 0x3b, 0xc1, 0xaa, 0xe7, 0x24, 0xbf, 0xf0,
-	0x73, 0x20, 0xe1, 0xa0, 0x28, 0x30, 0xa0, 0xa2, 0xf1, 0xe5, 0x3e, 0xda, 0x24, 0xae, 0xf0, 0xd4,
-	0xe7, 0x9a, 0x42, 0x31, 0x4c, 0xa6, 0x1f, 0x90, 0x5f, 0xec, 0xe2, 0x9b, 0xcb, 0x54, 0x60, 0x08,
-	0xc5, 0x30, 0xcf, 0xf7, 0x48, 0x2b, 0xd8, 0x2b, 0x46, 0x3c, 0x1f, 0x38, 0x14, 0x24, 0x16, 0xbf,
-	0x8c, 0xa6, 0x3d, 0xd7, 0x26, 0x55, 0xd8, 0x95, 0x37, 0xd8, 0xea, 0xf4, 0x00, 0x04, 0x18, 0x02,
-	0xfc, 0x38, 0x6a, 0xe5, 0xb8, 0x03, 0x4c, 0x6a, 0xa1, 0xf8, 0x9b, 0x1c, 0x9a, 0x8b, 0x77, 0xca,
-	0xc7, 0xa7, 0x55, 0x1b, 0xc3, 0xb4, 0x4e, 0x8d, 0x7a, 0x5a, 0x33, 0x67, 0x4e, 0xeb, 0x4b, 0x28,
-	0x77, 0xd4, 0x27, 0xfd, 0xe0, 0x4b, 0x52, 0xaa, 0x0e, 0xdf, 0x67, 0x40, 0x10, 0x38, 0x5c, 0x45,
-	0xf3, 0x8f, 0x0c, 0x8b, 0xb2, 0x00, 0xd7, 0x89, 0xe9, 0x3a, 0x0d, 0x71, 0xc8, 0x97, 0x89, 0xde,
-	0x84, 0xc7, 0xd0, 0x90, 0xa4, 0xbf, 0x88, 0xfb, 0x5c, 0xac, 0xd0, 0x7d, 0x13, 0xcd, 0x71, 0x23,
-	0xab, 0xa6, 0xe9, 0xf6, 0xf9, 0x3d, 0x49, 0x21, 0x7e, 0x46, 0xb0, 0x1f, 0xc5, 0xae, 0x43, 0x82,
-	0x3a, 0xee, 0xac, 0xa3, 0xf9, 0x4c, 0x42, 0xdc, 0x65, 0x26, 0xe5, 0xac, 0xbf, 0x8d, 0x0a, 0x81,
-	0x5f, 0xb0, 0x42, 0x54, 0xf1, 0x85, 0x85, 0x28, 0x73, 0x11, 0x2e, 0x64, 0x15, 0x15, 0xdd, 0x1e,
-	0xf1, 0x8c, 0xb4, 0x0b, 0xce, 0xfb, 0x01, 0x02, 0x42, 0x9a, 0xb0, 0xe9, 0x30, 0x73, 0x46, 0xd3,
-	0xe1, 0x77, 0x35, 0x14, 0x7c, 0xc1, 0x04, 0xaf, 0xa3, 0x5c, 0xcf, 0xf5, 0x68, 0x70, 0x33, 0xb1,
-	0x9c, 0xee, 0xce, 0xe2, 0xe6, 0xd9, 0xf5, 0x68, 0x28, 0x91, 0xfd, 0xf2, 0x41, 0x30, 0x33, 0x3b,
-	0x4d, 0xbb, 0xef, 0x53, 0xe2, 0x6d, 0xee, 0x25, 0xed, 0x5c, 0x0b, 0x10, 0x10, 0xd2, 0xac, 0xfc,
-	0x4f, 0x06, 0x2d, 0x24, 0x5f, 0x0e, 0xc0, 0x1f, 0xa2, 0x59, 0xdf, 0x6a, 0x39, 0x96, 0xd3, 0x92,
-	0x47, 0x40, 0xda, 0x85, 0xdb, 0xf2, 0xf4, 0x28, 0x3f, 0xc4, 0xc5, 0xe1, 0x0d, 0x94, 0xa3, 0xbc,
-	0x15, 0xe9, 0x42, 0xa1, 0x5b, 0x14, 0xa7, 0x5d, 0x1d, 0xe2, 0x80, 0x60, 0x8f, 0x66, 0xb5, 0xcc,
-	0xe4, 0xb2, 0xda, 0xa7, 0x83, 0xfd, 0xb2, 0x1f, 0x8c, 0xf8, 0xf5, 0x8c, 0x49, 0x45, 0xc0, 0x7f,
-	0xe7, 0xd0, 0xb3, 0xe9, 0x2f, 0x62, 0x3c, 0xa5, 0x1d, 0x43, 0xd8, 0xce, 0x36, 0x35, 0xb4, 0x9d,
-	0x8d, 0xaa, 0x0a, 0x25, 0x33, 0xa2, 0x17, 0x2b, 0xd4, 0x00, 0x9c, 0x51, 0xa4, 0x44, 0xf7, 0x32,
-	0xd9, 0x27, 0xee, 0x65, 0x6e, 0xa2, 0x7c, 0xbd, 0x6f, 0x76, 0xe4, 0xe9, 0x69, 0xf4, 0x7b, 0x78,
-	0x1c, 0x0a, 0x12, 0x1b, 0x49, 0x3a, 0xf9, 0x33, 0x93, 0x0e, 0x4b, 0xa2, 0x7d, 0xda, 0x16, 0x0d,
-	0x7c, 0xd3, 0x17, 0x4f, 0xa2, 0x01, 0x2f, 0x84, 0x62, 0x78, 0xbb, 0x6b, 0xcf, 0x3a, 0x84, 0x6d,
-	0xb9, 0xfe, 0x87, 0xed, 0xae, 0x7b, 0x9b, 0x87, 0xb0, 0x0d, 0x12, 0x8b, 0x7f, 0x34, 0xb8, 0xde,
-	0x9b, 0x63, 0x79, 0xf9, 0x67, 0x52, 0x5e, 0x6f, 0xa2, 0xc5, 0x81, 0x39, 0x3f, 0x77, 0x3d, 0x73,
-	0x13, 0xe5, 0xfd, 0x7e, 0x93, 0xd1, 0x4d, 0xc5, 0xe9, 0x74, 0x0e, 0x05, 0x89, 0x5d, 0xf9, 0x61,
-	0x96, 0x69, 0x49, 0xbc, 0xb2, 0xf3, 0x94, 0xa2, 0xea, 0x0d, 0x34, 0x2b, 0x2a, 0x8a, 0x07, 0x91,
-	0x86, 0xf5, 0x42, 0xa4, 0x65, 0x2e, 0x8a, 0x84, 0x38, 0x2d, 0xde, 0xe4, 0x6e, 0x72, 0xe1, 0x3d,
-	0x39, 0x92, 0x9e, 0xc4, 0x52, 0xa8, 0x14, 0x80, 0x5f, 0x45, 0x25, 0xfe, 0x10, 0x62, 0xc8, 0x65,
-	0x69, 0xcd, 0x3b, 0x4c, 0xef, 0x84, 0x60, 0x88, 0xd2, 0xc4, 0xcf, 0xf9, 0x72, 0x23, 0x39, 0xe7,
-	0x1b, 0x98, 0x95, 0x49, 0xf9, 0xdd, 0x3f, 0x68, 0xa8, 0xa8, 0x8e, 0xa2, 0xf8, 0xa7, 0x30, 0x8d,
-	0x35, 0xe2, 0x51, 0x7e, 0xa0, 0xad, 0x25, 0x3e, 0x85, 0x59, 0x0d, 0x30, 0x10, 0xa1, 0x62, 0x5b,
-	0x37, 0xd1, 0xba, 0xa2, 0xf8, 0x12, 0x77, 0x48, 0x6b, 0x31, 0x2c, 0x24, 0xa8, 0xb9, 0x23, 0x70,
-	0xc8, 0x16, 0x39, 0xe6, 0xec, 0xc9, 0xde, 0xc9, 0x28, 0x12, 0xe2, 0xb4, 0x2b, 0xff, 0x98, 0x47,
-	0xea, 0x03, 0x6f, 0xb8, 0x3f, 0xf0, 0xa9, 0xbd, 0xbb, 0x23, 0xea, 0x5f, 0x38, 0x6b, 0xfc, 0xf1,
-	0x5b, 0x08, 0xcb, 0x2f, 0xbd, 0xc9, 0xfd, 0x68, 0xe4, 0xd3, 0xd8, 0xaa, 0xbd, 0x57, 0x1f, 0xa0,
-	0x80, 0x14, 0x2e, 0xfc, 0x16, 0x2a, 0x9a, 0xae, 0x43, 0x0d, 0xcb, 0x51, 0xa9, 0xe4, 0xc5, 0x21,
-	0x6d, 0x87, 0x82, 0x48, 0xac, 0xa5, 0xea, 0x27, 0x84, 0xec, 0xf8, 0x0e, 0x9a, 0x7e, 0xe8, 0xda,
-	0xfd, 0xae, 0x3c, 0x30, 0x2a, 0xdd, 0x5e, 0x4a, 0x93, 0xf4, 0x36, 0x27, 0x09, 0x37, 0xf2, 0xe2,
-	0xb7, 0x0f, 0x01, 0x2f, 0x26, 0x68, 0x9e, 0x5f, 0x25, 0x58, 0xf4, 0x58, 0x46, 0xb4, 0xbc, 0x7d,
-	0xbb, 0x99, 0x26, 0x6e, 0xcf, 0x6d, 0xe8, 0x71, 0x6a, 0xf9, 0xf9, 0xe2, 0x38, 0x10, 0x92, 0x32,
-	0xf1, 0x06, 0x2a, 0x18, 0xcd, 0xa6, 0xe5, 0x58, 0xf4, 0x58, 0x9e, 0xb0, 0xbe, 0x90, 0x26, 0xbf,
-	0x2a, 0x69, 0xe4, 0xeb, 0x1b, 0xf2, 0x17, 0x28, 0x5e, 0x7c, 0x88, 0x4a, 0xd4, 0xb5, 0xe5, 0x96,
-	0xd7, 0x97, 0x85, 0xeb, 0xf5, 0x34, 0x51, 0x07, 0x8a, 0x2c, 0x3c, 0xc3, 0x0e, 0x61, 0x3e, 0x44,
-	0xe5, 0xe0, 0x3f, 0xd1, 0xd0, 0x8c, 0xe3, 0x36, 0x48, 0xb0, 0x96, 0xc8, 0xef, 0xc2, 0xbd, 0x3b,
-	0xa2, 0xcf, 0x15, 0x56, 0x76, 0x23, 0xb2, 0x45, 0xc8, 0xab, 0xef, 0x27, 0x46, 0x51, 0x10, 0x33,
-	0x62, 0xe9, 0x5b, 0x68, 0x71, 0x80, 0xf1, 0x42, 0xe1, 0xff, 0xe7, 0x1a, 0x4a, 0xf6, 0x3f, 0xb3,
-	0xfd, 0x7a, 0xc3, 0xf2, 0xb8, 0xc0, 0xe3, 0xe4, 0xf1, 0xec, 0x7a, 0x80, 0x80, 0x90, 0x06, 0xdf,
-	0x40, 0xd9, 0x5e, 0x18, 0xf7, 0xea, 0x4a, 0x8d, 0xc7, 0x2b, 0xc7, 0xb0, 0x75, 0x85, 0xfd, 0x0f,
-	0xa4, 0x45, 0x1e, 0xf7, 0x64, 0x80, 0xab, 0x75, 0x65, 0x4f, 0x61, 0x20, 0x42, 0xb5, 0xf2, 0x9f,
-	0x19, 0x34, 0x17, 0xcf, 0x24, 0x6c, 0xbf, 0x43, 0x9c, 0x46, 0xcf, 0xb5, 0x1c, 0x9a, 0xfc, 0x96,
-	0xf3, 0x1d, 0x09, 0x07, 0x45, 0xc1, 0xb2, 0x62, 0x97, 0xd0, 0xb6, 0xdb, 0x48, 0x66, 0xc5, 0x1d,
-	0x0e, 0x05, 0x89, 0xe5, 0xe6, 0xbb, 0x1e, 0x95, 0x66, 0x85, 0xe6, 0xbb, 0x1e, 0x05, 0x8e, 0x09,
-	0x6e, 0x04, 0xb3, 0x43, 0x6e, 0x04, 0xdf, 0x44, 0x73, 0x2c, 0x94, 0x89, 0xa7, 0x56, 0xc0, 0x5c,
-	0xe2, 0x82, 0x3b, 0x86, 0x85, 0x04, 0x35, 0x5b, 0x01, 0x05, 0x24, 0x58, 0x01, 0x13, 0x2f, 0x17,
-	0xe8, 0x51, 0x24, 0xc4, 0x69, 0xc7, 0x71, 0x4c, 0x13, 0x1f, 0xf5, 0x09, 0x65, 0xa2, 0x5a, 0xe5,
-	0xb3, 0xcf, 0xaf, 0x3f, 0xf3, 0x93, 0xcf, 0xaf, 0x3f, 0xf3, 0xd3, 0xcf, 0xaf, 0x3f, 0xf3, 0xdd,
-	0xd3, 0xeb, 0xda, 0x67, 0xa7, 0xd7, 0xb5, 0x9f, 0x9c, 0x5e, 0xd7, 0x7e, 0x7a, 0x7a, 0x5d, 0xfb,
-	0xd9, 0xe9, 0x75, 0xed, 0x87, 0xff, 0x76, 0xfd, 0x99, 0x6f, 0x17, 0x02, 0x2b, 0xff, 0x2f, 0x00,
-	0x00, 0xff, 0xff, 0xa8, 0x92, 0x78, 0xd4, 0x80, 0x62, 0x00, 0x00,
+	// 4745 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3c, 0x4b, 0x6c, 0x24, 0xd7,
+	0x71, 0x6a, 0xce, 0x87, 0x33, 0x6f, 0xb8, 0xfc, 0x3c, 0xee, 0x4a, 0x2d, 0x5a, 0x22, 0x37, 0x14,
+	0x2c, 0xac, 0x62, 0x79, 0x18, 0x6d, 0x12, 0x47, 0x96, 0x60, 0x19, 0x33, 0x24, 0x77, 0x97, 0xe2,
+	0x67, 0xc9, 0x6a, 0xae, 0x24, 0x5b, 0x96, 0xe4, 0x9e, 0x9e, 0x37, 0xc3, 0x16, 0x9b, 0xdd, 0xc3,
+	0xee, 0x37, 0xdc, 0xa5, 0x82, 0x24, 0x46, 0x80, 0x7c, 0x14, 0xd9, 0xb1, 0x83, 0x38, 0x41, 0x80,
+	0xdc, 0x72, 0xc9, 0xef, 0x9a, 0x4b, 0x80, 0xe4, 0x90, 0x9b, 0x80, 0x5c, 0x7c, 0x34, 0x10, 0x80,
+	0xb1, 0x98, 0x5c, 0x73, 0x49, 0x2e, 0x82, 0x83, 0x00, 0xc1, 0xfb, 0xf4, 0xef, 0x4d, 0x0f, 0x97,
+	0x23, 0xce, 0xcc, 0x5e, 0x7c, 0xd9, 0xe5, 0x54, 0xd5, 0xab, 0xaa, 0x7e, 0xaf, 0xea, 0xd5, 0xab,
+	0x57, 0xd5, 0x8d, 0xb6, 0xdb, 0x36, 0x3d, 0xe8, 0x36, 0xaa, 0x96, 0x77, 0xb4, 0x62, 0xfa, 0x6d,
+	0xaf, 0xe3, 0x7b, 0x1f, 0xf2, 0x3f, 0xbe, 0x4a, 0x4e, 0x88, 0x4b, 0x83, 0x95, 0xce, 0x61, 0x7b,
+	0xc5, 0xec, 0xd8, 0xc1, 0x8a, 0xf8, 0xed, 0x75, 0x7d, 0x8b, 0xac, 0x9c, 0xbc, 0x62, 0x3a, 0x9d,
+	0x03, 0xf3, 0x95, 0x95, 0x36, 0x71, 0x89, 0x6f, 0x52, 0xd2, 0xac, 0x76, 0x7c, 0x8f, 0x7a, 0xf8,
+	0x1b, 0x31, 0xbb, 0x6a, 0xc8, 0x8e, 0xff, 0xf1, 0x81, 0x18, 0x5e, 0xed, 0x1c, 0xb6, 0xab, 0x8c,
+	0x5d, 0x35, 0xc1, 0xae, 0x1a, 0xb2, 0x5b, 0xf8, 0xe6, 0xa5, 0xb5, 0xb1, 0xbc, 0xa3, 0x23, 0xcf,
+	0x55, 0xe5, 0x2f, 0x7c, 0x35, 0xc1, 0xa0, 0xed, 0xb5, 0xbd, 0x15, 0x0e, 0x6e, 0x74, 0x5b, 0xfc,
+	0x17, 0xff, 0xc1, 0xff, 0x92, 0xe4, 0xcb, 0x87, 0xaf, 0x06, 0x55, 0xdb, 0x63, 0x2c, 0x57, 0x2c,
+	0xcf, 0x67, 0x0f, 0xd6, 0xc3, 0xf2, 0xd7, 0x62, 0x9a, 0x23, 0xd3, 0x3a, 0xb0, 0x5d, 0xe2, 0x9f,
+	0xc6, 0x7a, 0x1c, 0x11, 0x6a, 0x66, 0x8d, 0x5a, 0xe9, 0x37, 0xca, 0xef, 0xba, 0xd4, 0x3e, 0x22,
+	0x3d, 0x03, 0xbe, 0xf6, 0xb8, 0x01, 0x81, 0x75, 0x40, 0x8e, 0x4c, 0x75, 0xdc, 0xf2, 0x3f, 0x14,
+	0xd0, 0x4c, 0x6d, 0x7b, 0x6f, 0x77, 0x9d, 0x4d, 0x90, 0xc1, 0xe7, 0x13, 0x3f, 0x8f, 0x72, 0x5d,
+	0xdf, 0xd1, 0xb5, 0x9b, 0xda, 0xad, 0x72, 0xbd, 0xf2, 0xe9, 0xd9, 0xd2, 0x53, 0xe7, 0x67, 0x4b,
+	0xb9, 0x07, 0xb0, 0x05, 0x0c, 0x8e, 0x5f, 0x45, 0x53, 0xe4, 0x91, 0x75, 0x60, 0xba, 0x6d, 0xb2,
+	0x63, 0x1e, 0x11, 0x7d, 0x82, 0xd3, 0x5d, 0x97, 0x74, 0x53, 0xeb, 0x09, 0x1c, 0xa4, 0x28, 0x93,
+	0x23, 0xf7, 0x4f, 0x3b, 0x44, 0xcf, 0x65, 0x8f, 0x64, 0x38, 0x48, 0x51, 0xe2, 0xdb, 0x08, 0xf9,
+	0x5e, 0x97, 0xda, 0x6e, 0x7b, 0x93, 0x9c, 0xea, 0x79, 0x3e, 0x0e, 0xcb, 0x71, 0x08, 0x22, 0x0c,
+	0x24, 0xa8, 0xf0, 0x6f, 0xa1, 0x39, 0xcb, 0x73, 0x5d, 0x62, 0x51, 0xdb, 0x73, 0xeb, 0xa6, 0x75,
+	0xe8, 0xb5, 0x5a, 0x7a, 0xe1, 0xa6, 0x76, 0xab, 0x72, 0xfb, 0xd5, 0xea, 0xa5, 0x0d, 0x4d, 0x58,
+	0x4a, 0x55, 0x8e, 0xaf, 0xdf, 0x38, 0x3f, 0x5b, 0x9a, 0x5b, 0x55, 0xd9, 0x42, 0xaf, 0x24, 0xfc,
+	0x32, 0x2a, 0x7d, 0x18, 0x78, 0x6e, 0xdd, 0x6b, 0x9e, 0xea, 0xc5, 0x9b, 0xda, 0xad, 0x52, 0x7d,
+	0x56, 0x2a, 0x5c, 0x7a, 0xd3, 0xb8, 0xbf, 0xc3, 0xe0, 0x10, 0x51, 0x60, 0x0b, 0xe5, 0xa8, 0x13,
+	0xe8, 0x93, 0x5c, 0xbd, 0x7b, 0xd5, 0x2b, 0xf9, 0x41, 0x75, 0x7f, 0xcb, 0x58, 0xf5, 0xdc, 0x96,
+	0xdd, 0xae, 0x4f, 0xb2, 0x95, 0xdb, 0xdf, 0x32, 0x80, 0x71, 0xc7, 0x7f, 0xa4, 0xa1, 0x12, 0xb3,
+	0xb8, 0xa6, 0x49, 0x4d, 0xbd, 0x74, 0x33, 0x77, 0xab, 0x72, 0xfb, 0x3b, 0x57, 0x14, 0xa5, 0xd8,
+	0x4e, 0x75, 0x5b, 0xb2, 0x5f, 0x77, 0xa9, 0x7f, 0x1a, 0x3f, 0x71, 0x08, 0x86, 0x48, 0xfe, 0xc2,
+	0xeb, 0xe8, 0x5a, 0x8a, 0x18, 0xcf, 0xa2, 0xdc, 0x21, 0x39, 0x15, 0x66, 0x07, 0xec, 0x4f, 0x7c,
+	0x1d, 0x15, 0x4e, 0x4c, 0xa7, 0x2b, 0x4d, 0x0c, 0xc4, 0x8f, 0xd7, 0x26, 0x5e, 0xd5, 0x96, 0x7f,
+	0x9c, 0x47, 0xcf, 0xd6, 0x3e, 0xea, 0xfa, 0x84, 0xcb, 0x0e, 0xee, 0x75, 0x1b, 0x49, 0x03, 0xbe,
+	0x89, 0xf2, 0xad, 0xe3, 0xa6, 0x2b, 0x2d, 0x78, 0x4a, 0x2a, 0x91, 0xbf, 0xb3, 0xb7, 0xb6, 0x03,
+	0x1c, 0x83, 0x3b, 0x68, 0x3e, 0x38, 0x30, 0x7d, 0xd2, 0xac, 0x59, 0x16, 0x09, 0x82, 0x4d, 0x72,
+	0x1a, 0x99, 0x72, 0xe5, 0xf6, 0x97, 0xab, 0xc2, 0x99, 0xd8, 0x63, 0x57, 0x99, 0x5f, 0x57, 0x4f,
+	0x5e, 0xa9, 0x1a, 0xc4, 0xf2, 0x09, 0xdd, 0x24, 0xa7, 0x06, 0x71, 0x88, 0x45, 0x3d, 0xbf, 0xfe,
+	0xcc, 0xf9, 0xd9, 0xd2, 0xbc, 0xd1, 0xcb, 0x05, 0xb2, 0x58, 0xe3, 0x26, 0x9a, 0x51, 0xc0, 0x7a,
+	0x6e, 0x10, 0x69, 0xf3, 0xe7, 0x67, 0x4b, 0x33, 0x8a, 0x34, 0x50, 0x59, 0xe2, 0x97, 0xd0, 0xe4,
+	0x41, 0xb7, 0xc1, 0x9f, 0x45, 0x38, 0xc9, 0x8c, 0x7c, 0xf8, 0xc9, 0x7b, 0x02, 0x0c, 0x21, 0x1e,
+	0xff, 0x38, 0x69, 0x0c, 0x05, 0x6e, 0x0c, 0xad, 0xab, 0x1a, 0x43, 0xbf, 0x15, 0x19, 0x97, 0x59,
+	0x7c, 0x9e, 0x43, 0xf3, 0xab, 0xa6, 0x43, 0xdc, 0xa6, 0xe9, 0x27, 0x0d, 0xe2, 0x65, 0x54, 0x62,
+	0xfb, 0x5f, 0xb3, 0xeb, 0x10, 0x69, 0x14, 0x91, 0x0a, 0x86, 0x84, 0x43, 0x44, 0xc1, 0xa8, 0x6d,
+	0x97, 0x12, 0xff, 0xc4, 0x74, 0xf4, 0x89, 0x34, 0xf5, 0x86, 0x84, 0x43, 0x44, 0x81, 0x5f, 0x43,
+	0xd3, 0xe4, 0x91, 0xe5, 0x74, 0x03, 0xdb, 0x73, 0xd7, 0x4c, 0x4a, 0x02, 0x3d, 0x77, 0x33, 0xc7,
+	0xb6, 0xa7, 0xf3, 0xb3, 0xa5, 0xe9, 0xf5, 0x14, 0x06, 0x14, 0x4a, 0x26, 0x89, 0x6d, 0xce, 0x1f,
+	0x79, 0x6e, 0xb8, 0x5e, 0x91, 0xa4, 0x7d, 0x09, 0x87, 0x88, 0x02, 0x6f, 0xa3, 0x4a, 0x37, 0x20,
+	0xfe, 0xae, 0x79, 0xea, 0x78, 0x66, 0x93, 0x6f, 0x65, 0x53, 0xf5, 0xaf, 0x9c, 0x9f, 0x2d, 0x55,
+	0x1e, 0xc4, 0xe0, 0x9f, 0x9f, 0x2d, 0xe9, 0xc4, 0xb5, 0xbc, 0xa6, 0xed, 0xb6, 0x57, 0xd8, 0xf6,
+	0x52, 0x05, 0xf3, 0xe1, 0x36, 0x09, 0x02, 0xb3, 0x4d, 0x20, 0x39, 0x1e, 0xff, 0x71, 0xd2, 0x00,
+	0x8a, 0xdc, 0x00, 0xbe, 0x7b, 0x45, 0x03, 0xc8, 0x98, 0xfb, 0x71, 0x2d, 0xfd, 0xdf, 0x14, 0x11,
+	0x5e, 0x3f, 0xb2, 0x29, 0x25, 0xa9, 0x95, 0x7f, 0x11, 0x15, 0x1b, 0xbe, 0x77, 0x48, 0x7c, 0xb9,
+	0xee, 0xd3, 0x52, 0x7e, 0xb1, 0xce, 0xa1, 0x20, 0xb1, 0x2c, 0xc0, 0xb0, 0x70, 0xe3, 0x12, 0x87,
+	0x79, 0xe6, 0x44, 0x3a, 0xc0, 0xac, 0x46, 0x18, 0x48, 0x50, 0xe1, 0x5f, 0x47, 0x15, 0xf9, 0x8b,
+	0x3b, 0x9c, 0x88, 0x66, 0xf3, 0x72, 0x50, 0x65, 0x35, 0x46, 0x41, 0x92, 0x0e, 0xdf, 0x47, 0x25,
+	0xb6, 0x0c, 0x6e, 0xe8, 0xa4, 0x97, 0xde, 0x02, 0xa6, 0xd8, 0xbc, 0x3d, 0x90, 0x43, 0x21, 0x62,
+	0xc2, 0x18, 0x76, 0xcc, 0x20, 0x78, 0xe8, 0xf9, 0x4d, 0xbd, 0x30, 0x30, 0xc3, 0x5d, 0x39, 0x14,
+	0x22, 0x26, 0xd9, 0x91, 0xb3, 0xf8, 0x44, 0x22, 0xe7, 0xe4, 0x65, 0x23, 0x67, 0x69, 0xa4, 0x91,
+	0xf3, 0x07, 0x49, 0x5f, 0x29, 0x73, 0x5f, 0xf9, 0xe0, 0x8a, 0xa2, 0x7a, 0x8d, 0x75, 0x5c, 0xae,
+	0xf2, 0x6f, 0x13, 0xa8, 0x92, 0xf4, 0x91, 0xef, 0x26, 0x9e, 0x4d, 0xe3, 0xd3, 0xf8, 0x2b, 0x09,
+	0xfb, 0x89, 0x8e, 0x93, 0xf1, 0xf3, 0x30, 0x6a, 0x66, 0x51, 0xf7, 0x1b, 0x1f, 0x12, 0x8b, 0x32,
+	0x45, 0x62, 0x5f, 0x89, 0x61, 0xb1, 0xba, 0xb8, 0x83, 0xf2, 0x41, 0x87, 0x58, 0x32, 0xbe, 0xee,
+	0x5c, 0x75, 0xe6, 0x62, 0xdd, 0x8d, 0x0e, 0xb1, 0xe2, 0x00, 0xcf, 0x7e, 0x01, 0x97, 0x84, 0x1f,
+	0xa1, 0x62, 0x40, 0x4d, 0xda, 0x0d, 0x64, 0x94, 0xdd, 0x1d, 0xa2, 0x4c, 0xce, 0x37, 0xde, 0x49,
+	0xc4, 0x6f, 0x90, 0xf2, 0x96, 0x7f, 0xa6, 0xa1, 0x99, 0x04, 0xf5, 0x96, 0x1d, 0x50, 0xfc, 0x9d,
+	0x9e, 0x19, 0xae, 0x5e, 0x6e, 0x86, 0xd9, 0x68, 0x3e, 0xbf, 0x91, 0x31, 0x84, 0x90, 0xc4, 0xec,
+	0x7a, 0xa8, 0x60, 0x53, 0x72, 0x14, 0xe8, 0x13, 0xdc, 0x30, 0xdf, 0x1c, 0xde, 0xa3, 0xd6, 0xaf,
+	0x49, 0xb1, 0x85, 0x0d, 0x26, 0x00, 0x84, 0x9c, 0xe5, 0xbf, 0xfd, 0x5a, 0xea, 0x11, 0xd9, 0xb4,
+	0xf3, 0xb3, 0x3d, 0x03, 0xd5, 0xbb, 0x01, 0xdf, 0x0d, 0x35, 0xe5, 0x6c, 0x9f, 0xc0, 0x41, 0x8a,
+	0x12, 0x77, 0x51, 0x89, 0x92, 0xa3, 0x8e, 0x63, 0xd2, 0xf0, 0x00, 0x76, 0xf7, 0xaa, 0x5e, 0x2c,
+	0xd9, 0x25, 0xa2, 0xa9, 0x84, 0x40, 0x24, 0x0a, 0x1f, 0xa1, 0xc9, 0x80, 0xf8, 0x27, 0xb6, 0x45,
+	0xa4, 0x89, 0xdc, 0xb9, 0xa2, 0x54, 0x43, 0x70, 0xab, 0x57, 0xd8, 0x71, 0x4b, 0xfe, 0x80, 0x50,
+	0x06, 0xfe, 0x32, 0x9a, 0xf4, 0x49, 0xc7, 0xb1, 0x2d, 0x93, 0x6f, 0xfa, 0x05, 0x41, 0x06, 0x02,
+	0x04, 0x21, 0x0e, 0xff, 0x36, 0x2a, 0x1c, 0xd9, 0xae, 0xed, 0xc9, 0x13, 0xd9, 0xb7, 0x86, 0xeb,
+	0x2a, 0xd5, 0x6d, 0xc6, 0x5b, 0x6c, 0x2f, 0xd1, 0xd2, 0x72, 0x18, 0x08, 0xb1, 0x3c, 0x45, 0xb0,
+	0x64, 0x14, 0xd7, 0x8b, 0x43, 0x49, 0x11, 0x54, 0x1d, 0xa2, 0x43, 0x42, 0x7a, 0x97, 0x0b, 0xc1,
+	0x10, 0xc9, 0xc7, 0x1f, 0xa1, 0x7c, 0xcb, 0x76, 0x88, 0x3e, 0xc9, 0xf5, 0x78, 0x67, 0xc8, 0x7a,
+	0xdc, 0xb1, 0x1d, 0x22, 0x74, 0x88, 0x33, 0x04, 0xdb, 0x21, 0xc0, 0x65, 0xf2, 0x89, 0xf0, 0x89,
+	0xe0, 0x31, 0xa4, 0x5c, 0x49, 0x55, 0x00, 0x24, 0x7b, 0x65, 0x22, 0x42, 0x30, 0x44, 0xf2, 0xf1,
+	0xef, 0x6b, 0x68, 0xf2, 0x21, 0x69, 0x1c, 0x78, 0xde, 0xa1, 0x8c, 0x3e, 0xef, 0x0e, 0x59, 0x97,
+	0xb7, 0x05, 0x77, 0xa1, 0x4a, 0x94, 0x34, 0x48, 0x28, 0x84, 0xc2, 0xd9, 0x8a, 0x98, 0x47, 0xc7,
+	0x1d, 0x1d, 0x8d, 0x64, 0x45, 0x6a, 0x47, 0xc7, 0x1d, 0x65, 0x45, 0x58, 0x7e, 0x09, 0x5c, 0x26,
+	0x73, 0x8d, 0x43, 0xb3, 0x75, 0x68, 0xea, 0x95, 0x91, 0xb8, 0xc6, 0x26, 0xe3, 0xad, 0xb8, 0x06,
+	0x87, 0x81, 0x10, 0xcb, 0x9e, 0xfd, 0xe8, 0x98, 0x52, 0x7d, 0x6a, 0x24, 0xcf, 0xbe, 0x7d, 0x4c,
+	0xa9, 0xf2, 0xec, 0xdb, 0x7b, 0xfb, 0xfb, 0xc0, 0x65, 0x32, 0xd9, 0xae, 0x49, 0x03, 0xfd, 0xda,
+	0x48, 0x64, 0xef, 0x98, 0x34, 0x50, 0x64, 0xef, 0xd4, 0xf6, 0x0d, 0xe0, 0x32, 0xf1, 0x09, 0xca,
+	0x05, 0x6e, 0xa0, 0x4f, 0x73, 0xd1, 0x6f, 0x0f, 0x59, 0xb4, 0xe1, 0x4a, 0xc9, 0xd1, 0x3d, 0x93,
+	0xb1, 0x63, 0x00, 0x13, 0xc8, 0xe5, 0x1e, 0x07, 0xfa, 0xcc, 0x68, 0xe4, 0x1e, 0xf7, 0xc8, 0xdd,
+	0x63, 0x72, 0x8f, 0x03, 0xfc, 0xbb, 0x1a, 0x2a, 0x76, 0xba, 0x0d, 0xa3, 0xdb, 0xd0, 0x67, 0xb9,
+	0xec, 0x6f, 0x0f, 0x59, 0xf6, 0x2e, 0x67, 0x2e, 0xc4, 0x47, 0xa7, 0x08, 0x01, 0x04, 0x29, 0x99,
+	0x2b, 0x21, 0xa4, 0xea, 0x73, 0x23, 0x51, 0xe2, 0x2e, 0xe7, 0xa6, 0x28, 0x21, 0x80, 0x20, 0x25,
+	0x87, 0x4a, 0x38, 0x66, 0x43, 0xc7, 0xa3, 0x52, 0xc2, 0x31, 0x33, 0x94, 0x70, 0x4c, 0xa1, 0x84,
+	0x63, 0x36, 0x98, 0xe9, 0x1f, 0x34, 0x5b, 0x81, 0x3e, 0x3f, 0x12, 0xd3, 0xbf, 0xd7, 0x6c, 0xa9,
+	0xa6, 0x7f, 0x6f, 0xed, 0x8e, 0x01, 0x5c, 0x26, 0xdb, 0x72, 0x02, 0xc7, 0xb4, 0x0e, 0xf5, 0xeb,
+	0x23, 0xd9, 0x72, 0x0c, 0xc6, 0x5b, 0xd9, 0x72, 0x38, 0x0c, 0x84, 0x58, 0xfc, 0xe7, 0x1a, 0xaa,
+	0x04, 0xd4, 0xf3, 0xcd, 0x36, 0xb9, 0xeb, 0xdb, 0x4d, 0xfd, 0xc6, 0x70, 0x32, 0x0f, 0x55, 0x8d,
+	0x58, 0x82, 0x50, 0x26, 0xca, 0x61, 0x13, 0x18, 0x48, 0x2a, 0x82, 0xff, 0x4a, 0x43, 0xd3, 0x66,
+	0xea, 0xb6, 0x47, 0x7f, 0x9a, 0xeb, 0xd6, 0x18, 0x76, 0x48, 0x48, 0x5f, 0x29, 0x71, 0xf5, 0x9e,
+	0x96, 0xea, 0x4d, 0xa7, 0x91, 0xa0, 0x68, 0xc4, 0xcd, 0x37, 0xa0, 0xbe, 0xdd, 0x21, 0xfa, 0x33,
+	0x23, 0x31, 0x5f, 0x83, 0x33, 0x57, 0xcc, 0x57, 0x00, 0x41, 0x4a, 0xe6, 0xa1, 0x9b, 0x88, 0x54,
+	0x4f, 0xd7, 0x47, 0x12, 0xba, 0xc3, 0x44, 0x32, 0x1d, 0xba, 0x25, 0x14, 0x42, 0xe1, 0xcc, 0x96,
+	0x7d, 0xd2, 0xb4, 0x03, 0xfd, 0xd9, 0x91, 0xd8, 0x32, 0x30, 0xde, 0x8a, 0x2d, 0x73, 0x18, 0x08,
+	0xb1, 0x6c, 0x3b, 0x77, 0x83, 0x63, 0x7d, 0x61, 0x24, 0xdb, 0xf9, 0x4e, 0x70, 0xac, 0x6c, 0xe7,
+	0x3b, 0xc6, 0x1e, 0x30, 0x81, 0x72, 0x3b, 0x77, 0x02, 0xd3, 0xd7, 0xbf, 0x34, 0xa2, 0xed, 0x9c,
+	0x31, 0xef, 0xd9, 0xce, 0x19, 0x10, 0xa4, 0x64, 0x6e, 0x05, 0xbc, 0xf4, 0x62, 0x5b, 0xfa, 0x73,
+	0x23, 0xb1, 0x82, 0xbb, 0x82, 0xbb, 0x62, 0x05, 0x12, 0x0a, 0xa1, 0x70, 0xfc, 0x0d, 0x34, 0x13,
+	0x74, 0x83, 0x0e, 0x71, 0x9b, 0xa4, 0x29, 0x3c, 0x45, 0x7f, 0x9e, 0x5f, 0x57, 0x8a, 0xfb, 0xe5,
+	0x34, 0x0a, 0x54, 0xda, 0x85, 0x2e, 0x42, 0x71, 0x0a, 0x91, 0x71, 0xe9, 0xb0, 0x97, 0xbc, 0x74,
+	0xa8, 0xdc, 0x7e, 0x7d, 0xe0, 0xdb, 0x22, 0xe3, 0x57, 0x6b, 0x3e, 0xb5, 0x5b, 0xa6, 0x45, 0x13,
+	0x37, 0x16, 0x0b, 0x3f, 0xd4, 0xd0, 0xb5, 0x54, 0xda, 0x90, 0x21, 0xfa, 0x20, 0x2d, 0x1a, 0x86,
+	0x7f, 0x95, 0x99, 0xd4, 0xe8, 0x0f, 0x34, 0x54, 0x8e, 0x12, 0x88, 0x0c, 0x6d, 0x9a, 0x69, 0x6d,
+	0xae, 0x7a, 0xe5, 0xc1, 0x45, 0x65, 0x6b, 0xc2, 0xe6, 0x26, 0x95, 0x49, 0x8c, 0x7e, 0x6e, 0x22,
+	0x71, 0xd9, 0x1a, 0x7d, 0xac, 0xa1, 0xa9, 0x64, 0x3e, 0x91, 0xa1, 0x90, 0x95, 0x56, 0x68, 0xfb,
+	0x8a, 0x0a, 0x49, 0x69, 0xab, 0x9e, 0x4b, 0xc9, 0x23, 0xaa, 0xae, 0x53, 0x94, 0x56, 0x8c, 0x7e,
+	0x9d, 0x94, 0x72, 0x98, 0x32, 0x2b, 0x28, 0xce, 0x31, 0x32, 0x54, 0x21, 0x69, 0x55, 0xee, 0x5f,
+	0x51, 0x15, 0x21, 0xab, 0xbf, 0xf5, 0x46, 0x09, 0xc7, 0xe8, 0x67, 0x85, 0x25, 0x32, 0x7d, 0x34,
+	0xf9, 0x43, 0x0d, 0x95, 0xa3, 0xf4, 0x63, 0xf4, 0x93, 0xc2, 0xd2, 0x1a, 0xb1, 0x95, 0xf5, 0xaa,
+	0xf2, 0x7b, 0x1a, 0x2a, 0x19, 0x6e, 0x5f, 0x4d, 0x86, 0x6c, 0xb2, 0xc6, 0x8e, 0xd1, 0x67, 0x4a,
+	0xb8, 0x1e, 0xc7, 0x63, 0xd3, 0x63, 0xaf, 0x9f, 0x1e, 0x9f, 0x68, 0xa8, 0x92, 0x48, 0x55, 0x32,
+	0x54, 0x69, 0xa5, 0x55, 0xb9, 0xea, 0x1d, 0xab, 0x14, 0xd6, 0x5f, 0x9b, 0x44, 0xce, 0x32, 0x7a,
+	0x6d, 0xa4, 0xb0, 0x0b, 0xb5, 0x71, 0xcc, 0x31, 0x6a, 0xc3, 0x84, 0xf5, 0x77, 0xe7, 0x28, 0x91,
+	0x19, 0xbd, 0x3b, 0xb3, 0x04, 0xe9, 0x82, 0x4d, 0x2e, 0xce, 0x6a, 0x46, 0xef, 0xcf, 0x42, 0x56,
+	0xb6, 0x2e, 0x7f, 0xa6, 0xa1, 0x59, 0x35, 0xb5, 0xc9, 0xd0, 0xe8, 0x30, 0xad, 0xd1, 0x83, 0xab,
+	0x6a, 0x94, 0x90, 0x98, 0xad, 0xd7, 0x5f, 0x6a, 0x68, 0x3e, 0x23, 0xad, 0xc9, 0x50, 0xcd, 0x4d,
+	0xab, 0xf6, 0xce, 0xa8, 0xca, 0xf3, 0xaa, 0x65, 0x27, 0xf2, 0x9a, 0xd1, 0x5b, 0xb6, 0x14, 0x96,
+	0xad, 0xcd, 0x0f, 0x34, 0x34, 0x95, 0xcc, 0x6f, 0x32, 0xd4, 0x69, 0xa7, 0xd5, 0xd9, 0x1b, 0x7a,
+	0x59, 0x4e, 0xb5, 0xef, 0x38, 0xd3, 0x19, 0xbd, 0x7d, 0x0b, 0x59, 0xfd, 0xe3, 0x44, 0x98, 0xf7,
+	0x8c, 0x3e, 0x4e, 0xec, 0x18, 0x7b, 0x17, 0xc6, 0x89, 0x28, 0x07, 0x1a, 0x47, 0x9c, 0xe0, 0xc2,
+	0xfa, 0x5b, 0x4c, 0x32, 0x17, 0x1a, 0xbd, 0xc5, 0x84, 0xd2, 0x32, 0xf5, 0x59, 0xa6, 0x68, 0xae,
+	0xa7, 0x76, 0x88, 0x3f, 0x88, 0xaa, 0x93, 0xa2, 0x1a, 0xf8, 0x1b, 0x83, 0xe7, 0x49, 0x17, 0x17,
+	0x21, 0xff, 0x35, 0x87, 0x66, 0x94, 0x9c, 0x01, 0xaf, 0xa0, 0x32, 0x67, 0xc6, 0x5b, 0xef, 0x44,
+	0x79, 0x6e, 0x4e, 0x0e, 0x2f, 0xaf, 0x87, 0x08, 0x88, 0x69, 0xf0, 0x9f, 0x68, 0x68, 0xe6, 0xa1,
+	0x49, 0xad, 0x83, 0x5d, 0x93, 0x1e, 0x88, 0xb2, 0xf8, 0x90, 0x22, 0xc8, 0xdb, 0x69, 0xae, 0xf5,
+	0x67, 0xa4, 0x1e, 0x33, 0x0a, 0x02, 0x54, 0xf9, 0xac, 0xc1, 0xa9, 0xe3, 0x39, 0x8e, 0xed, 0xb6,
+	0x79, 0xd5, 0xae, 0x14, 0xa7, 0xba, 0xbb, 0x02, 0x0c, 0x21, 0x3e, 0xdd, 0xed, 0x96, 0x1f, 0x4a,
+	0x05, 0x47, 0x99, 0xd2, 0x71, 0x15, 0xec, 0xbf, 0x8e, 0x70, 0xaf, 0x91, 0xe1, 0x17, 0x42, 0x7a,
+	0xb1, 0x96, 0xd1, 0xad, 0xcb, 0x5b, 0x0c, 0x28, 0x87, 0x2f, 0x7f, 0x3e, 0x89, 0xe6, 0x7a, 0x4e,
+	0x32, 0x78, 0x01, 0x4d, 0xd8, 0x4d, 0x3e, 0x2e, 0x57, 0x47, 0x72, 0xdc, 0xc4, 0xc6, 0x1a, 0x4c,
+	0xd8, 0x4d, 0x4c, 0xe3, 0x52, 0xd3, 0x28, 0x92, 0x33, 0x51, 0xf7, 0xec, 0x29, 0x2c, 0xbd, 0x80,
+	0x0a, 0xde, 0x43, 0x97, 0xf8, 0x7a, 0x2e, 0xfd, 0x30, 0xf7, 0x19, 0x10, 0x04, 0x8e, 0x77, 0x81,
+	0x92, 0x8e, 0x17, 0xd8, 0xd4, 0xf3, 0x7b, 0xbb, 0x40, 0x23, 0x0c, 0x24, 0xa8, 0xf0, 0x32, 0x2a,
+	0x0a, 0xad, 0x78, 0x45, 0xb5, 0x5c, 0x47, 0xcc, 0x5b, 0xe4, 0xf5, 0x86, 0xc4, 0xb0, 0x06, 0x1a,
+	0xb3, 0x63, 0xef, 0x7b, 0x87, 0xc4, 0xd5, 0x8b, 0x03, 0x37, 0xd0, 0xd4, 0x76, 0x37, 0xf8, 0x50,
+	0x88, 0x98, 0xe0, 0xf7, 0xd1, 0x35, 0xf9, 0x60, 0x62, 0x8c, 0x3e, 0x39, 0x08, 0xd7, 0xb9, 0xf3,
+	0xb3, 0xa5, 0x6b, 0x6f, 0x27, 0xc7, 0x43, 0x9a, 0x9d, 0xe8, 0x50, 0x0b, 0x88, 0xd5, 0xf5, 0x89,
+	0x5e, 0x4a, 0x77, 0xc8, 0x6c, 0x48, 0x38, 0x44, 0x14, 0xac, 0x07, 0xca, 0xb4, 0xa8, 0x7d, 0x42,
+	0xf4, 0x32, 0xa7, 0x8d, 0x36, 0x8d, 0x1a, 0x87, 0x82, 0xc4, 0xf2, 0x7e, 0x26, 0xb6, 0x48, 0x72,
+	0x8b, 0x40, 0x4a, 0x3f, 0x53, 0x8c, 0x82, 0x24, 0x1d, 0x7e, 0x1d, 0x5d, 0x13, 0x06, 0x52, 0x37,
+	0x03, 0xf2, 0x00, 0xb6, 0xf4, 0x0a, 0x1f, 0x78, 0x43, 0x0e, 0xbc, 0x76, 0x37, 0x89, 0x84, 0x34,
+	0x2d, 0xae, 0xa1, 0x19, 0x01, 0x78, 0xd0, 0x61, 0x4d, 0x69, 0x6c, 0xf8, 0x14, 0x1f, 0x1e, 0x6d,
+	0x09, 0x77, 0xd3, 0x68, 0x50, 0xe9, 0xf1, 0x9b, 0x08, 0x37, 0x89, 0x43, 0x28, 0xb9, 0xe7, 0x79,
+	0x87, 0xf7, 0xdd, 0x3b, 0xb6, 0x6b, 0x07, 0x07, 0xfa, 0x35, 0xfe, 0xa8, 0x0b, 0x92, 0x0b, 0x5e,
+	0xeb, 0xa1, 0x80, 0x8c, 0x51, 0xf8, 0xfb, 0xc9, 0x3d, 0x43, 0x54, 0xbc, 0xde, 0x1f, 0x76, 0x1e,
+	0x31, 0xae, 0x5d, 0xe3, 0xbc, 0x80, 0xe6, 0x7a, 0xd2, 0x86, 0xa4, 0x7b, 0x6b, 0xe3, 0x73, 0xef,
+	0x15, 0x54, 0x66, 0x6c, 0x89, 0x45, 0x37, 0xd6, 0xf4, 0x89, 0x74, 0xec, 0xd9, 0x0d, 0x11, 0x10,
+	0xd3, 0x24, 0xdc, 0x36, 0xd7, 0xd7, 0x6d, 0xdf, 0x41, 0x15, 0x93, 0x77, 0xbe, 0x0a, 0xcf, 0x1d,
+	0xa8, 0x97, 0x6e, 0x86, 0x99, 0x74, 0x2d, 0x1e, 0x0d, 0x49, 0x56, 0xd8, 0x40, 0x37, 0x88, 0x6b,
+	0x36, 0x1c, 0x62, 0x18, 0x5b, 0x6f, 0x11, 0xdf, 0x6e, 0xd9, 0x96, 0x49, 0x6d, 0xcf, 0xe5, 0xed,
+	0x75, 0xa5, 0xfa, 0xf3, 0x52, 0xf5, 0x1b, 0xeb, 0x59, 0x44, 0x90, 0x3d, 0x56, 0xfa, 0x89, 0x63,
+	0x46, 0x7e, 0x52, 0xec, 0xf1, 0x13, 0xc7, 0x4c, 0xf9, 0x49, 0xfc, 0xb3, 0x8f, 0x91, 0x97, 0xae,
+	0x6e, 0xe4, 0xe5, 0x61, 0x19, 0xb9, 0x63, 0x3e, 0x09, 0x23, 0xff, 0xeb, 0x12, 0x9a, 0x51, 0xf2,
+	0xd1, 0xcc, 0x73, 0x8b, 0xf6, 0x84, 0xcf, 0x2d, 0x37, 0x51, 0x9e, 0x9e, 0x76, 0xe4, 0x03, 0xc4,
+	0xb5, 0x46, 0xbe, 0x9b, 0x72, 0x0c, 0x33, 0x0f, 0xeb, 0x80, 0x58, 0x87, 0x61, 0x8b, 0xb1, 0x9e,
+	0x4b, 0x9b, 0xc7, 0x6a, 0x12, 0x09, 0x69, 0x5a, 0xfc, 0x15, 0x54, 0x36, 0x9b, 0x4d, 0x9f, 0x04,
+	0x01, 0x09, 0xf8, 0x59, 0xa7, 0x5c, 0xbf, 0xc6, 0x7c, 0xab, 0x16, 0x02, 0x21, 0xc6, 0xb3, 0xe8,
+	0xc1, 0xaa, 0x9b, 0xac, 0x93, 0x54, 0x2f, 0xa4, 0xbb, 0x8e, 0xd9, 0x54, 0x32, 0x38, 0x44, 0x14,
+	0xac, 0x71, 0xfd, 0xd0, 0x6f, 0xac, 0xae, 0x9a, 0xd6, 0x01, 0x91, 0xd1, 0xac, 0x38, 0x70, 0xe3,
+	0xfa, 0x66, 0x9a, 0x03, 0xa8, 0x2c, 0xa5, 0x94, 0x4d, 0x72, 0x4a, 0xcd, 0xc6, 0x17, 0x89, 0x99,
+	0xa1, 0x94, 0x24, 0x07, 0x50, 0x59, 0xb2, 0x08, 0x77, 0xe8, 0x37, 0xc2, 0x16, 0x5a, 0xbd, 0x94,
+	0x8e, 0x70, 0x9b, 0x31, 0x0a, 0x92, 0x74, 0x6c, 0xc2, 0x0e, 0xfd, 0x06, 0x10, 0xd3, 0x39, 0xd2,
+	0xcb, 0xe9, 0x09, 0xdb, 0x94, 0x70, 0x88, 0x28, 0x70, 0x07, 0x61, 0xf6, 0x74, 0x7c, 0xdd, 0xc5,
+	0xbf, 0xdb, 0x66, 0x87, 0x47, 0xd3, 0xca, 0xed, 0x5b, 0x59, 0x4f, 0x13, 0x11, 0x25, 0x1f, 0xe8,
+	0x69, 0xe6, 0xd0, 0x9b, 0x3d, 0x7c, 0x20, 0x83, 0x37, 0xfe, 0x16, 0x7a, 0xe6, 0xd0, 0x6f, 0xc8,
+	0x96, 0xb3, 0x5d, 0xdf, 0x76, 0x2d, 0xbb, 0x63, 0x8a, 0xa6, 0x64, 0x11, 0x8b, 0x97, 0xa4, 0xba,
+	0xcf, 0x6c, 0x66, 0x93, 0x41, 0xbf, 0xf1, 0xe9, 0x43, 0xf4, 0xd4, 0x50, 0x0e, 0xd1, 0x8a, 0xbb,
+	0x8e, 0x6b, 0xa7, 0xf8, 0xcf, 0x3c, 0x9a, 0x55, 0xef, 0xc4, 0x1f, 0xf7, 0xaa, 0x13, 0x0b, 0x5b,
+	0xa6, 0x4f, 0x6d, 0xbe, 0xf7, 0xab, 0x61, 0x2b, 0x44, 0x40, 0x4c, 0xc3, 0x8e, 0xb1, 0xd4, 0xeb,
+	0xd8, 0x96, 0x7a, 0x8c, 0xdd, 0x67, 0x40, 0x10, 0xb8, 0xec, 0xf6, 0xea, 0xfc, 0xd8, 0xda, 0xab,
+	0x65, 0xc3, 0x74, 0x61, 0xa4, 0x0d, 0xd3, 0x83, 0xbd, 0xfd, 0xf4, 0x49, 0xd2, 0xca, 0x44, 0xb7,
+	0xdf, 0x7b, 0x43, 0x2e, 0x7f, 0x8c, 0xcb, 0xcc, 0xfe, 0x3d, 0x8f, 0x66, 0x94, 0x7a, 0xc7, 0xe3,
+	0xac, 0x2c, 0x32, 0x9a, 0x89, 0x0b, 0x8c, 0xe6, 0x65, 0x54, 0xb2, 0x1c, 0x9b, 0xb8, 0x74, 0xa3,
+	0x29, 0x8d, 0x2b, 0xee, 0x9c, 0x14, 0xf0, 0x35, 0x88, 0x28, 0x9e, 0xb4, 0x89, 0x25, 0x57, 0xbf,
+	0x70, 0xd9, 0x0e, 0xfe, 0xe2, 0xf8, 0xde, 0x7d, 0x9b, 0x1c, 0xca, 0x46, 0xa6, 0x2c, 0xf3, 0xd8,
+	0xde, 0x7d, 0xcb, 0xa1, 0x88, 0x27, 0xfe, 0xa1, 0x86, 0x2a, 0xa6, 0xeb, 0x7a, 0x94, 0x9f, 0x31,
+	0xd9, 0x7d, 0xd2, 0x30, 0xba, 0xa4, 0x42, 0xf6, 0xd5, 0x5a, 0xcc, 0x5a, 0x69, 0x0d, 0x4a, 0x60,
+	0x20, 0xa9, 0x01, 0xfe, 0x4d, 0x54, 0x74, 0xcc, 0x06, 0x71, 0xc2, 0x76, 0x74, 0x63, 0x58, 0xba,
+	0x6c, 0x71, 0xae, 0x4a, 0x9f, 0x85, 0x00, 0x82, 0x14, 0xb9, 0xf0, 0x06, 0x9a, 0x55, 0x55, 0x1e,
+	0x64, 0x6e, 0x17, 0xbe, 0x8e, 0x2a, 0x09, 0x31, 0x03, 0x2d, 0xcb, 0x3f, 0xe6, 0xd1, 0xac, 0x5a,
+	0x5e, 0x7c, 0x9c, 0xe7, 0xbf, 0x84, 0x26, 0x83, 0x2e, 0x7f, 0x61, 0x42, 0xfa, 0x7e, 0x74, 0x9b,
+	0x65, 0x08, 0x30, 0x84, 0xf8, 0x6c, 0x8f, 0xce, 0x3d, 0x11, 0x8f, 0xce, 0x5f, 0xd6, 0xa3, 0x47,
+	0x1b, 0x62, 0x3e, 0xe9, 0x7d, 0x7f, 0xed, 0xbd, 0x21, 0x97, 0x87, 0xc7, 0xe5, 0xd2, 0xff, 0x9d,
+	0x47, 0xd3, 0xe9, 0x0b, 0x76, 0x76, 0x54, 0x3d, 0xf0, 0x02, 0x2a, 0x0f, 0xf0, 0xd2, 0x82, 0x22,
+	0xef, 0xbb, 0x17, 0xa3, 0x20, 0x49, 0x77, 0xb9, 0x58, 0xf2, 0x12, 0x9a, 0x94, 0x2f, 0xa4, 0xe9,
+	0xb9, 0xb4, 0xd9, 0xc9, 0x97, 0xd6, 0x20, 0xc4, 0xff, 0x22, 0x90, 0xf4, 0x98, 0xdd, 0xc7, 0xbd,
+	0x81, 0xe4, 0xdd, 0xa1, 0xd6, 0x56, 0xc6, 0x65, 0x74, 0xff, 0x57, 0x40, 0x73, 0x3d, 0x25, 0xf7,
+	0xf4, 0x4d, 0x8d, 0x76, 0x89, 0x9b, 0x9a, 0x37, 0xd0, 0x34, 0xb7, 0xaa, 0x5d, 0xe5, 0x7e, 0x27,
+	0xea, 0xd2, 0xdc, 0x4f, 0x61, 0x41, 0xa1, 0xbe, 0xdc, 0x91, 0xf9, 0x0d, 0x34, 0x1d, 0x74, 0x1b,
+	0x81, 0xe5, 0xdb, 0x1d, 0x66, 0x1e, 0x1b, 0x6b, 0x7a, 0x3e, 0x2d, 0xc4, 0x48, 0x61, 0x41, 0xa1,
+	0xc6, 0x6d, 0x34, 0x6b, 0xf9, 0xa4, 0x49, 0x5c, 0x6a, 0x9b, 0x8e, 0xcc, 0x2f, 0x07, 0x7a, 0x55,
+	0xf2, 0xfa, 0xf9, 0xd9, 0xd2, 0xec, 0xaa, 0xc2, 0x02, 0x7a, 0x98, 0xe2, 0x06, 0x5a, 0x10, 0x37,
+	0x2e, 0x49, 0x85, 0xa2, 0xfb, 0x1a, 0x71, 0x12, 0x5e, 0x96, 0x4a, 0x2f, 0xac, 0xf5, 0xa5, 0x84,
+	0x0b, 0xb8, 0x0c, 0xf8, 0x7e, 0xe4, 0x26, 0x9a, 0x89, 0xb5, 0x0c, 0x58, 0x05, 0x43, 0xe6, 0xbd,
+	0xbf, 0x24, 0x07, 0x3d, 0xbb, 0x46, 0x3a, 0x3e, 0xb1, 0x4c, 0x4a, 0x9a, 0xab, 0x69, 0x42, 0x50,
+	0x47, 0x8e, 0xe2, 0xea, 0xa8, 0xc7, 0x04, 0xc7, 0x65, 0xff, 0x67, 0x45, 0x34, 0xd7, 0x53, 0x4a,
+	0x64, 0x17, 0x8f, 0xdc, 0xe4, 0xc4, 0x51, 0x4a, 0x5e, 0x3c, 0x72, 0x5b, 0x0c, 0x40, 0x62, 0x2e,
+	0x71, 0x99, 0x23, 0xe3, 0x7e, 0xae, 0x4f, 0xdc, 0x37, 0xd0, 0x0d, 0xea, 0x04, 0xfb, 0x7e, 0x37,
+	0xa0, 0xab, 0xc4, 0xa7, 0x7c, 0x6e, 0xd9, 0x65, 0x91, 0xb4, 0xea, 0xe8, 0x7e, 0x71, 0x7f, 0xcb,
+	0xe8, 0x25, 0x82, 0xec, 0xb1, 0xcc, 0xf4, 0xa8, 0x13, 0xd4, 0x1c, 0xc7, 0x7b, 0x18, 0x16, 0x01,
+	0xe2, 0x3d, 0x56, 0x2f, 0xa4, 0x4d, 0x6f, 0x7f, 0xcb, 0xe8, 0x43, 0x09, 0x17, 0x70, 0xc1, 0xdb,
+	0x68, 0x9e, 0x3a, 0xc1, 0x5b, 0xa6, 0x63, 0x37, 0x4d, 0x76, 0xab, 0x18, 0x50, 0x7e, 0x91, 0x22,
+	0xec, 0xfa, 0x4b, 0x92, 0xf9, 0xfc, 0xfe, 0x96, 0xa1, 0x92, 0x40, 0xd6, 0xb8, 0xf1, 0x7c, 0xf5,
+	0x22, 0x33, 0x84, 0x95, 0x9e, 0x48, 0x08, 0x2b, 0x3f, 0xd6, 0x5b, 0x53, 0x0e, 0x86, 0x86, 0xe4,
+	0x60, 0x8a, 0x8d, 0x8f, 0xcb, 0xc1, 0xfe, 0x25, 0x8f, 0x66, 0xd5, 0x06, 0x86, 0x2f, 0x7a, 0xae,
+	0x49, 0xbe, 0xe3, 0x3e, 0x31, 0x8c, 0x77, 0xdc, 0x57, 0x50, 0x99, 0x99, 0x60, 0xd0, 0x31, 0xad,
+	0xf0, 0xd5, 0xfd, 0x28, 0xce, 0xed, 0x84, 0x08, 0x88, 0x69, 0x58, 0xcd, 0xb4, 0xd9, 0x90, 0xef,
+	0x6e, 0x46, 0x35, 0xd3, 0xb5, 0x3a, 0x4c, 0x34, 0x1b, 0xf8, 0x16, 0x2a, 0xc9, 0x03, 0x53, 0x58,
+	0x66, 0xe4, 0x62, 0xe5, 0x69, 0x2a, 0x80, 0x08, 0x3b, 0x9e, 0x23, 0xca, 0x08, 0xae, 0x53, 0xd4,
+	0x75, 0x1c, 0xdb, 0xbb, 0xea, 0x79, 0x34, 0x9f, 0xd1, 0x6e, 0x9c, 0x5e, 0x3e, 0xed, 0x12, 0xcb,
+	0x77, 0x8c, 0x8a, 0x2d, 0xdb, 0xa1, 0xc4, 0x1f, 0x52, 0x55, 0x3b, 0x54, 0xea, 0x0e, 0x67, 0x2a,
+	0xc2, 0x84, 0xf8, 0x1b, 0xa4, 0x20, 0xe6, 0xcb, 0xd7, 0xdb, 0xbe, 0xd7, 0xed, 0xbc, 0x45, 0xfc,
+	0x80, 0xed, 0xac, 0x72, 0x88, 0x4c, 0xdb, 0x5e, 0xbb, 0xdc, 0x2b, 0xe0, 0x77, 0x33, 0x38, 0xd4,
+	0x9f, 0x93, 0xcf, 0x7a, 0x3d, 0x0b, 0x0b, 0x99, 0x52, 0xf1, 0x2a, 0x42, 0x51, 0x6f, 0x47, 0x58,
+	0x24, 0x78, 0x81, 0x55, 0xce, 0xa3, 0xe6, 0x8f, 0xe0, 0xe7, 0x67, 0x4b, 0x73, 0xa9, 0xd9, 0x66,
+	0x50, 0x48, 0x0c, 0x4b, 0x7f, 0x34, 0xa4, 0x30, 0x94, 0x8f, 0x86, 0x64, 0x2c, 0xef, 0xb8, 0xac,
+	0xeb, 0xef, 0x73, 0x68, 0x3a, 0xbd, 0x90, 0xac, 0x58, 0xde, 0xf1, 0x49, 0xcb, 0x7e, 0xa4, 0x7e,
+	0x30, 0x64, 0x97, 0x43, 0x41, 0x62, 0xb1, 0xa7, 0x5c, 0x73, 0xdc, 0xbd, 0xf2, 0xdb, 0xe3, 0x72,
+	0xc3, 0xea, 0x73, 0xb5, 0xc1, 0x04, 0xb6, 0x6c, 0xe2, 0x34, 0x45, 0x45, 0x74, 0x14, 0x02, 0xef,
+	0x70, 0xf6, 0x20, 0xc5, 0xe0, 0x77, 0x51, 0xd9, 0xf2, 0x09, 0x3b, 0x16, 0xd6, 0x4f, 0x65, 0xca,
+	0xf7, 0xcb, 0x97, 0x33, 0x59, 0xf6, 0xed, 0x9a, 0xd8, 0x1d, 0x57, 0x43, 0x26, 0x10, 0xf3, 0x63,
+	0xad, 0x1c, 0x66, 0x8b, 0x12, 0xdf, 0xa0, 0xa6, 0x4f, 0xe5, 0xe1, 0x24, 0x6a, 0xe5, 0xa8, 0x45,
+	0x18, 0x48, 0x50, 0x2d, 0x7f, 0x9e, 0x47, 0xd3, 0xe9, 0xb6, 0xe9, 0x27, 0x54, 0xcd, 0x66, 0x9f,
+	0xed, 0x61, 0x27, 0xc1, 0x9a, 0xef, 0xaa, 0x1f, 0x08, 0xda, 0x97, 0x70, 0x88, 0x28, 0x30, 0xa0,
+	0xb2, 0xf9, 0xc5, 0xbe, 0xf9, 0x24, 0x4a, 0x78, 0xe1, 0x58, 0x88, 0xd9, 0x30, 0x9e, 0x41, 0x48,
+	0xae, 0xe7, 0x07, 0xe6, 0x19, 0x81, 0x21, 0x66, 0xc3, 0x2c, 0xdf, 0x27, 0xed, 0xf0, 0xac, 0x98,
+	0xb0, 0x7c, 0xe0, 0x50, 0x90, 0x58, 0x76, 0x7b, 0xe0, 0x7b, 0x0e, 0xa9, 0xc1, 0x8e, 0x5e, 0x4c,
+	0xdf, 0x1e, 0x80, 0x00, 0x43, 0x88, 0x1f, 0x45, 0xae, 0x9c, 0x36, 0x80, 0x71, 0x6d, 0x14, 0x7f,
+	0x57, 0x40, 0xd3, 0xe9, 0x4e, 0xf9, 0xf4, 0xb2, 0x6a, 0x23, 0x58, 0xd6, 0x89, 0x61, 0x2f, 0x6b,
+	0xee, 0xc2, 0x65, 0x7d, 0x01, 0x15, 0x8e, 0xbb, 0xa4, 0x1b, 0x7e, 0x88, 0x2a, 0xca, 0xc3, 0xf7,
+	0x18, 0x10, 0x04, 0x8e, 0xb5, 0xeb, 0x3c, 0x34, 0x6d, 0xca, 0x1c, 0xdc, 0x20, 0x96, 0xe7, 0x36,
+	0xc5, 0x25, 0x5f, 0x2e, 0x59, 0x09, 0x4f, 0xa1, 0x41, 0xa5, 0x1f, 0xc4, 0x7c, 0x06, 0x4b, 0x74,
+	0xdf, 0x40, 0xd3, 0x5c, 0xc9, 0x9a, 0x65, 0x79, 0x5d, 0x5e, 0x27, 0x29, 0xa5, 0xef, 0x08, 0xf6,
+	0x92, 0xd8, 0x35, 0x50, 0xa8, 0xf1, 0xc7, 0xbd, 0xb9, 0xed, 0xbb, 0x43, 0x7d, 0xb9, 0x62, 0x5c,
+	0xc6, 0xfa, 0x3b, 0xa8, 0x14, 0xda, 0x05, 0x7e, 0x3e, 0x31, 0x2e, 0x4e, 0x44, 0x99, 0x89, 0x70,
+	0x26, 0x2b, 0xa8, 0xec, 0x75, 0x88, 0x6f, 0x66, 0x15, 0x38, 0xef, 0x87, 0x08, 0x88, 0x69, 0xe2,
+	0xa6, 0xc3, 0xdc, 0x05, 0x4d, 0x87, 0xdf, 0xd3, 0x50, 0xf8, 0x01, 0x14, 0xbc, 0x86, 0x0a, 0x1d,
+	0xcf, 0xa7, 0x61, 0x65, 0x62, 0x29, 0xdb, 0x9c, 0x45, 0xe5, 0xd9, 0xf3, 0x69, 0xcc, 0x91, 0xfd,
+	0x0a, 0x40, 0x0c, 0x66, 0x7a, 0xb2, 0x0f, 0xa7, 0x51, 0xe2, 0x6f, 0xec, 0xaa, 0x7a, 0xae, 0x86,
+	0x08, 0x88, 0x69, 0x96, 0xff, 0x37, 0x87, 0x66, 0xd5, 0x97, 0x03, 0x58, 0x5b, 0x5e, 0x60, 0xb7,
+	0x5d, 0xdb, 0x6d, 0xcb, 0x2b, 0x20, 0x6d, 0xe0, 0xb6, 0x3c, 0x23, 0x39, 0x1e, 0xd2, 0xec, 0xf0,
+	0x1d, 0x76, 0x95, 0xc5, 0x5a, 0x91, 0x06, 0x72, 0xdd, 0xb2, 0xb8, 0xed, 0x62, 0x4d, 0x48, 0x62,
+	0x78, 0x32, 0xaa, 0xe5, 0xc6, 0x17, 0xd5, 0x3e, 0xe9, 0xed, 0x97, 0x7d, 0x6f, 0xc8, 0xaf, 0x67,
+	0x8c, 0xcb, 0x03, 0xfe, 0xa7, 0x80, 0x9e, 0xce, 0x7e, 0x11, 0xe3, 0x09, 0x9d, 0x18, 0xe2, 0x76,
+	0xb6, 0x89, 0xbe, 0xed, 0x6c, 0x34, 0xca, 0x50, 0x72, 0x43, 0x7a, 0xb1, 0x22, 0x9a, 0x80, 0x0b,
+	0x92, 0x94, 0xe4, 0x59, 0x26, 0xff, 0xd8, 0xb3, 0x0c, 0xfb, 0x9c, 0x5e, 0xd7, 0x3a, 0x94, 0xb7,
+	0xa7, 0xc9, 0xcf, 0xe9, 0x71, 0x28, 0x48, 0x6c, 0x22, 0xe8, 0x14, 0x2f, 0x0c, 0x3a, 0x2c, 0x88,
+	0x76, 0xe9, 0x81, 0x68, 0xe0, 0x9b, 0x1c, 0x3c, 0x88, 0x86, 0x63, 0x21, 0x66, 0xc3, 0x64, 0x9b,
+	0x1d, 0x9b, 0x35, 0xd8, 0x95, 0xd2, 0xb2, 0x6b, 0xbb, 0x1b, 0xec, 0x06, 0x4e, 0x62, 0x59, 0x9b,
+	0x98, 0xba, 0xdf, 0x5b, 0x23, 0x79, 0xf9, 0x67, 0x5c, 0x56, 0x6f, 0xa1, 0xb9, 0x9e, 0x35, 0xbf,
+	0x74, 0x3e, 0xf3, 0x22, 0x2a, 0x06, 0xdd, 0x16, 0xa3, 0x9b, 0x48, 0xd3, 0x19, 0x1c, 0x0a, 0x12,
+	0xbb, 0xfc, 0xa3, 0x3c, 0x9a, 0xeb, 0x79, 0x65, 0xe7, 0x09, 0x79, 0x15, 0x6b, 0x99, 0xe3, 0x19,
+	0xc5, 0xdb, 0x89, 0x86, 0xf5, 0x52, 0xa2, 0x65, 0x2e, 0x89, 0x84, 0x34, 0x2d, 0xde, 0xe0, 0x66,
+	0x32, 0xf0, 0x99, 0x1c, 0x49, 0x4b, 0x62, 0x21, 0x54, 0x32, 0xc0, 0xaf, 0xa0, 0x0a, 0x7f, 0x08,
+	0x31, 0xe5, 0x32, 0xb5, 0xe6, 0x1d, 0xa6, 0xeb, 0x31, 0x18, 0x92, 0x34, 0xf8, 0xfb, 0xbd, 0x79,
+	0xf4, 0xfb, 0xc3, 0x7e, 0x91, 0x6a, 0x5c, 0x76, 0xf7, 0x4f, 0x1a, 0x2a, 0x47, 0x57, 0x51, 0xfc,
+	0x4b, 0x9a, 0x26, 0xbb, 0x99, 0xe6, 0x17, 0xda, 0x9a, 0xf2, 0x25, 0xcd, 0x5a, 0x88, 0x81, 0x04,
+	0x15, 0x3b, 0xba, 0x89, 0xd6, 0x95, 0x68, 0x9c, 0x52, 0x43, 0x5a, 0x4d, 0x61, 0x41, 0xa1, 0xe6,
+	0x86, 0xc0, 0x21, 0x9b, 0xe4, 0x94, 0x0f, 0x57, 0x7b, 0x27, 0x93, 0x48, 0x48, 0xd3, 0x2e, 0xff,
+	0x73, 0x11, 0x45, 0xdf, 0x87, 0x63, 0x1f, 0xa3, 0x53, 0xbe, 0xd4, 0x77, 0x77, 0x48, 0xfd, 0x0b,
+	0x17, 0xcd, 0x3f, 0x6b, 0xef, 0x95, 0x1f, 0x8a, 0x93, 0xe7, 0xd1, 0xc4, 0x97, 0xb5, 0xa3, 0xf6,
+	0x5e, 0xa3, 0x87, 0x02, 0x32, 0x46, 0xe1, 0x37, 0x51, 0xd9, 0xf2, 0x5c, 0x6a, 0xda, 0x6e, 0x14,
+	0x4a, 0x9e, 0xef, 0xd3, 0x76, 0x28, 0x88, 0xc4, 0x5e, 0x1a, 0xfd, 0x84, 0x78, 0x38, 0x5e, 0x47,
+	0x93, 0x27, 0x9e, 0xd3, 0x3d, 0x92, 0x17, 0x46, 0x95, 0xdb, 0x0b, 0x59, 0x9c, 0xde, 0xe2, 0x24,
+	0xf1, 0x41, 0x5e, 0xfc, 0x0e, 0x20, 0x1c, 0x8b, 0x09, 0x9a, 0xe1, 0xa5, 0x04, 0x9b, 0x9e, 0x4a,
+	0x8f, 0x96, 0xd5, 0xb7, 0x17, 0xb3, 0xd8, 0xed, 0x7a, 0x4d, 0x23, 0x4d, 0x2d, 0xbf, 0x4e, 0x91,
+	0x06, 0x82, 0xca, 0x13, 0xdf, 0x41, 0x25, 0xb3, 0xd5, 0xb2, 0x5d, 0x9b, 0x9e, 0xca, 0x1b, 0xd6,
+	0xe7, 0xb2, 0xf8, 0xd7, 0x24, 0x8d, 0x7c, 0x7d, 0x43, 0xfe, 0x82, 0x68, 0x2c, 0x7e, 0x80, 0x2a,
+	0xd4, 0x73, 0xe4, 0x91, 0x37, 0x90, 0x89, 0xeb, 0x62, 0x16, 0xab, 0xfd, 0x88, 0x2c, 0xbe, 0xc3,
+	0x8e, 0x61, 0x01, 0x24, 0xf9, 0xe0, 0x3f, 0xd5, 0xd0, 0x94, 0xeb, 0x35, 0x49, 0xb8, 0x97, 0xe8,
+	0xa5, 0xa1, 0x7c, 0x89, 0x25, 0xb4, 0xdd, 0xea, 0x4e, 0x82, 0xb7, 0x70, 0xf9, 0xe8, 0xf3, 0x8b,
+	0x49, 0x14, 0xa4, 0x94, 0x58, 0xf8, 0x26, 0x9a, 0xeb, 0x19, 0x38, 0x90, 0xfb, 0xff, 0x85, 0x86,
+	0xd4, 0xfe, 0x67, 0x76, 0x5e, 0x6f, 0xda, 0x3e, 0x67, 0x78, 0xaa, 0x5e, 0xcf, 0xae, 0x85, 0x08,
+	0x88, 0x69, 0x58, 0x49, 0xad, 0x13, 0xfb, 0x7d, 0x54, 0x52, 0xe3, 0xfe, 0xca, 0x31, 0x6c, 0x5f,
+	0x61, 0xff, 0x03, 0x69, 0x93, 0x47, 0x1d, 0x3d, 0x97, 0xde, 0x57, 0x76, 0x23, 0x0c, 0x24, 0xa8,
+	0x96, 0xff, 0x2b, 0x87, 0xa6, 0xd3, 0x91, 0x84, 0x9d, 0x77, 0x88, 0xdb, 0xec, 0x78, 0xb6, 0x4b,
+	0xd5, 0x4f, 0x41, 0xaf, 0x4b, 0x38, 0x44, 0x14, 0x2c, 0x2a, 0x1e, 0x11, 0x7a, 0xe0, 0x35, 0xd5,
+	0xa8, 0xb8, 0xcd, 0xa1, 0x20, 0xb1, 0x5c, 0x7d, 0xcf, 0xa7, 0x7a, 0x4e, 0x51, 0xdf, 0xf3, 0x29,
+	0x70, 0x4c, 0x58, 0x11, 0xcc, 0xf7, 0xa9, 0x08, 0xb2, 0x02, 0x37, 0xf1, 0x4f, 0x88, 0x1f, 0xed,
+	0x80, 0x05, 0xa5, 0xc0, 0x9d, 0xc2, 0x82, 0x42, 0xcd, 0x76, 0x40, 0x01, 0x09, 0x77, 0x40, 0xe5,
+	0xe5, 0x02, 0x23, 0x89, 0x84, 0x34, 0xed, 0x28, 0xae, 0x69, 0xd2, 0xb3, 0x3e, 0xa6, 0x48, 0x54,
+	0xaf, 0x7e, 0xfa, 0xd9, 0xe2, 0x53, 0x3f, 0xf9, 0x6c, 0xf1, 0xa9, 0x9f, 0x7e, 0xb6, 0xf8, 0xd4,
+	0xf7, 0xce, 0x17, 0xb5, 0x4f, 0xcf, 0x17, 0xb5, 0x9f, 0x9c, 0x2f, 0x6a, 0x3f, 0x3d, 0x5f, 0xd4,
+	0x7e, 0x76, 0xbe, 0xa8, 0xfd, 0xe8, 0x3f, 0x16, 0x9f, 0xfa, 0x76, 0x29, 0xd4, 0xf2, 0xff, 0x07,
+	0x00, 0x63, 0x55, 0x33, 0xca, 0xbf, 0x62, 0x00, 0x00,
 }
 
 func (m *AMQPEventSource) Marshal() (dAtA []byte, err error) {
@@ -1914,6 +1914,17 @@ func (m *EventSourceSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SuspendedEvents) > 0 {
+		for iNdEx := len(m.SuspendedEvents) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SuspendedEvents[iNdEx])
+			copy(dAtA[i:], m.SuspendedEvents[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.SuspendedEvents[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xea
+		}
+	}
 	if len(m.Generic) > 0 {
 		keysForGeneric := make([]string, 0, len(m.Generic))
 		for k := range m.Generic {
@@ -5382,6 +5393,12 @@ func (m *EventSourceSpec) Size() (n int) {
 			n += mapEntrySize + 2 + sovGenerated(uint64(mapEntrySize))
 		}
 	}
+	if len(m.SuspendedEvents) > 0 {
+		for _, s := range m.SuspendedEvents {
+			l = len(s)
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -6615,6 +6632,7 @@ func (this *EventSourceSpec) String() string {
 		`NSQ:` + mapStringForNSQ + `,`,
 		`Pulsar:` + mapStringForPulsar + `,`,
 		`Generic:` + mapStringForGeneric + `,`,
+		`SuspendedEvents:` + fmt.Sprintf("%v", this.SuspendedEvents) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -12297,6 +12315,38 @@ func (m *EventSourceSpec) Unmarshal(dAtA []byte) error {
 			}
 			m.Generic[mapkey] = *mapvalue
 			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuspendedEvents", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SuspendedEvents = append(m.SuspendedEvents, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
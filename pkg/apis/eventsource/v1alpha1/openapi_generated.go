@@ -816,6 +816,20 @@ func schema_pkg_apis_eventsource_v1alpha1_EventSourceSpec(ref common.ReferenceCa
 							},
 						},
 					},
+					"suspendedEvents": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SuspendedEvents is the list of names of the events that are suspended. No events are consumed from the sources of the suspended events.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
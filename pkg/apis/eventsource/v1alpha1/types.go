@@ -107,6 +107,10 @@ type EventSourceSpec struct {
 	Pulsar map[string]PulsarEventSource `json:"pulsar,omitempty" protobuf:"bytes,27,opt,name=pulsar"`
 	// Generic event source
 	Generic map[string]GenericEventSource `json:"generic,omitempty" protobuf:"bytes,28,rep,name=generic"`
+	// SuspendedEvents is the list of names of the events that are suspended. No events are consumed from
+	// the sources of the suspended events.
+	// +optional
+	SuspendedEvents []string `json:"suspendedEvents,omitempty" protobuf:"bytes,29,rep,name=suspendedEvents"`
 }
 
 // Template holds the information of an EventSource deployment template
@@ -761,6 +765,9 @@ const (
 	// EventSourceConditionDeployed has the status True when the EventSource
 	// has its Deployment created.
 	EventSourceConditionDeployed apicommon.ConditionType = "Deployed"
+	// EventSourceConditionActive has the status True when none of the
+	// events of the EventSource is suspended.
+	EventSourceConditionActive apicommon.ConditionType = "Active"
 )
 
 // EventSourceStatus holds the status of the event-source resource
@@ -770,7 +777,7 @@ type EventSourceStatus struct {
 
 // InitConditions sets conditions to Unknown state.
 func (es *EventSourceStatus) InitConditions() {
-	es.InitializeConditions(EventSourceConditionSourcesProvided, EventSourceConditionDeployed)
+	es.InitializeConditions(EventSourceConditionSourcesProvided, EventSourceConditionDeployed, EventSourceConditionActive)
 }
 
 // MarkSourcesProvided set the eventsource has valid sources spec provided.
@@ -792,3 +799,13 @@ func (es *EventSourceStatus) MarkDeployed() {
 func (es *EventSourceStatus) MarkDeployFailed(reason, message string) {
 	es.MarkFalse(EventSourceConditionDeployed, reason, message)
 }
+
+// MarkActive set none of the events of the eventsource is suspended.
+func (es *EventSourceStatus) MarkActive() {
+	es.MarkTrue(EventSourceConditionActive)
+}
+
+// MarkEventsSuspended set some events of the eventsource have been suspended.
+func (es *EventSourceStatus) MarkEventsSuspended(message string) {
+	es.MarkFalse(EventSourceConditionActive, "EventsSuspended", message)
+}
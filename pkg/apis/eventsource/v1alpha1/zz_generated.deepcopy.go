@@ -413,6 +413,11 @@ func (in *EventSourceSpec) DeepCopyInto(out *EventSourceSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.SuspendedEvents != nil {
+		in, out := &in.SuspendedEvents, &out.SuspendedEvents
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -48,10 +48,38 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+func (m *AMQPTrigger) Reset()      { *m = AMQPTrigger{} }
+func (*AMQPTrigger) ProtoMessage() {}
+func (*AMQPTrigger) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c4bded897df1f16, []int{0}
+}
+func (m *AMQPTrigger) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AMQPTrigger) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AMQPTrigger) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AMQPTrigger.Merge(m, src)
+}
+func (m *AMQPTrigger) XXX_Size() int {
+	return m.Size()
+}
+func (m *AMQPTrigger) XXX_DiscardUnknown() {
+	xxx_messageInfo_AMQPTrigger.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AMQPTrigger proto.InternalMessageInfo
+
 func (m *AWSLambdaTrigger) Reset()      { *m = AWSLambdaTrigger{} }
 func (*AWSLambdaTrigger) ProtoMessage() {}
 func (*AWSLambdaTrigger) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{0}
+	return fileDescriptor_6c4bded897df1f16, []int{1}
 }
 func (m *AWSLambdaTrigger) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -76,10 +104,66 @@ func (m *AWSLambdaTrigger) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AWSLambdaTrigger proto.InternalMessageInfo
 
+func (m *AWSSNSTrigger) Reset()      { *m = AWSSNSTrigger{} }
+func (*AWSSNSTrigger) ProtoMessage() {}
+func (*AWSSNSTrigger) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c4bded897df1f16, []int{2}
+}
+func (m *AWSSNSTrigger) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AWSSNSTrigger) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AWSSNSTrigger) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AWSSNSTrigger.Merge(m, src)
+}
+func (m *AWSSNSTrigger) XXX_Size() int {
+	return m.Size()
+}
+func (m *AWSSNSTrigger) XXX_DiscardUnknown() {
+	xxx_messageInfo_AWSSNSTrigger.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AWSSNSTrigger proto.InternalMessageInfo
+
+func (m *AWSSQSTrigger) Reset()      { *m = AWSSQSTrigger{} }
+func (*AWSSQSTrigger) ProtoMessage() {}
+func (*AWSSQSTrigger) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c4bded897df1f16, []int{3}
+}
+func (m *AWSSQSTrigger) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AWSSQSTrigger) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AWSSQSTrigger) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AWSSQSTrigger.Merge(m, src)
+}
+func (m *AWSSQSTrigger) XXX_Size() int {
+	return m.Size()
+}
+func (m *AWSSQSTrigger) XXX_DiscardUnknown() {
+	xxx_messageInfo_AWSSQSTrigger.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AWSSQSTrigger proto.InternalMessageInfo
+
 func (m *ArgoWorkflowTrigger) Reset()      { *m = ArgoWorkflowTrigger{} }
 func (*ArgoWorkflowTrigger) ProtoMessage() {}
 func (*ArgoWorkflowTrigger) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{1}
+	return fileDescriptor_6c4bded897df1f16, []int{4}
 }
 func (m *ArgoWorkflowTrigger) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -107,7 +191,7 @@ var xxx_messageInfo_ArgoWorkflowTrigger proto.InternalMessageInfo
 func (m *ArtifactLocation) Reset()      { *m = ArtifactLocation{} }
 func (*ArtifactLocation) ProtoMessage() {}
 func (*ArtifactLocation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{2}
+	return fileDescriptor_6c4bded897df1f16, []int{5}
 }
 func (m *ArtifactLocation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -135,7 +219,7 @@ var xxx_messageInfo_ArtifactLocation proto.InternalMessageInfo
 func (m *BasicAuth) Reset()      { *m = BasicAuth{} }
 func (*BasicAuth) ProtoMessage() {}
 func (*BasicAuth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{3}
+	return fileDescriptor_6c4bded897df1f16, []int{6}
 }
 func (m *BasicAuth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -160,10 +244,38 @@ func (m *BasicAuth) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_BasicAuth proto.InternalMessageInfo
 
+func (m *CircuitBreaker) Reset()      { *m = CircuitBreaker{} }
+func (*CircuitBreaker) ProtoMessage() {}
+func (*CircuitBreaker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c4bded897df1f16, []int{7}
+}
+func (m *CircuitBreaker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CircuitBreaker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *CircuitBreaker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitBreaker.Merge(m, src)
+}
+func (m *CircuitBreaker) XXX_Size() int {
+	return m.Size()
+}
+func (m *CircuitBreaker) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitBreaker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CircuitBreaker proto.InternalMessageInfo
+
 func (m *CustomTrigger) Reset()      { *m = CustomTrigger{} }
 func (*CustomTrigger) ProtoMessage() {}
 func (*CustomTrigger) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{4}
+	return fileDescriptor_6c4bded897df1f16, []int{8}
 }
 func (m *CustomTrigger) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -191,7 +303,7 @@ var xxx_messageInfo_CustomTrigger proto.InternalMessageInfo
 func (m *DataFilter) Reset()      { *m = DataFilter{} }
 func (*DataFilter) ProtoMessage() {}
 func (*DataFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{5}
+	return fileDescriptor_6c4bded897df1f16, []int{9}
 }
 func (m *DataFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -219,7 +331,7 @@ var xxx_messageInfo_DataFilter proto.InternalMessageInfo
 func (m *DependencyGroup) Reset()      { *m = DependencyGroup{} }
 func (*DependencyGroup) ProtoMessage() {}
 func (*DependencyGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{6}
+	return fileDescriptor_6c4bded897df1f16, []int{10}
 }
 func (m *DependencyGroup) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -244,10 +356,38 @@ func (m *DependencyGroup) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DependencyGroup proto.InternalMessageInfo
 
+func (m *EmailTrigger) Reset()      { *m = EmailTrigger{} }
+func (*EmailTrigger) ProtoMessage() {}
+func (*EmailTrigger) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c4bded897df1f16, []int{11}
+}
+func (m *EmailTrigger) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EmailTrigger) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *EmailTrigger) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmailTrigger.Merge(m, src)
+}
+func (m *EmailTrigger) XXX_Size() int {
+	return m.Size()
+}
+func (m *EmailTrigger) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmailTrigger.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmailTrigger proto.InternalMessageInfo
+
 func (m *Event) Reset()      { *m = Event{} }
 func (*Event) ProtoMessage() {}
 func (*Event) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{7}
+	return fileDescriptor_6c4bded897df1f16, []int{12}
 }
 func (m *Event) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -272,10 +412,38 @@ func (m *Event) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Event proto.InternalMessageInfo
 
+func (m *EventBusTrigger) Reset()      { *m = EventBusTrigger{} }
+func (*EventBusTrigger) ProtoMessage() {}
+func (*EventBusTrigger) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c4bded897df1f16, []int{13}
+}
+func (m *EventBusTrigger) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBusTrigger) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *EventBusTrigger) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBusTrigger.Merge(m, src)
+}
+func (m *EventBusTrigger) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBusTrigger) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBusTrigger.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBusTrigger proto.InternalMessageInfo
+
 func (m *EventContext) Reset()      { *m = EventContext{} }
 func (*EventContext) ProtoMessage() {}
 func (*EventContext) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{8}
+	return fileDescriptor_6c4bded897df1f16, []int{14}
 }
 func (m *EventContext) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -303,7 +471,7 @@ var xxx_messageInfo_EventContext proto.InternalMessageInfo
 func (m *EventDependency) Reset()      { *m = EventDependency{} }
 func (*EventDependency) ProtoMessage() {}
 func (*EventDependency) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{9}
+	return fileDescriptor_6c4bded897df1f16, []int{15}
 }
 func (m *EventDependency) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -331,7 +499,7 @@ var xxx_messageInfo_EventDependency proto.InternalMessageInfo
 func (m *EventDependencyFilter) Reset()      { *m = EventDependencyFilter{} }
 func (*EventDependencyFilter) ProtoMessage() {}
 func (*EventDependencyFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{10}
+	return fileDescriptor_6c4bded897df1f16, []int{16}
 }
 func (m *EventDependencyFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -359,7 +527,7 @@ var xxx_messageInfo_EventDependencyFilter proto.InternalMessageInfo
 func (m *FileArtifact) Reset()      { *m = FileArtifact{} }
 func (*FileArtifact) ProtoMessage() {}
 func (*FileArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{11}
+	return fileDescriptor_6c4bded897df1f16, []int{17}
 }
 func (m *FileArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -384,10 +552,66 @@ func (m *FileArtifact) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_FileArtifact proto.InternalMessageInfo
 
+func (m *GCPPubSubTrigger) Reset()      { *m = GCPPubSubTrigger{} }
+func (*GCPPubSubTrigger) ProtoMessage() {}
+func (*GCPPubSubTrigger) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c4bded897df1f16, []int{18}
+}
+func (m *GCPPubSubTrigger) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GCPPubSubTrigger) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *GCPPubSubTrigger) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GCPPubSubTrigger.Merge(m, src)
+}
+func (m *GCPPubSubTrigger) XXX_Size() int {
+	return m.Size()
+}
+func (m *GCPPubSubTrigger) XXX_DiscardUnknown() {
+	xxx_messageInfo_GCPPubSubTrigger.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GCPPubSubTrigger proto.InternalMessageInfo
+
+func (m *GarbageCollection) Reset()      { *m = GarbageCollection{} }
+func (*GarbageCollection) ProtoMessage() {}
+func (*GarbageCollection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c4bded897df1f16, []int{19}
+}
+func (m *GarbageCollection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GarbageCollection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *GarbageCollection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GarbageCollection.Merge(m, src)
+}
+func (m *GarbageCollection) XXX_Size() int {
+	return m.Size()
+}
+func (m *GarbageCollection) XXX_DiscardUnknown() {
+	xxx_messageInfo_GarbageCollection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GarbageCollection proto.InternalMessageInfo
+
 func (m *GitArtifact) Reset()      { *m = GitArtifact{} }
 func (*GitArtifact) ProtoMessage() {}
 func (*GitArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{12}
+	return fileDescriptor_6c4bded897df1f16, []int{20}
 }
 func (m *GitArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -415,7 +639,7 @@ var xxx_messageInfo_GitArtifact proto.InternalMessageInfo
 func (m *GitCreds) Reset()      { *m = GitCreds{} }
 func (*GitCreds) ProtoMessage() {}
 func (*GitCreds) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{13}
+	return fileDescriptor_6c4bded897df1f16, []int{21}
 }
 func (m *GitCreds) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -443,7 +667,7 @@ var xxx_messageInfo_GitCreds proto.InternalMessageInfo
 func (m *GitRemoteConfig) Reset()      { *m = GitRemoteConfig{} }
 func (*GitRemoteConfig) ProtoMessage() {}
 func (*GitRemoteConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{14}
+	return fileDescriptor_6c4bded897df1f16, []int{22}
 }
 func (m *GitRemoteConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -471,7 +695,7 @@ var xxx_messageInfo_GitRemoteConfig proto.InternalMessageInfo
 func (m *HTTPTrigger) Reset()      { *m = HTTPTrigger{} }
 func (*HTTPTrigger) ProtoMessage() {}
 func (*HTTPTrigger) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{15}
+	return fileDescriptor_6c4bded897df1f16, []int{23}
 }
 func (m *HTTPTrigger) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -496,10 +720,38 @@ func (m *HTTPTrigger) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_HTTPTrigger proto.InternalMessageInfo
 
+func (m *JobTrigger) Reset()      { *m = JobTrigger{} }
+func (*JobTrigger) ProtoMessage() {}
+func (*JobTrigger) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c4bded897df1f16, []int{24}
+}
+func (m *JobTrigger) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobTrigger) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *JobTrigger) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobTrigger.Merge(m, src)
+}
+func (m *JobTrigger) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobTrigger) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobTrigger.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobTrigger proto.InternalMessageInfo
+
 func (m *K8SResourcePolicy) Reset()      { *m = K8SResourcePolicy{} }
 func (*K8SResourcePolicy) ProtoMessage() {}
 func (*K8SResourcePolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{16}
+	return fileDescriptor_6c4bded897df1f16, []int{25}
 }
 func (m *K8SResourcePolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -527,7 +779,7 @@ var xxx_messageInfo_K8SResourcePolicy proto.InternalMessageInfo
 func (m *KafkaTrigger) Reset()      { *m = KafkaTrigger{} }
 func (*KafkaTrigger) ProtoMessage() {}
 func (*KafkaTrigger) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{17}
+	return fileDescriptor_6c4bded897df1f16, []int{26}
 }
 func (m *KafkaTrigger) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -552,10 +804,66 @@ func (m *KafkaTrigger) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_KafkaTrigger proto.InternalMessageInfo
 
+func (m *LogTrigger) Reset()      { *m = LogTrigger{} }
+func (*LogTrigger) ProtoMessage() {}
+func (*LogTrigger) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c4bded897df1f16, []int{27}
+}
+func (m *LogTrigger) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LogTrigger) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *LogTrigger) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogTrigger.Merge(m, src)
+}
+func (m *LogTrigger) XXX_Size() int {
+	return m.Size()
+}
+func (m *LogTrigger) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogTrigger.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogTrigger proto.InternalMessageInfo
+
+func (m *MQTTTrigger) Reset()      { *m = MQTTTrigger{} }
+func (*MQTTTrigger) ProtoMessage() {}
+func (*MQTTTrigger) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c4bded897df1f16, []int{28}
+}
+func (m *MQTTTrigger) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MQTTTrigger) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MQTTTrigger) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MQTTTrigger.Merge(m, src)
+}
+func (m *MQTTTrigger) XXX_Size() int {
+	return m.Size()
+}
+func (m *MQTTTrigger) XXX_DiscardUnknown() {
+	xxx_messageInfo_MQTTTrigger.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MQTTTrigger proto.InternalMessageInfo
+
 func (m *Metadata) Reset()      { *m = Metadata{} }
 func (*Metadata) ProtoMessage() {}
 func (*Metadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{18}
+	return fileDescriptor_6c4bded897df1f16, []int{29}
 }
 func (m *Metadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -583,7 +891,7 @@ var xxx_messageInfo_Metadata proto.InternalMessageInfo
 func (m *NATSTrigger) Reset()      { *m = NATSTrigger{} }
 func (*NATSTrigger) ProtoMessage() {}
 func (*NATSTrigger) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{19}
+	return fileDescriptor_6c4bded897df1f16, []int{30}
 }
 func (m *NATSTrigger) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -608,10 +916,38 @@ func (m *NATSTrigger) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_NATSTrigger proto.InternalMessageInfo
 
+func (m *ObjectStorageTrigger) Reset()      { *m = ObjectStorageTrigger{} }
+func (*ObjectStorageTrigger) ProtoMessage() {}
+func (*ObjectStorageTrigger) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c4bded897df1f16, []int{31}
+}
+func (m *ObjectStorageTrigger) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ObjectStorageTrigger) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ObjectStorageTrigger) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectStorageTrigger.Merge(m, src)
+}
+func (m *ObjectStorageTrigger) XXX_Size() int {
+	return m.Size()
+}
+func (m *ObjectStorageTrigger) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectStorageTrigger.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectStorageTrigger proto.InternalMessageInfo
+
 func (m *OpenWhiskTrigger) Reset()      { *m = OpenWhiskTrigger{} }
 func (*OpenWhiskTrigger) ProtoMessage() {}
 func (*OpenWhiskTrigger) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{20}
+	return fileDescriptor_6c4bded897df1f16, []int{32}
 }
 func (m *OpenWhiskTrigger) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -636,15 +972,15 @@ func (m *OpenWhiskTrigger) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_OpenWhiskTrigger proto.InternalMessageInfo
 
-func (m *Sensor) Reset()      { *m = Sensor{} }
-func (*Sensor) ProtoMessage() {}
-func (*Sensor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{21}
+func (m *PulsarTrigger) Reset()      { *m = PulsarTrigger{} }
+func (*PulsarTrigger) ProtoMessage() {}
+func (*PulsarTrigger) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c4bded897df1f16, []int{33}
 }
-func (m *Sensor) XXX_Unmarshal(b []byte) error {
+func (m *PulsarTrigger) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Sensor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PulsarTrigger) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -652,10 +988,66 @@ func (m *Sensor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	}
 	return b[:n], nil
 }
-func (m *Sensor) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Sensor.Merge(m, src)
+func (m *PulsarTrigger) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PulsarTrigger.Merge(m, src)
 }
-func (m *Sensor) XXX_Size() int {
+func (m *PulsarTrigger) XXX_Size() int {
+	return m.Size()
+}
+func (m *PulsarTrigger) XXX_DiscardUnknown() {
+	xxx_messageInfo_PulsarTrigger.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PulsarTrigger proto.InternalMessageInfo
+
+func (m *RedisTrigger) Reset()      { *m = RedisTrigger{} }
+func (*RedisTrigger) ProtoMessage() {}
+func (*RedisTrigger) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c4bded897df1f16, []int{34}
+}
+func (m *RedisTrigger) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RedisTrigger) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RedisTrigger) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedisTrigger.Merge(m, src)
+}
+func (m *RedisTrigger) XXX_Size() int {
+	return m.Size()
+}
+func (m *RedisTrigger) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedisTrigger.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedisTrigger proto.InternalMessageInfo
+
+func (m *Sensor) Reset()      { *m = Sensor{} }
+func (*Sensor) ProtoMessage() {}
+func (*Sensor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c4bded897df1f16, []int{35}
+}
+func (m *Sensor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Sensor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Sensor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Sensor.Merge(m, src)
+}
+func (m *Sensor) XXX_Size() int {
 	return m.Size()
 }
 func (m *Sensor) XXX_DiscardUnknown() {
@@ -667,7 +1059,7 @@ var xxx_messageInfo_Sensor proto.InternalMessageInfo
 func (m *SensorList) Reset()      { *m = SensorList{} }
 func (*SensorList) ProtoMessage() {}
 func (*SensorList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{22}
+	return fileDescriptor_6c4bded897df1f16, []int{36}
 }
 func (m *SensorList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -695,7 +1087,7 @@ var xxx_messageInfo_SensorList proto.InternalMessageInfo
 func (m *SensorSpec) Reset()      { *m = SensorSpec{} }
 func (*SensorSpec) ProtoMessage() {}
 func (*SensorSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{23}
+	return fileDescriptor_6c4bded897df1f16, []int{37}
 }
 func (m *SensorSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -723,7 +1115,7 @@ var xxx_messageInfo_SensorSpec proto.InternalMessageInfo
 func (m *SensorStatus) Reset()      { *m = SensorStatus{} }
 func (*SensorStatus) ProtoMessage() {}
 func (*SensorStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{24}
+	return fileDescriptor_6c4bded897df1f16, []int{38}
 }
 func (m *SensorStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -751,7 +1143,7 @@ var xxx_messageInfo_SensorStatus proto.InternalMessageInfo
 func (m *SlackTrigger) Reset()      { *m = SlackTrigger{} }
 func (*SlackTrigger) ProtoMessage() {}
 func (*SlackTrigger) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{25}
+	return fileDescriptor_6c4bded897df1f16, []int{39}
 }
 func (m *SlackTrigger) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -779,7 +1171,7 @@ var xxx_messageInfo_SlackTrigger proto.InternalMessageInfo
 func (m *StandardK8STrigger) Reset()      { *m = StandardK8STrigger{} }
 func (*StandardK8STrigger) ProtoMessage() {}
 func (*StandardK8STrigger) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{26}
+	return fileDescriptor_6c4bded897df1f16, []int{40}
 }
 func (m *StandardK8STrigger) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -807,7 +1199,7 @@ var xxx_messageInfo_StandardK8STrigger proto.InternalMessageInfo
 func (m *StatusPolicy) Reset()      { *m = StatusPolicy{} }
 func (*StatusPolicy) ProtoMessage() {}
 func (*StatusPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{27}
+	return fileDescriptor_6c4bded897df1f16, []int{41}
 }
 func (m *StatusPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -835,7 +1227,7 @@ var xxx_messageInfo_StatusPolicy proto.InternalMessageInfo
 func (m *TLSConfig) Reset()      { *m = TLSConfig{} }
 func (*TLSConfig) ProtoMessage() {}
 func (*TLSConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{28}
+	return fileDescriptor_6c4bded897df1f16, []int{42}
 }
 func (m *TLSConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -863,7 +1255,7 @@ var xxx_messageInfo_TLSConfig proto.InternalMessageInfo
 func (m *Template) Reset()      { *m = Template{} }
 func (*Template) ProtoMessage() {}
 func (*Template) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{29}
+	return fileDescriptor_6c4bded897df1f16, []int{43}
 }
 func (m *Template) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -891,7 +1283,7 @@ var xxx_messageInfo_Template proto.InternalMessageInfo
 func (m *TimeFilter) Reset()      { *m = TimeFilter{} }
 func (*TimeFilter) ProtoMessage() {}
 func (*TimeFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{30}
+	return fileDescriptor_6c4bded897df1f16, []int{44}
 }
 func (m *TimeFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -919,7 +1311,7 @@ var xxx_messageInfo_TimeFilter proto.InternalMessageInfo
 func (m *Trigger) Reset()      { *m = Trigger{} }
 func (*Trigger) ProtoMessage() {}
 func (*Trigger) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{31}
+	return fileDescriptor_6c4bded897df1f16, []int{45}
 }
 func (m *Trigger) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -944,10 +1336,38 @@ func (m *Trigger) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Trigger proto.InternalMessageInfo
 
+func (m *TriggerConcurrency) Reset()      { *m = TriggerConcurrency{} }
+func (*TriggerConcurrency) ProtoMessage() {}
+func (*TriggerConcurrency) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c4bded897df1f16, []int{46}
+}
+func (m *TriggerConcurrency) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TriggerConcurrency) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *TriggerConcurrency) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TriggerConcurrency.Merge(m, src)
+}
+func (m *TriggerConcurrency) XXX_Size() int {
+	return m.Size()
+}
+func (m *TriggerConcurrency) XXX_DiscardUnknown() {
+	xxx_messageInfo_TriggerConcurrency.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TriggerConcurrency proto.InternalMessageInfo
+
 func (m *TriggerParameter) Reset()      { *m = TriggerParameter{} }
 func (*TriggerParameter) ProtoMessage() {}
 func (*TriggerParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{32}
+	return fileDescriptor_6c4bded897df1f16, []int{47}
 }
 func (m *TriggerParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -975,7 +1395,7 @@ var xxx_messageInfo_TriggerParameter proto.InternalMessageInfo
 func (m *TriggerParameterSource) Reset()      { *m = TriggerParameterSource{} }
 func (*TriggerParameterSource) ProtoMessage() {}
 func (*TriggerParameterSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{33}
+	return fileDescriptor_6c4bded897df1f16, []int{48}
 }
 func (m *TriggerParameterSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1003,7 +1423,7 @@ var xxx_messageInfo_TriggerParameterSource proto.InternalMessageInfo
 func (m *TriggerPolicy) Reset()      { *m = TriggerPolicy{} }
 func (*TriggerPolicy) ProtoMessage() {}
 func (*TriggerPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{34}
+	return fileDescriptor_6c4bded897df1f16, []int{49}
 }
 func (m *TriggerPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1028,10 +1448,38 @@ func (m *TriggerPolicy) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TriggerPolicy proto.InternalMessageInfo
 
+func (m *TriggerStatus) Reset()      { *m = TriggerStatus{} }
+func (*TriggerStatus) ProtoMessage() {}
+func (*TriggerStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c4bded897df1f16, []int{50}
+}
+func (m *TriggerStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TriggerStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *TriggerStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TriggerStatus.Merge(m, src)
+}
+func (m *TriggerStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *TriggerStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_TriggerStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TriggerStatus proto.InternalMessageInfo
+
 func (m *TriggerSwitch) Reset()      { *m = TriggerSwitch{} }
 func (*TriggerSwitch) ProtoMessage() {}
 func (*TriggerSwitch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{35}
+	return fileDescriptor_6c4bded897df1f16, []int{51}
 }
 func (m *TriggerSwitch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1059,7 +1507,7 @@ var xxx_messageInfo_TriggerSwitch proto.InternalMessageInfo
 func (m *TriggerTemplate) Reset()      { *m = TriggerTemplate{} }
 func (*TriggerTemplate) ProtoMessage() {}
 func (*TriggerTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{36}
+	return fileDescriptor_6c4bded897df1f16, []int{52}
 }
 func (m *TriggerTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1087,7 +1535,7 @@ var xxx_messageInfo_TriggerTemplate proto.InternalMessageInfo
 func (m *URLArtifact) Reset()      { *m = URLArtifact{} }
 func (*URLArtifact) ProtoMessage() {}
 func (*URLArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6c4bded897df1f16, []int{37}
+	return fileDescriptor_6c4bded897df1f16, []int{53}
 }
 func (m *URLArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1113,36 +1561,56 @@ func (m *URLArtifact) XXX_DiscardUnknown() {
 var xxx_messageInfo_URLArtifact proto.InternalMessageInfo
 
 func init() {
+	proto.RegisterType((*AMQPTrigger)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.AMQPTrigger")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.AMQPTrigger.HeadersEntry")
 	proto.RegisterType((*AWSLambdaTrigger)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.AWSLambdaTrigger")
+	proto.RegisterType((*AWSSNSTrigger)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.AWSSNSTrigger")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.AWSSNSTrigger.MessageAttributesEntry")
+	proto.RegisterType((*AWSSQSTrigger)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.AWSSQSTrigger")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.AWSSQSTrigger.MessageAttributesEntry")
 	proto.RegisterType((*ArgoWorkflowTrigger)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.ArgoWorkflowTrigger")
 	proto.RegisterType((*ArtifactLocation)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.ArtifactLocation")
 	proto.RegisterType((*BasicAuth)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.BasicAuth")
+	proto.RegisterType((*CircuitBreaker)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.CircuitBreaker")
 	proto.RegisterType((*CustomTrigger)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.CustomTrigger")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.CustomTrigger.SpecEntry")
 	proto.RegisterType((*DataFilter)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.DataFilter")
 	proto.RegisterType((*DependencyGroup)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.DependencyGroup")
+	proto.RegisterType((*EmailTrigger)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.EmailTrigger")
 	proto.RegisterType((*Event)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.Event")
+	proto.RegisterType((*EventBusTrigger)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.EventBusTrigger")
 	proto.RegisterType((*EventContext)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.EventContext")
 	proto.RegisterType((*EventDependency)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.EventDependency")
 	proto.RegisterType((*EventDependencyFilter)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.EventDependencyFilter")
 	proto.RegisterType((*FileArtifact)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.FileArtifact")
+	proto.RegisterType((*GCPPubSubTrigger)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.GCPPubSubTrigger")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.GCPPubSubTrigger.AttributesEntry")
+	proto.RegisterType((*GarbageCollection)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.GarbageCollection")
 	proto.RegisterType((*GitArtifact)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.GitArtifact")
 	proto.RegisterType((*GitCreds)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.GitCreds")
 	proto.RegisterType((*GitRemoteConfig)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.GitRemoteConfig")
 	proto.RegisterType((*HTTPTrigger)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.HTTPTrigger")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.HTTPTrigger.HeadersEntry")
+	proto.RegisterType((*JobTrigger)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.JobTrigger")
 	proto.RegisterType((*K8SResourcePolicy)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.K8SResourcePolicy")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.K8SResourcePolicy.LabelsEntry")
 	proto.RegisterType((*KafkaTrigger)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.KafkaTrigger")
+	proto.RegisterType((*LogTrigger)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.LogTrigger")
+	proto.RegisterType((*MQTTTrigger)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.MQTTTrigger")
 	proto.RegisterType((*Metadata)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.Metadata")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.Metadata.AnnotationsEntry")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.Metadata.LabelsEntry")
 	proto.RegisterType((*NATSTrigger)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.NATSTrigger")
+	proto.RegisterType((*ObjectStorageTrigger)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.ObjectStorageTrigger")
 	proto.RegisterType((*OpenWhiskTrigger)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.OpenWhiskTrigger")
+	proto.RegisterType((*PulsarTrigger)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.PulsarTrigger")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.PulsarTrigger.PropertiesEntry")
+	proto.RegisterType((*RedisTrigger)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.RedisTrigger")
 	proto.RegisterType((*Sensor)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.Sensor")
 	proto.RegisterType((*SensorList)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.SensorList")
 	proto.RegisterType((*SensorSpec)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.SensorSpec")
 	proto.RegisterType((*SensorStatus)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.SensorStatus")
+	proto.RegisterMapType((map[string]TriggerStatus)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.SensorStatus.TriggerStatusesEntry")
 	proto.RegisterType((*SlackTrigger)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.SlackTrigger")
 	proto.RegisterType((*StandardK8STrigger)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.StandardK8STrigger")
 	proto.RegisterType((*StatusPolicy)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.StatusPolicy")
@@ -1151,9 +1619,11 @@ func init() {
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.Template.NodeSelectorEntry")
 	proto.RegisterType((*TimeFilter)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.TimeFilter")
 	proto.RegisterType((*Trigger)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.Trigger")
+	proto.RegisterType((*TriggerConcurrency)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.TriggerConcurrency")
 	proto.RegisterType((*TriggerParameter)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.TriggerParameter")
 	proto.RegisterType((*TriggerParameterSource)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.TriggerParameterSource")
 	proto.RegisterType((*TriggerPolicy)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.TriggerPolicy")
+	proto.RegisterType((*TriggerStatus)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.TriggerStatus")
 	proto.RegisterType((*TriggerSwitch)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.TriggerSwitch")
 	proto.RegisterType((*TriggerTemplate)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.TriggerTemplate")
 	proto.RegisterType((*URLArtifact)(nil), "github.com.argoproj.argo_events.pkg.apis.sensor.v1alpha1.URLArtifact")
@@ -1164,229 +1634,495 @@ func init() {
 }
 
 var fileDescriptor_6c4bded897df1f16 = []byte{
-	// 3541 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3b, 0x4d, 0x6f, 0x1c, 0xc7,
-	0x95, 0x9a, 0x4f, 0xce, 0x3c, 0x8e, 0x44, 0xaa, 0x2c, 0x79, 0x69, 0xae, 0x4d, 0x0a, 0x6d, 0xac,
-	0x57, 0x36, 0xec, 0xa1, 0x2d, 0x79, 0xd7, 0xb4, 0x0d, 0xd8, 0x9e, 0x19, 0x52, 0x5f, 0xa4, 0x44,
-	0xba, 0x9a, 0x94, 0x80, 0xc5, 0x62, 0xed, 0x66, 0x4f, 0xcd, 0x4c, 0x7b, 0x66, 0xba, 0x7b, 0xbb,
-	0x6a, 0xa8, 0x9d, 0xc3, 0x7e, 0x00, 0xbe, 0xec, 0x1a, 0x8b, 0xf5, 0x2e, 0xbc, 0xf7, 0xfc, 0x81,
-	0x24, 0xe7, 0x00, 0x09, 0x10, 0x20, 0x40, 0x00, 0x1f, 0x9d, 0x43, 0x00, 0x9f, 0x88, 0x98, 0x3e,
-	0xe4, 0xe0, 0x43, 0x10, 0x20, 0x27, 0x5d, 0x12, 0xd4, 0x57, 0x77, 0x75, 0x73, 0x14, 0x91, 0x1a,
-	0x99, 0x42, 0x6e, 0x3d, 0xef, 0xbd, 0x7a, 0xaf, 0xea, 0xd5, 0xab, 0xf7, 0x51, 0xaf, 0x06, 0x6e,
-	0x74, 0x3d, 0xd6, 0x1b, 0xed, 0xd5, 0xdd, 0x60, 0xb8, 0xe2, 0x44, 0xdd, 0x20, 0x8c, 0x82, 0x4f,
-	0xc4, 0xc7, 0x6b, 0x64, 0x9f, 0xf8, 0x8c, 0xae, 0x84, 0xfd, 0xee, 0x8a, 0x13, 0x7a, 0x74, 0x85,
-	0x12, 0x9f, 0x06, 0xd1, 0xca, 0xfe, 0x1b, 0xce, 0x20, 0xec, 0x39, 0x6f, 0xac, 0x74, 0x89, 0x4f,
-	0x22, 0x87, 0x91, 0x76, 0x3d, 0x8c, 0x02, 0x16, 0xa0, 0xd5, 0x84, 0x53, 0x5d, 0x73, 0x12, 0x1f,
-	0x1f, 0x49, 0x4e, 0xf5, 0xb0, 0xdf, 0xad, 0x73, 0x4e, 0x75, 0xc9, 0xa9, 0xae, 0x39, 0x2d, 0xbe,
-	0x7f, 0xec, 0x39, 0xb8, 0xc1, 0x70, 0x18, 0xf8, 0x59, 0xd1, 0x8b, 0xaf, 0x19, 0x0c, 0xba, 0x41,
-	0x37, 0x58, 0x11, 0xe0, 0xbd, 0x51, 0x47, 0xfc, 0x12, 0x3f, 0xc4, 0x97, 0x22, 0xb7, 0xfa, 0xab,
-	0xb4, 0xee, 0x05, 0x9c, 0xe5, 0x8a, 0x1b, 0x44, 0x64, 0x65, 0xff, 0xc8, 0x6a, 0x16, 0xdf, 0x4c,
-	0x68, 0x86, 0x8e, 0xdb, 0xf3, 0x7c, 0x12, 0x8d, 0x93, 0x79, 0x0c, 0x09, 0x73, 0x26, 0x8d, 0x5a,
-	0x79, 0xd8, 0xa8, 0x68, 0xe4, 0x33, 0x6f, 0x48, 0x8e, 0x0c, 0xf8, 0xfb, 0x47, 0x0d, 0xa0, 0x6e,
-	0x8f, 0x0c, 0x9d, 0xec, 0x38, 0xeb, 0x8b, 0x22, 0xcc, 0x37, 0xee, 0xd9, 0x9b, 0xce, 0x70, 0xaf,
-	0xed, 0xec, 0x44, 0x5e, 0xb7, 0x4b, 0x22, 0xb4, 0x0a, 0xb5, 0xce, 0xc8, 0x77, 0x99, 0x17, 0xf8,
-	0x77, 0x9c, 0x21, 0x59, 0xc8, 0x5d, 0xca, 0x5d, 0xae, 0x36, 0x2f, 0x7c, 0x79, 0xb0, 0x7c, 0xe6,
-	0xf0, 0x60, 0xb9, 0x76, 0xcd, 0xc0, 0xe1, 0x14, 0x25, 0xc2, 0x50, 0x75, 0x5c, 0x97, 0x50, 0xba,
-	0x41, 0xc6, 0x0b, 0xf9, 0x4b, 0xb9, 0xcb, 0xb3, 0x57, 0xfe, 0xa6, 0x2e, 0xa7, 0xc6, 0xb7, 0xac,
-	0xce, 0xb5, 0x54, 0xdf, 0x7f, 0xa3, 0x6e, 0x13, 0x37, 0x22, 0x6c, 0x83, 0x8c, 0x6d, 0x32, 0x20,
-	0x2e, 0x0b, 0xa2, 0xe6, 0xd9, 0xc3, 0x83, 0xe5, 0x6a, 0x43, 0x8f, 0xc5, 0x09, 0x1b, 0xce, 0x93,
-	0x6a, 0xf2, 0x85, 0xc2, 0x89, 0x79, 0xc6, 0x60, 0x9c, 0xb0, 0x41, 0x2f, 0x41, 0x39, 0x22, 0x5d,
-	0x2f, 0xf0, 0x17, 0x8a, 0x62, 0x6d, 0xe7, 0xd4, 0xda, 0xca, 0x58, 0x40, 0xb1, 0xc2, 0xa2, 0x11,
-	0xcc, 0x84, 0xce, 0x78, 0x10, 0x38, 0xed, 0x85, 0xd2, 0xa5, 0xc2, 0xe5, 0xd9, 0x2b, 0xb7, 0xea,
-	0x8f, 0x6b, 0x9d, 0x75, 0xa5, 0xdd, 0x6d, 0x27, 0x72, 0x86, 0x84, 0x91, 0xa8, 0x39, 0xa7, 0x84,
-	0xce, 0x6c, 0x4b, 0x11, 0x58, 0xcb, 0x42, 0xff, 0x06, 0x10, 0x6a, 0x32, 0xba, 0x50, 0x7e, 0xe2,
-	0x92, 0x91, 0x92, 0x0c, 0x31, 0x88, 0x62, 0x43, 0xa2, 0x75, 0x50, 0x80, 0x67, 0x1a, 0x51, 0x37,
-	0xb8, 0x17, 0x44, 0xfd, 0xce, 0x20, 0xb8, 0xaf, 0x0d, 0xc3, 0x87, 0x32, 0x0d, 0x46, 0x91, 0x2b,
-	0x4d, 0x62, 0xaa, 0x39, 0x35, 0x22, 0xe6, 0x75, 0x1c, 0x97, 0x6d, 0x06, 0xae, 0xc3, 0xcd, 0xa7,
-	0x09, 0x5c, 0xfd, 0xb6, 0xe0, 0x8e, 0x95, 0x14, 0x74, 0x03, 0xaa, 0x41, 0xc8, 0xed, 0x95, 0xef,
-	0x54, 0x5e, 0xec, 0xd4, 0x2b, 0x6a, 0xea, 0xd5, 0x2d, 0x8d, 0x78, 0x70, 0xb0, 0x7c, 0xd1, 0x9c,
-	0x6c, 0x8c, 0xc0, 0xc9, 0xe0, 0x8c, 0x46, 0x0b, 0xa7, 0xad, 0x51, 0xf4, 0xdf, 0x39, 0xb8, 0xd0,
-	0x8d, 0x82, 0x51, 0x78, 0x97, 0x44, 0x94, 0xcf, 0x8d, 0x28, 0x45, 0x16, 0x85, 0x22, 0xdf, 0x31,
-	0x0c, 0x3a, 0x3e, 0xbf, 0x89, 0x78, 0xee, 0x26, 0xb8, 0x89, 0x5f, 0x9f, 0xc0, 0xa1, 0xf9, 0xbc,
-	0x12, 0x7d, 0x61, 0x12, 0x16, 0x4f, 0x94, 0x6a, 0xfd, 0x9e, 0x1f, 0xfb, 0xcc, 0x0e, 0x20, 0x1b,
-	0xf2, 0xf4, 0xaa, 0xda, 0xd9, 0x77, 0x8f, 0xaf, 0x1b, 0xe9, 0x4b, 0xeb, 0xf6, 0x55, 0xcd, 0xb0,
-	0x59, 0x3e, 0x3c, 0x58, 0xce, 0xdb, 0x57, 0x71, 0x9e, 0x5e, 0x45, 0x16, 0x94, 0x3d, 0x7f, 0xe0,
-	0xf9, 0x44, 0xed, 0x9f, 0xd8, 0xe6, 0x9b, 0x02, 0x82, 0x15, 0x06, 0xb5, 0xa1, 0xd8, 0xf1, 0x06,
-	0x44, 0x1d, 0xee, 0x6b, 0x8f, 0xbf, 0x2d, 0xd7, 0xbc, 0x01, 0x89, 0x67, 0x51, 0x39, 0x3c, 0x58,
-	0x2e, 0x72, 0x08, 0x16, 0xdc, 0xd1, 0xc7, 0x50, 0x18, 0x45, 0x03, 0xa5, 0xf0, 0xf5, 0xc7, 0x17,
-	0xb2, 0x8b, 0x37, 0x63, 0x19, 0x33, 0x87, 0x07, 0xcb, 0x85, 0x5d, 0xbc, 0x89, 0x39, 0x6b, 0xb4,
-	0x0b, 0x55, 0x37, 0xf0, 0x3b, 0x5e, 0x77, 0xe8, 0x84, 0x0b, 0x25, 0x21, 0xe7, 0xf2, 0x24, 0x4f,
-	0xd5, 0x12, 0x44, 0xb7, 0x9d, 0xf0, 0x88, 0xb3, 0x6a, 0xe9, 0xe1, 0x38, 0xe1, 0xc4, 0x27, 0xde,
-	0xf5, 0xd8, 0x42, 0x79, 0xda, 0x89, 0x5f, 0xf7, 0x58, 0x7a, 0xe2, 0xd7, 0x3d, 0x86, 0x39, 0x6b,
-	0xe4, 0x42, 0x25, 0xd2, 0x06, 0x39, 0x23, 0xc4, 0xbc, 0x7d, 0xe2, 0xfd, 0x8f, 0xed, 0xb1, 0x76,
-	0x78, 0xb0, 0x5c, 0x89, 0xed, 0x2f, 0x66, 0x6c, 0xfd, 0x28, 0x07, 0xd5, 0xa6, 0x43, 0x3d, 0xb7,
-	0x31, 0x62, 0x3d, 0xb4, 0x05, 0x95, 0x11, 0x25, 0x91, 0xaf, 0xe3, 0xcb, 0xb1, 0x9d, 0xba, 0x60,
-	0xbf, 0xab, 0x86, 0xe2, 0x98, 0x09, 0x67, 0x18, 0x3a, 0x94, 0xde, 0x0f, 0xa2, 0xf6, 0xc9, 0x22,
-	0x8f, 0x60, 0xb8, 0xad, 0x86, 0xe2, 0x98, 0x89, 0xf5, 0x45, 0x09, 0xce, 0xb6, 0x46, 0x94, 0x05,
-	0x43, 0xed, 0xfe, 0x56, 0x78, 0x24, 0x8a, 0xf6, 0x49, 0xb4, 0x8b, 0x37, 0x55, 0x50, 0x3c, 0xaf,
-	0xdd, 0x91, 0xad, 0x11, 0x38, 0xa1, 0xe1, 0x61, 0x86, 0x12, 0x77, 0x14, 0x49, 0xe3, 0xaf, 0x24,
-	0x61, 0xc6, 0x16, 0x50, 0xac, 0xb0, 0x3c, 0xe0, 0xba, 0x24, 0x62, 0xdc, 0x58, 0xb7, 0x1d, 0xd6,
-	0x13, 0x07, 0xc1, 0x08, 0xb8, 0x2d, 0x03, 0x87, 0x53, 0x94, 0xe8, 0x16, 0x20, 0x29, 0x8e, 0x87,
-	0xdf, 0xad, 0x7d, 0x12, 0x45, 0x5e, 0x9b, 0xa8, 0xa0, 0xb6, 0xa8, 0xc6, 0x23, 0xfb, 0x08, 0x05,
-	0x9e, 0x30, 0x0a, 0x51, 0x28, 0xd2, 0x90, 0xb8, 0x2a, 0xd2, 0x7d, 0xf8, 0xf8, 0x86, 0x96, 0xd2,
-	0x5a, 0xdd, 0x0e, 0x89, 0xbb, 0xee, 0xb3, 0x68, 0xdc, 0xac, 0xa9, 0x09, 0x15, 0x39, 0x08, 0x0b,
-	0x61, 0x4f, 0x3b, 0xd4, 0x99, 0x11, 0x7e, 0xe6, 0xf4, 0x22, 0xfc, 0xe2, 0x5b, 0x50, 0x8d, 0xf5,
-	0x82, 0xe6, 0xa1, 0xd0, 0x27, 0x63, 0x69, 0x51, 0x98, 0x7f, 0xa2, 0x0b, 0x50, 0xda, 0x77, 0x06,
-	0x23, 0xe5, 0x34, 0xb1, 0xfc, 0xf1, 0x4e, 0x7e, 0x35, 0x67, 0xfd, 0x3c, 0x07, 0xb0, 0xe6, 0x30,
-	0xe7, 0x9a, 0x37, 0x60, 0x24, 0x42, 0x97, 0xa0, 0x18, 0x72, 0x8b, 0x91, 0xd6, 0x18, 0x2b, 0x58,
-	0x58, 0x8a, 0xc0, 0xa0, 0x57, 0xa1, 0xc8, 0xc6, 0xa1, 0x76, 0xbf, 0x0b, 0x9a, 0x62, 0x67, 0x1c,
-	0x92, 0x07, 0x07, 0xcb, 0x95, 0x5b, 0xf6, 0xd6, 0x1d, 0xfe, 0x8d, 0x05, 0x15, 0x5a, 0xd6, 0x82,
-	0x79, 0x88, 0xac, 0x36, 0xab, 0x87, 0x07, 0xcb, 0xa5, 0xbb, 0x1c, 0xa0, 0xe6, 0x80, 0x3e, 0x00,
-	0x70, 0x83, 0x21, 0x57, 0x20, 0x0b, 0x22, 0x65, 0x68, 0x97, 0xb4, 0x8e, 0x5b, 0x31, 0xe6, 0x41,
-	0xea, 0x17, 0x36, 0xc6, 0x58, 0x1e, 0xcc, 0xad, 0x91, 0x90, 0xf8, 0x6d, 0xe2, 0xbb, 0x63, 0x11,
-	0xb3, 0xf8, 0x2a, 0xfc, 0x24, 0xd1, 0x8c, 0x57, 0x21, 0x12, 0x4c, 0x81, 0x41, 0x6f, 0x42, 0xad,
-	0xad, 0x07, 0x79, 0x84, 0x2e, 0xe4, 0xc5, 0xf4, 0xe6, 0xf9, 0xe9, 0x58, 0x33, 0xe0, 0x38, 0x45,
-	0x65, 0xfd, 0x7f, 0x0e, 0x4a, 0xeb, 0x7c, 0xd3, 0xd0, 0x10, 0x66, 0xdc, 0xc0, 0x67, 0xe4, 0x5f,
-	0x98, 0xf2, 0x36, 0x53, 0x44, 0x19, 0xc1, 0xb1, 0x25, 0xb9, 0x35, 0x67, 0xf9, 0xf6, 0xaa, 0x1f,
-	0x58, 0xcb, 0x40, 0xcf, 0x43, 0xb1, 0xed, 0x30, 0x47, 0x28, 0xbd, 0x26, 0x23, 0x11, 0xdf, 0x34,
-	0x2c, 0xa0, 0xd6, 0x6f, 0xf3, 0x50, 0x33, 0x99, 0xa0, 0x45, 0xc8, 0x7b, 0x6d, 0xb5, 0x7a, 0x50,
-	0xab, 0xcf, 0xdf, 0x5c, 0xc3, 0x79, 0xaf, 0x2d, 0x7c, 0x88, 0xf4, 0xcc, 0xf9, 0x74, 0xaa, 0x9a,
-	0xc9, 0x95, 0xfe, 0x0e, 0x66, 0xf9, 0x81, 0xda, 0x97, 0x91, 0x5e, 0xb9, 0x90, 0x67, 0x14, 0xf1,
-	0x2c, 0x37, 0x36, 0x9d, 0x04, 0x98, 0x74, 0x5c, 0xf5, 0xc2, 0x3c, 0x8a, 0x69, 0xd5, 0x1b, 0x26,
-	0xd1, 0x80, 0x39, 0x3e, 0x6b, 0xb1, 0x34, 0x9f, 0x09, 0xe2, 0x92, 0x20, 0xfe, 0x2b, 0x45, 0x3c,
-	0xc7, 0x97, 0xd6, 0x92, 0x68, 0x31, 0x2e, 0x4b, 0x8f, 0x5e, 0x86, 0x19, 0x3a, 0xda, 0xfb, 0x84,
-	0xb8, 0x32, 0x8a, 0x55, 0x93, 0x83, 0x61, 0x4b, 0x30, 0xd6, 0x78, 0xb4, 0x09, 0x45, 0x5e, 0xaf,
-	0xa8, 0x30, 0xf4, 0xca, 0xf1, 0xf2, 0xa2, 0x1d, 0x6f, 0x48, 0x8c, 0xb9, 0x7b, 0xdc, 0x6c, 0x38,
-	0x17, 0xeb, 0x07, 0x79, 0x98, 0x13, 0x9a, 0x4e, 0x2c, 0xee, 0x18, 0xc6, 0xd6, 0x80, 0x39, 0x61,
-	0x03, 0x52, 0xc3, 0xa2, 0x04, 0xca, 0xa7, 0x57, 0xbc, 0x9e, 0x46, 0xe3, 0x2c, 0x3d, 0x0f, 0x15,
-	0x02, 0x24, 0x06, 0x17, 0xd2, 0xa1, 0x62, 0x5d, 0x23, 0x70, 0x42, 0x83, 0xf6, 0x61, 0xa6, 0x23,
-	0x8e, 0x34, 0x55, 0x19, 0xca, 0xd6, 0x94, 0x06, 0x9a, 0xac, 0x58, 0xba, 0x0a, 0x69, 0xa9, 0xf2,
-	0x9b, 0x62, 0x2d, 0xcc, 0xfa, 0x43, 0x1e, 0x2e, 0x4e, 0xa4, 0x3f, 0x86, 0x9e, 0xf6, 0xd4, 0x5e,
-	0xc9, 0x70, 0xbb, 0x36, 0x85, 0xe3, 0xf4, 0x86, 0x44, 0xcd, 0xb2, 0x92, 0xde, 0x41, 0xf3, 0xe0,
-	0x16, 0x4e, 0xe1, 0xe0, 0x76, 0xd4, 0xc1, 0x2d, 0x8a, 0x58, 0x30, 0xc5, 0x92, 0x12, 0x1f, 0x9d,
-	0xa8, 0xce, 0x70, 0x01, 0xaf, 0x43, 0xcd, 0x4c, 0x56, 0x1f, 0xed, 0xc7, 0xad, 0xff, 0x2c, 0xc2,
-	0xac, 0x91, 0xc1, 0xa1, 0x17, 0x64, 0x3a, 0x2b, 0x07, 0xcc, 0xaa, 0x01, 0x49, 0x2e, 0xfa, 0x1e,
-	0x9c, 0x73, 0x07, 0x81, 0x4f, 0xd6, 0xbc, 0x48, 0xa4, 0x39, 0x63, 0x65, 0xc2, 0xcf, 0x2a, 0xca,
-	0x73, 0xad, 0x14, 0x16, 0x67, 0xa8, 0x91, 0x0b, 0x25, 0x37, 0x22, 0x6d, 0xaa, 0xb4, 0xde, 0x9c,
-	0x2a, 0xed, 0x6c, 0x71, 0x4e, 0x32, 0x98, 0x88, 0x4f, 0x2c, 0x79, 0xa3, 0x2b, 0x00, 0x94, 0xf6,
-	0x36, 0xc8, 0x58, 0x64, 0x3d, 0xd2, 0x05, 0xc5, 0x01, 0xdb, 0xb6, 0x6f, 0x28, 0x0c, 0x36, 0xa8,
-	0xd0, 0xab, 0x50, 0xe9, 0xe8, 0x3c, 0x49, 0xfa, 0xa1, 0x79, 0x35, 0xa2, 0x12, 0xe7, 0x48, 0x31,
-	0x05, 0xf7, 0x9e, 0x7b, 0x91, 0xe3, 0xbb, 0x3d, 0xe5, 0x78, 0x62, 0xef, 0xd9, 0x14, 0x50, 0xac,
-	0xb0, 0x5c, 0x9b, 0xcc, 0xe9, 0x0a, 0xaf, 0x63, 0x68, 0x73, 0xc7, 0xe9, 0x62, 0x0e, 0xe7, 0xe8,
-	0x88, 0x74, 0x16, 0x2a, 0x69, 0x34, 0x26, 0x1d, 0xcc, 0xe1, 0x68, 0x08, 0xe5, 0x88, 0x0c, 0x03,
-	0x46, 0x16, 0xaa, 0x42, 0x5b, 0x37, 0xa7, 0xd2, 0x16, 0x16, 0xac, 0x64, 0x29, 0x20, 0xeb, 0x25,
-	0x09, 0xc1, 0x4a, 0x88, 0xf5, 0xc3, 0x1c, 0x54, 0xb4, 0x56, 0xff, 0x02, 0x12, 0xe9, 0x0f, 0x61,
-	0x2e, 0xb3, 0xaa, 0x63, 0xf8, 0x96, 0xe7, 0xa1, 0x38, 0x8a, 0x06, 0x3a, 0xd0, 0x0b, 0xaf, 0xb0,
-	0x8b, 0x37, 0x6d, 0x2c, 0xa0, 0xd6, 0xa7, 0x65, 0x98, 0xbd, 0xb1, 0xb3, 0xb3, 0xad, 0x33, 0xf3,
-	0x47, 0x1c, 0x06, 0x23, 0xc9, 0xcb, 0x9f, 0xe2, 0x35, 0xce, 0x3f, 0x41, 0x81, 0x0d, 0xf4, 0x09,
-	0x6a, 0x4d, 0x21, 0x72, 0xd3, 0x56, 0xd6, 0x20, 0xca, 0xb6, 0x9d, 0x4d, 0x1b, 0x73, 0xc6, 0xdc,
-	0xb8, 0x87, 0x84, 0xf5, 0x82, 0x76, 0xf6, 0x16, 0xeb, 0xb6, 0x80, 0x62, 0x85, 0xcd, 0xe4, 0xd8,
-	0xa5, 0x53, 0xcf, 0xb1, 0x5f, 0x86, 0x19, 0xee, 0xcb, 0x83, 0x91, 0x0c, 0xff, 0x85, 0x44, 0x65,
-	0x3b, 0x12, 0x8c, 0x35, 0x1e, 0x85, 0x50, 0xdd, 0xd3, 0x35, 0xa2, 0xca, 0x01, 0xa6, 0x50, 0x5c,
-	0x5c, 0x6e, 0xca, 0xea, 0x3a, 0xfe, 0x89, 0x13, 0x21, 0xe8, 0x5f, 0x61, 0xa6, 0x47, 0x9c, 0x36,
-	0xd7, 0x4c, 0x45, 0x68, 0x06, 0x3f, 0xbe, 0x3c, 0xc3, 0x24, 0xeb, 0x37, 0x24, 0x53, 0x59, 0xf9,
-	0xc4, 0x0b, 0x56, 0x50, 0xac, 0x65, 0x2e, 0xbe, 0x03, 0x35, 0x93, 0xf2, 0x44, 0xb5, 0xc0, 0x7f,
-	0x15, 0xe0, 0xfc, 0xc6, 0xaa, 0xad, 0x6b, 0xed, 0xed, 0x60, 0xe0, 0xb9, 0x63, 0xf4, 0xef, 0x50,
-	0x1e, 0x38, 0x7b, 0x64, 0x40, 0x17, 0x72, 0x62, 0x3d, 0xf7, 0x1e, 0x7f, 0x3d, 0x47, 0x98, 0xd7,
-	0x37, 0x05, 0x67, 0xb9, 0xa8, 0xd8, 0xdc, 0x24, 0x10, 0x2b, 0xb1, 0xc8, 0x85, 0x99, 0x3d, 0xc7,
-	0xed, 0x07, 0x9d, 0x8e, 0xf2, 0x1f, 0xab, 0x27, 0xbe, 0x4c, 0x68, 0xca, 0xf1, 0x89, 0xde, 0x14,
-	0x00, 0x6b, 0xce, 0xc8, 0x86, 0x8b, 0x24, 0x8a, 0x82, 0x68, 0xcb, 0x57, 0x28, 0x65, 0x4a, 0xe2,
-	0xb4, 0x55, 0x9a, 0x2f, 0xa8, 0x81, 0x17, 0xd7, 0x27, 0x11, 0xe1, 0xc9, 0x63, 0x17, 0xdf, 0x86,
-	0x59, 0x63, 0x81, 0x27, 0xda, 0x8b, 0x5f, 0x96, 0xa0, 0xb6, 0xe1, 0x74, 0xfa, 0xce, 0x31, 0x5d,
-	0xd2, 0x8b, 0x50, 0x62, 0x41, 0xe8, 0xb9, 0x2a, 0x2c, 0x9f, 0x55, 0x04, 0xa5, 0x1d, 0x0e, 0xc4,
-	0x12, 0xc7, 0xb3, 0xc8, 0xd0, 0x89, 0x98, 0xc7, 0x74, 0x46, 0x5f, 0x4a, 0xb2, 0xc8, 0x6d, 0x8d,
-	0xc0, 0x09, 0x4d, 0xe6, 0xa4, 0x17, 0x4f, 0xfd, 0xa4, 0xaf, 0x42, 0x2d, 0x22, 0xff, 0x3c, 0xf2,
-	0x22, 0xd2, 0x6e, 0xb8, 0x7d, 0x2a, 0x02, 0x74, 0x29, 0xb9, 0xc8, 0xc0, 0x06, 0x0e, 0xa7, 0x28,
-	0x79, 0x58, 0xe7, 0x35, 0x62, 0x44, 0x28, 0x15, 0x4e, 0xa2, 0x92, 0x84, 0xf5, 0x96, 0x82, 0xe3,
-	0x98, 0x82, 0x67, 0x37, 0x9d, 0xc1, 0x88, 0xf6, 0xae, 0x71, 0x1e, 0x3c, 0x67, 0x15, 0xbe, 0xa2,
-	0x94, 0x64, 0x37, 0xd7, 0x52, 0x58, 0x9c, 0xa1, 0xd6, 0x9e, 0xb9, 0xf2, 0x7d, 0x79, 0x66, 0x23,
-	0xe0, 0x54, 0x4f, 0x31, 0xe0, 0x34, 0x60, 0x2e, 0xb6, 0x05, 0xcf, 0xef, 0x6e, 0x90, 0xf1, 0x02,
-	0xa4, 0x0b, 0x97, 0xed, 0x34, 0x1a, 0x67, 0xe9, 0xad, 0xcf, 0x0a, 0x50, 0xb9, 0x4d, 0x98, 0xc3,
-	0xb3, 0x54, 0xf4, 0x59, 0x0e, 0x66, 0x1d, 0xdf, 0x0f, 0x98, 0xb8, 0x20, 0xd6, 0x0e, 0xc5, 0x7e,
-	0xfc, 0xb5, 0x68, 0xce, 0xf5, 0x46, 0xc2, 0x55, 0x3a, 0x93, 0xb8, 0x52, 0x35, 0x30, 0xd8, 0x14,
-	0x8e, 0xf6, 0x63, 0xbf, 0x26, 0x63, 0xf8, 0x9d, 0x27, 0x30, 0x8d, 0x63, 0xb8, 0xb3, 0xc5, 0xf7,
-	0x60, 0x3e, 0x3b, 0xdb, 0x93, 0x78, 0x86, 0x69, 0x9c, 0xca, 0x8f, 0x0b, 0x30, 0x7b, 0xa7, 0xb1,
-	0x63, 0x1f, 0xd3, 0xa7, 0x18, 0x65, 0x76, 0xfe, 0x11, 0x65, 0xb6, 0x61, 0xa0, 0x85, 0xa7, 0xd6,
-	0xd8, 0x3a, 0x7d, 0xff, 0xa4, 0xce, 0x7d, 0xe9, 0x7b, 0x3a, 0xf7, 0xd6, 0xe7, 0x45, 0x98, 0xdf,
-	0x0a, 0x89, 0x7f, 0xaf, 0xe7, 0xd1, 0xbe, 0xde, 0xb5, 0x4b, 0x50, 0xec, 0x05, 0x94, 0x65, 0x93,
-	0xdd, 0x1b, 0x01, 0x65, 0x58, 0x60, 0xf8, 0xc6, 0xe9, 0x7b, 0x9b, 0xcc, 0xc6, 0xe9, 0x3b, 0x1b,
-	0x8d, 0xe7, 0x21, 0x81, 0xe7, 0xc7, 0x34, 0x74, 0xdc, 0x23, 0x17, 0x0b, 0x77, 0x34, 0x02, 0x27,
-	0x34, 0xa2, 0x25, 0x3b, 0x62, 0xbd, 0x9d, 0xa0, 0x4f, 0x7c, 0x75, 0xb5, 0x70, 0xa2, 0x96, 0xac,
-	0x1e, 0x8b, 0x13, 0x36, 0xbc, 0x6e, 0x73, 0x92, 0xf6, 0x70, 0x29, 0x5d, 0xb7, 0x35, 0x92, 0xe6,
-	0xb0, 0x41, 0x65, 0x5a, 0x5c, 0xf9, 0xa9, 0x59, 0xdc, 0xcc, 0xa9, 0xb7, 0x52, 0x7f, 0x91, 0x87,
-	0xb2, 0x2d, 0x98, 0xa0, 0x8f, 0xa1, 0x32, 0x54, 0x8e, 0x47, 0x55, 0x6a, 0xaf, 0x1f, 0xef, 0x7a,
-	0x6b, 0x4b, 0x9c, 0x59, 0xee, 0xb4, 0x12, 0x71, 0x09, 0x0c, 0xc7, 0x5c, 0x51, 0x47, 0xdd, 0xe0,
-	0x4f, 0x7d, 0x21, 0x23, 0x67, 0x6c, 0x87, 0xc4, 0x9d, 0x78, 0x69, 0xef, 0x43, 0x99, 0x32, 0x87,
-	0x8d, 0xe8, 0xf4, 0x77, 0x32, 0x4a, 0x92, 0xe0, 0x66, 0xdc, 0x6d, 0x8a, 0xdf, 0x58, 0x49, 0xb1,
-	0x7e, 0x95, 0x03, 0x90, 0x84, 0x9b, 0x1e, 0x65, 0xe8, 0x1f, 0x8f, 0x28, 0xb2, 0x7e, 0x3c, 0x45,
-	0xf2, 0xd1, 0x42, 0x8d, 0x71, 0x6e, 0xa1, 0x21, 0x86, 0x12, 0x09, 0x94, 0x3c, 0x46, 0x86, 0x3a,
-	0xcc, 0x7c, 0x30, 0xed, 0xda, 0x92, 0xdc, 0xee, 0x26, 0x67, 0x8b, 0x25, 0x77, 0xeb, 0x27, 0x25,
-	0xbd, 0x26, 0xae, 0x58, 0xf4, 0x69, 0x2e, 0x73, 0xc3, 0x2d, 0x63, 0xed, 0xcd, 0x27, 0x76, 0x0b,
-	0x98, 0x64, 0x61, 0x0f, 0xbf, 0x30, 0x47, 0x01, 0x54, 0x98, 0xb4, 0x70, 0xbd, 0xfc, 0xc6, 0xd4,
-	0x67, 0x25, 0x51, 0xb6, 0x02, 0x50, 0x1c, 0x0b, 0x41, 0x21, 0x54, 0x18, 0x19, 0x86, 0x03, 0x87,
-	0x91, 0xe9, 0x6f, 0x9a, 0x76, 0x14, 0x27, 0x43, 0xa2, 0x82, 0xe0, 0x58, 0x0a, 0xf7, 0xb5, 0xae,
-	0x17, 0xb9, 0x23, 0x8f, 0xa9, 0xaa, 0x39, 0xf6, 0x1d, 0x2d, 0x09, 0xc6, 0x1a, 0x8f, 0x3e, 0xcf,
-	0xc1, 0x7c, 0x3b, 0xdd, 0xaa, 0xd0, 0xe5, 0xf3, 0x14, 0xfb, 0x92, 0x69, 0x7e, 0xc4, 0x2d, 0x99,
-	0xf9, 0x0c, 0x82, 0xe2, 0x23, 0xc2, 0xd1, 0x2d, 0x40, 0xaa, 0x72, 0xb9, 0xe6, 0x78, 0x03, 0xd2,
-	0xc6, 0xc1, 0xc8, 0x6f, 0xab, 0x7c, 0x39, 0x6e, 0xf7, 0xad, 0x1f, 0xa1, 0xc0, 0x13, 0x46, 0xf1,
-	0x5c, 0x5d, 0x4c, 0xb5, 0x39, 0xa2, 0xc2, 0x8d, 0xcf, 0xa4, 0x9b, 0x8e, 0xeb, 0x06, 0x0e, 0xa7,
-	0x28, 0xad, 0x00, 0x6a, 0xe6, 0xb1, 0x45, 0x1f, 0xc5, 0xee, 0x40, 0x9e, 0xc6, 0xb7, 0x4e, 0xfe,
-	0x78, 0xe0, 0xcf, 0x9f, 0xff, 0x9f, 0xe6, 0xa1, 0x66, 0x0f, 0x1c, 0x37, 0x0e, 0xa9, 0x69, 0xaf,
-	0x9e, 0x3b, 0xf5, 0x3c, 0x62, 0x17, 0x80, 0x8a, 0xf9, 0x88, 0xa8, 0x7a, 0xa2, 0x5b, 0xb2, 0x73,
-	0xe2, 0x6e, 0x33, 0x1e, 0x8c, 0x0d, 0x46, 0xc2, 0x36, 0x7b, 0x8e, 0xef, 0x93, 0x81, 0x0a, 0xed,
-	0x89, 0x6d, 0x4a, 0x30, 0xd6, 0x78, 0x4e, 0x3a, 0x24, 0x94, 0x3a, 0x5d, 0x92, 0x35, 0xe3, 0xdb,
-	0x12, 0x8c, 0x35, 0xde, 0xfa, 0x63, 0x11, 0x90, 0xcd, 0x1c, 0xbf, 0xed, 0x44, 0xed, 0x8d, 0xd5,
-	0x38, 0x99, 0x7c, 0xe8, 0x93, 0x94, 0xdc, 0xd3, 0x78, 0x92, 0x62, 0xbc, 0x2d, 0xca, 0x9f, 0xca,
-	0xdb, 0xa2, 0x3b, 0xe6, 0xdb, 0x22, 0xa9, 0xed, 0xd7, 0x27, 0xbd, 0x2d, 0xfa, 0xeb, 0x8d, 0xd1,
-	0x1e, 0x89, 0x7c, 0xc2, 0x08, 0xd5, 0x73, 0x3d, 0xc6, 0x0b, 0xa3, 0xd3, 0x4f, 0x6d, 0x3b, 0x70,
-	0x36, 0x74, 0x98, 0xdb, 0xb3, 0x59, 0xe4, 0x30, 0xd2, 0x1d, 0xab, 0xb4, 0xec, 0x03, 0x35, 0xec,
-	0xec, 0xb6, 0x89, 0x7c, 0x70, 0xb0, 0xfc, 0xb7, 0x0f, 0x7b, 0x31, 0xc8, 0xc6, 0x21, 0xa1, 0x75,
-	0x41, 0x2e, 0xba, 0x79, 0x69, 0xb6, 0x3c, 0xf7, 0x1b, 0x78, 0xfb, 0x64, 0x2b, 0x69, 0xe7, 0x55,
-	0x92, 0xb9, 0x6d, 0xc6, 0x18, 0x6c, 0x50, 0x59, 0x2b, 0x50, 0x93, 0x27, 0x5a, 0x5d, 0x51, 0x2d,
-	0x43, 0xc9, 0x19, 0x0c, 0x82, 0xfb, 0xe2, 0xe4, 0x96, 0x64, 0x63, 0xa0, 0xc1, 0x01, 0x58, 0xc2,
-	0xad, 0x9f, 0xe5, 0xa0, 0x1a, 0xe7, 0xd8, 0x5c, 0xa4, 0xeb, 0xb4, 0x48, 0xc4, 0xb6, 0x93, 0x16,
-	0x49, 0x2c, 0xb2, 0xd5, 0xd0, 0x18, 0x6c, 0x50, 0xc9, 0xfe, 0x87, 0x47, 0x7c, 0x16, 0x8f, 0x3b,
-	0xd2, 0xff, 0x30, 0xb1, 0x38, 0x43, 0x8d, 0xde, 0x85, 0xb3, 0x12, 0xa2, 0xbb, 0x13, 0xd2, 0x44,
-	0x2e, 0x6a, 0x75, 0xb6, 0x4c, 0x24, 0x4e, 0xd3, 0x5a, 0xdf, 0x95, 0x20, 0x0e, 0x3d, 0x3c, 0xc4,
-	0x65, 0xb2, 0x95, 0xe6, 0xf4, 0x95, 0x6b, 0x12, 0xe2, 0x34, 0xc4, 0xc8, 0x60, 0xd4, 0xa3, 0x10,
-	0xcf, 0x25, 0x0d, 0xd7, 0x0d, 0x46, 0xaa, 0x0b, 0x99, 0x3f, 0xfa, 0x28, 0x24, 0x4d, 0x81, 0x27,
-	0x8c, 0x42, 0xb7, 0xc4, 0x9b, 0x26, 0xe6, 0x70, 0xfb, 0x50, 0x11, 0xfa, 0x85, 0x87, 0xbc, 0x69,
-	0x92, 0x44, 0xf1, 0x43, 0x26, 0xf9, 0x13, 0x27, 0xc3, 0xd1, 0x3a, 0xcc, 0xec, 0x07, 0x83, 0xd1,
-	0x90, 0xe8, 0xf3, 0xb1, 0x38, 0x89, 0xd3, 0x5d, 0x41, 0x62, 0x94, 0x40, 0x72, 0x08, 0xd6, 0x63,
-	0x11, 0x81, 0x39, 0xf1, 0x6e, 0xc6, 0x63, 0x63, 0xd5, 0xbf, 0x53, 0x05, 0xdd, 0x4b, 0x93, 0xd8,
-	0x6d, 0x07, 0x6d, 0x3b, 0x4d, 0xdd, 0x7c, 0xe6, 0xf0, 0x60, 0x79, 0x2e, 0x03, 0xc4, 0x59, 0x9e,
-	0xe8, 0x7f, 0x72, 0x50, 0xf3, 0x83, 0x36, 0xd1, 0x9e, 0x5b, 0x95, 0x2d, 0x3b, 0xd3, 0xe7, 0x27,
-	0xf5, 0x3b, 0x06, 0x5b, 0x79, 0xf9, 0x10, 0x87, 0x5d, 0x13, 0x85, 0x53, 0xf2, 0xd1, 0x2e, 0xcc,
-	0xb2, 0x60, 0xa0, 0xfc, 0x8d, 0xae, 0x65, 0x96, 0x26, 0xad, 0x79, 0x27, 0x26, 0x4b, 0xee, 0x55,
-	0x12, 0x18, 0xc5, 0x26, 0x9f, 0xc5, 0xf7, 0xe1, 0xfc, 0x91, 0xf9, 0x9c, 0xe8, 0x96, 0xc2, 0x06,
-	0x48, 0x1a, 0xb8, 0xe8, 0x45, 0x28, 0x51, 0xe6, 0x44, 0xba, 0xdc, 0x8d, 0x93, 0x5f, 0x9b, 0x03,
-	0xb1, 0xc4, 0xf1, 0x92, 0x98, 0xb2, 0x20, 0x54, 0x36, 0x99, 0x94, 0x18, 0x2c, 0x08, 0xb1, 0xc0,
-	0x58, 0xdf, 0xe5, 0x61, 0x46, 0x47, 0x2a, 0x6a, 0x24, 0x89, 0xb9, 0x69, 0x1b, 0x6c, 0x8a, 0x69,
-	0x9c, 0x2b, 0xd6, 0x1e, 0x92, 0x27, 0xa6, 0xfd, 0x79, 0xfe, 0xd4, 0xfd, 0x79, 0x1f, 0xca, 0xa1,
-	0xf0, 0x96, 0xea, 0xd4, 0x5d, 0x9f, 0x5e, 0xb6, 0x60, 0x27, 0x83, 0xa1, 0xfc, 0xc6, 0x4a, 0x84,
-	0xf5, 0xbb, 0x1c, 0xcc, 0x67, 0x67, 0x88, 0xfa, 0x50, 0xa0, 0x91, 0xab, 0x34, 0xbe, 0xfd, 0xe4,
-	0x96, 0x2e, 0x03, 0xb1, 0xbc, 0x39, 0xb1, 0x23, 0x17, 0x73, 0x29, 0xdc, 0x22, 0xda, 0x84, 0xb2,
-	0xac, 0x45, 0xac, 0x11, 0xca, 0xb0, 0xc0, 0xa0, 0xcd, 0xa3, 0x01, 0xbb, 0x3e, 0x29, 0x60, 0x3f,
-	0x97, 0x95, 0x37, 0x29, 0x5c, 0x5b, 0xbf, 0xce, 0xc3, 0xb3, 0x93, 0x27, 0xc6, 0x43, 0x47, 0x92,
-	0x78, 0x1b, 0x0f, 0xe0, 0xe3, 0xd0, 0xb1, 0x96, 0xc2, 0xe2, 0x0c, 0xb5, 0x08, 0x57, 0xd2, 0x87,
-	0xe8, 0x57, 0xf0, 0x66, 0xb8, 0x8a, 0x31, 0xd8, 0xa0, 0x42, 0x0d, 0x98, 0x53, 0xbf, 0x76, 0xcc,
-	0x72, 0xc8, 0xb8, 0xb9, 0x6d, 0xa5, 0xd1, 0x38, 0x4b, 0xcf, 0x33, 0x42, 0xee, 0xfd, 0xb9, 0xcc,
-	0x4c, 0x46, 0xb8, 0x26, 0xc1, 0x58, 0xe3, 0x79, 0xea, 0xcf, 0x3f, 0x63, 0x51, 0xa5, 0x74, 0xea,
-	0xbf, 0x66, 0xe0, 0x70, 0x8a, 0x32, 0x79, 0x1f, 0x26, 0xdb, 0xe9, 0x47, 0xde, 0x87, 0x59, 0xdf,
-	0xe6, 0xe0, 0x6c, 0xca, 0xde, 0x50, 0x07, 0x0a, 0xfd, 0x55, 0x5d, 0x1a, 0x6c, 0x3c, 0xc1, 0x66,
-	0x94, 0xb4, 0xa0, 0x8d, 0x55, 0x8a, 0xb9, 0x00, 0xf4, 0x49, 0x5c, 0x85, 0xe4, 0xa7, 0xbe, 0x94,
-	0x30, 0x92, 0x15, 0x95, 0x3c, 0xa6, 0x0b, 0x92, 0xf5, 0x78, 0x91, 0xf6, 0x7d, 0x8f, 0xb9, 0x3d,
-	0xf4, 0x1c, 0x14, 0x1c, 0x7f, 0x2c, 0xf2, 0x99, 0xaa, 0x9c, 0x57, 0xc3, 0x1f, 0x63, 0x0e, 0x13,
-	0xa8, 0xc1, 0x40, 0x35, 0xb2, 0x25, 0x6a, 0x30, 0xc0, 0x1c, 0x66, 0xfd, 0x5f, 0x15, 0xe6, 0x32,
-	0xfe, 0xe8, 0x18, 0xad, 0xf1, 0x3e, 0x94, 0xa9, 0x90, 0xaa, 0x16, 0x3a, 0xbd, 0x67, 0x90, 0x8b,
-	0x50, 0x2b, 0x15, 0xdf, 0x58, 0x89, 0x40, 0x5d, 0xb9, 0x7b, 0xd2, 0x07, 0x6d, 0x4e, 0xa5, 0xd2,
-	0x4c, 0x01, 0x92, 0xd9, 0xbe, 0x4f, 0x73, 0x50, 0x73, 0x8c, 0x67, 0xfc, 0xea, 0xae, 0xf2, 0xf6,
-	0x34, 0x65, 0xc0, 0x91, 0x7f, 0x30, 0xc8, 0x17, 0x83, 0x26, 0x02, 0xa7, 0x84, 0x22, 0x17, 0x8a,
-	0x3d, 0xc6, 0xf4, 0xeb, 0xed, 0xf5, 0x27, 0xd2, 0x0a, 0x96, 0xaf, 0x17, 0x38, 0x00, 0x0b, 0xe6,
-	0xe8, 0x3e, 0x54, 0x9d, 0xfb, 0x54, 0xfe, 0xe7, 0x46, 0x3d, 0xeb, 0x9e, 0xa6, 0xda, 0xc9, 0xfc,
-	0x7d, 0x47, 0xdd, 0xdb, 0x6a, 0x28, 0x4e, 0x64, 0xa1, 0x08, 0xca, 0xae, 0x78, 0x9b, 0xab, 0x5a,
-	0xeb, 0xd7, 0x9f, 0xd0, 0x1b, 0xdf, 0xe6, 0x79, 0x91, 0x12, 0x9b, 0x20, 0xac, 0x24, 0xa1, 0x2e,
-	0x94, 0xfa, 0x4e, 0xa7, 0xef, 0xa8, 0x66, 0xdb, 0x14, 0xa7, 0xd2, 0x6c, 0xaf, 0x4a, 0xcf, 0x23,
-	0x20, 0x58, 0xf2, 0xe7, 0x5b, 0xe7, 0x3b, 0x8c, 0xaa, 0x27, 0x38, 0x53, 0x6c, 0x9d, 0xd1, 0x71,
-	0x91, 0x5b, 0xc7, 0x01, 0x58, 0x30, 0xe7, 0xab, 0x11, 0xf5, 0xba, 0xe8, 0xab, 0x4d, 0xe7, 0x63,
-	0x8c, 0xfb, 0x0c, 0xb9, 0x1a, 0x01, 0xc1, 0x92, 0x3f, 0xb7, 0x91, 0x40, 0x37, 0x12, 0x16, 0x66,
-	0xa7, 0xb5, 0x91, 0x6c, 0x4f, 0x42, 0xda, 0x48, 0x0c, 0xc5, 0x89, 0x2c, 0xcb, 0x85, 0x59, 0xe3,
-	0x1f, 0x0e, 0xc7, 0x78, 0x60, 0x7c, 0x05, 0x60, 0x9f, 0x44, 0x5e, 0x67, 0xcc, 0x6b, 0x27, 0xf5,
-	0xd0, 0x3d, 0x0e, 0x77, 0x77, 0x63, 0x0c, 0x36, 0xa8, 0x9a, 0xf5, 0x2f, 0xbf, 0x59, 0x3a, 0xf3,
-	0xd5, 0x37, 0x4b, 0x67, 0xbe, 0xfe, 0x66, 0xe9, 0xcc, 0x7f, 0x1c, 0x2e, 0xe5, 0xbe, 0x3c, 0x5c,
-	0xca, 0x7d, 0x75, 0xb8, 0x94, 0xfb, 0xfa, 0x70, 0x29, 0xf7, 0x9b, 0xc3, 0xa5, 0xdc, 0xff, 0x7e,
-	0xbb, 0x74, 0xe6, 0x1f, 0x2a, 0x7a, 0xfe, 0x7f, 0x0a, 0x00, 0x00, 0xff, 0xff, 0x7c, 0xb9, 0xab,
-	0x29, 0x5e, 0x38, 0x00, 0x00,
+	// 5835 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3d, 0x5d, 0x6f, 0x24, 0x59,
+	0x75, 0xdb, 0xdf, 0xed, 0x6b, 0x7b, 0xec, 0xa9, 0xf9, 0xd8, 0x5a, 0xc3, 0x8e, 0x27, 0x8d, 0xb2,
+	0xd9, 0x45, 0xd0, 0x03, 0xbb, 0x10, 0x06, 0x10, 0x1f, 0xee, 0xb6, 0x3d, 0x5f, 0xed, 0x8f, 0x39,
+	0xd5, 0xb3, 0x23, 0x41, 0x02, 0x94, 0xab, 0x6f, 0xb7, 0x6b, 0x5d, 0x5d, 0xd5, 0x7b, 0xab, 0xda,
+	0xb3, 0x4e, 0x94, 0x10, 0x89, 0x48, 0x89, 0x00, 0x01, 0x12, 0x91, 0xf2, 0x06, 0x0f, 0x79, 0xe0,
+	0x21, 0x09, 0xfc, 0x00, 0x22, 0x25, 0x8a, 0x14, 0x09, 0xe5, 0x89, 0x48, 0x89, 0xc4, 0xd3, 0x08,
+	0xcc, 0x43, 0x1e, 0x22, 0x65, 0x15, 0x29, 0x4f, 0x23, 0x45, 0x8a, 0xce, 0xfd, 0xa8, 0xba, 0x55,
+	0xdd, 0xde, 0xb1, 0xa7, 0x3c, 0x36, 0x84, 0xbc, 0xb9, 0xcf, 0x39, 0xf7, 0xdc, 0x5b, 0xf7, 0x9e,
+	0x7b, 0xee, 0xf9, 0xba, 0xd7, 0xe4, 0xf6, 0xc0, 0x8d, 0x76, 0xc7, 0x3b, 0x4d, 0x27, 0x18, 0xde,
+	0xb0, 0xd9, 0x20, 0x18, 0xb1, 0xe0, 0x2d, 0xfe, 0xc7, 0x87, 0xe9, 0x3e, 0xf5, 0xa3, 0xf0, 0xc6,
+	0x68, 0x6f, 0x70, 0xc3, 0x1e, 0xb9, 0xe1, 0x8d, 0x90, 0xfa, 0x61, 0xc0, 0x6e, 0xec, 0x7f, 0xd4,
+	0xf6, 0x46, 0xbb, 0xf6, 0x47, 0x6f, 0x0c, 0xa8, 0x4f, 0x99, 0x1d, 0xd1, 0x5e, 0x73, 0xc4, 0x82,
+	0x28, 0x30, 0x6e, 0x26, 0x9c, 0x9a, 0x8a, 0x13, 0xff, 0xe3, 0xcb, 0x82, 0x53, 0x73, 0xb4, 0x37,
+	0x68, 0x22, 0xa7, 0xa6, 0xe0, 0xd4, 0x54, 0x9c, 0x96, 0x3e, 0x77, 0xec, 0x31, 0x38, 0xc1, 0x70,
+	0x18, 0xf8, 0xd9, 0xae, 0x97, 0x3e, 0xac, 0x31, 0x18, 0x04, 0x83, 0xe0, 0x06, 0x07, 0xef, 0x8c,
+	0xfb, 0xfc, 0x17, 0xff, 0xc1, 0xff, 0x92, 0xe4, 0x8d, 0xbd, 0x9b, 0x61, 0xd3, 0x0d, 0x90, 0xe5,
+	0x0d, 0x27, 0x60, 0xf4, 0xc6, 0xfe, 0xc4, 0xd7, 0x2c, 0x7d, 0x2c, 0xa1, 0x19, 0xda, 0xce, 0xae,
+	0xeb, 0x53, 0x76, 0x90, 0x8c, 0x63, 0x48, 0x23, 0x7b, 0x5a, 0xab, 0x1b, 0x47, 0xb5, 0x62, 0x63,
+	0x3f, 0x72, 0x87, 0x74, 0xa2, 0xc1, 0xef, 0x3e, 0xad, 0x41, 0xe8, 0xec, 0xd2, 0xa1, 0x9d, 0x6d,
+	0xd7, 0xf8, 0x79, 0x95, 0xcc, 0xae, 0x6c, 0xdc, 0xdf, 0xee, 0x32, 0x77, 0x30, 0xa0, 0xcc, 0x78,
+	0x99, 0x94, 0xc6, 0xcc, 0x33, 0x0b, 0xd7, 0x0b, 0xaf, 0xce, 0xb4, 0x66, 0x7f, 0xf2, 0x78, 0xf9,
+	0x85, 0xc3, 0xc7, 0xcb, 0xa5, 0x07, 0xd0, 0x01, 0x84, 0x1b, 0x37, 0xc9, 0x1c, 0x7d, 0xc7, 0xd9,
+	0xb5, 0xfd, 0x01, 0xdd, 0xb4, 0x87, 0xd4, 0x2c, 0x72, 0xba, 0xcb, 0x92, 0x6e, 0x6e, 0x4d, 0xc3,
+	0x41, 0x8a, 0xd2, 0x78, 0x9d, 0x10, 0x16, 0x8c, 0x23, 0xd7, 0x1f, 0xdc, 0xa3, 0x07, 0x66, 0x89,
+	0xb7, 0x33, 0x64, 0x3b, 0x02, 0x31, 0x06, 0x34, 0x2a, 0xe3, 0x8f, 0x48, 0x6d, 0x97, 0xda, 0x3d,
+	0xca, 0x42, 0xb3, 0x7c, 0xbd, 0xf4, 0xea, 0xec, 0xeb, 0xd0, 0x7c, 0x56, 0xd9, 0x68, 0x6a, 0x1f,
+	0xd9, 0xbc, 0x2d, 0x98, 0xae, 0xf9, 0x11, 0x3b, 0x68, 0x2d, 0xc8, 0x41, 0xd4, 0x24, 0x14, 0x54,
+	0x9f, 0xc6, 0xc7, 0xc9, 0xac, 0x13, 0xf8, 0x11, 0xf5, 0xa3, 0xee, 0xc1, 0x88, 0x9a, 0x15, 0x3e,
+	0xe6, 0x4b, 0x92, 0x7c, 0xb6, 0x9d, 0xa0, 0x40, 0xa7, 0xc3, 0x2f, 0x1d, 0x51, 0x16, 0xba, 0x21,
+	0x42, 0xcc, 0xea, 0xf5, 0xc2, 0xab, 0xf5, 0xe4, 0x4b, 0xb7, 0x63, 0x0c, 0x68, 0x54, 0xc6, 0x2d,
+	0x72, 0x71, 0x34, 0xde, 0xf1, 0xdc, 0x70, 0x97, 0xb2, 0x76, 0xe0, 0xf7, 0x5d, 0x36, 0x0c, 0xcd,
+	0x1a, 0x6f, 0xfa, 0x92, 0x6c, 0x7a, 0x71, 0x3b, 0x4b, 0x00, 0x93, 0x6d, 0x8c, 0x2f, 0x91, 0x52,
+	0xe4, 0x85, 0x66, 0xfd, 0x7a, 0xe1, 0xd5, 0xd9, 0xd7, 0xdb, 0xcf, 0x3e, 0x5d, 0xdd, 0x8e, 0xc5,
+	0x79, 0x0e, 0x5a, 0x35, 0x14, 0x80, 0x6e, 0xc7, 0x02, 0x64, 0x6c, 0x8c, 0x49, 0x6d, 0x64, 0x1f,
+	0x78, 0x81, 0xdd, 0x33, 0x67, 0xf8, 0x92, 0xdc, 0xcd, 0xd1, 0x87, 0x58, 0x8e, 0x6d, 0x9b, 0xd9,
+	0x43, 0x1a, 0x51, 0x96, 0x2c, 0xc5, 0xb6, 0xe8, 0x02, 0x54, 0x5f, 0xc6, 0x1f, 0x13, 0x32, 0x52,
+	0x64, 0xa1, 0x49, 0x4e, 0xbd, 0xe7, 0x64, 0x7d, 0xe2, 0x5e, 0x40, 0xeb, 0x71, 0xe9, 0x53, 0x64,
+	0x4e, 0x17, 0x1a, 0x63, 0x91, 0x94, 0xf6, 0xe8, 0x81, 0xd8, 0x26, 0x80, 0x7f, 0x1a, 0x97, 0x49,
+	0x65, 0xdf, 0xf6, 0xc6, 0x72, 0x4b, 0x80, 0xf8, 0xf1, 0xa9, 0xe2, 0xcd, 0x42, 0xe3, 0xbb, 0x65,
+	0xb2, 0xb8, 0xf2, 0xd0, 0xea, 0xd8, 0xc3, 0x9d, 0x9e, 0xad, 0xf6, 0xd9, 0x4d, 0x32, 0xd7, 0x1f,
+	0xfb, 0x4e, 0xe4, 0x06, 0x3e, 0xdf, 0x48, 0x85, 0xf4, 0x46, 0x5a, 0xd7, 0x70, 0x90, 0xa2, 0x34,
+	0x80, 0xcc, 0xd8, 0x8e, 0x43, 0xc3, 0x10, 0xf7, 0x51, 0x91, 0xaf, 0xf3, 0x6f, 0x37, 0xc5, 0xee,
+	0xc7, 0x8f, 0x6d, 0xa2, 0x22, 0x6a, 0xee, 0x7f, 0xb4, 0x69, 0x51, 0x87, 0xd1, 0xe8, 0x1e, 0x3d,
+	0xb0, 0xa8, 0x47, 0x9d, 0x28, 0x60, 0xad, 0xf9, 0xc3, 0xc7, 0xcb, 0x33, 0x2b, 0xaa, 0x2d, 0x24,
+	0x6c, 0x90, 0x67, 0xa8, 0xc8, 0xcd, 0xd2, 0x89, 0x79, 0xc6, 0x60, 0x48, 0xd8, 0x18, 0xaf, 0x90,
+	0x2a, 0xa3, 0x03, 0x37, 0xf0, 0xcd, 0x32, 0xff, 0xb6, 0x0b, 0xf2, 0xdb, 0xaa, 0xc0, 0xa1, 0x20,
+	0xb1, 0xba, 0x44, 0x55, 0xce, 0x4d, 0xa2, 0xaa, 0x67, 0x2d, 0x51, 0x8d, 0xc3, 0x1a, 0x99, 0x5f,
+	0x79, 0x68, 0x59, 0x9b, 0x96, 0x12, 0x89, 0x0f, 0x91, 0x7a, 0x14, 0x8c, 0x5c, 0x67, 0x85, 0xf9,
+	0x52, 0x1c, 0x16, 0x25, 0x8f, 0x7a, 0x57, 0xc2, 0x21, 0xa6, 0xd0, 0xa6, 0xb7, 0xf8, 0x9e, 0xd3,
+	0x9b, 0x12, 0x97, 0xd2, 0x73, 0x10, 0x97, 0xf2, 0xe9, 0x88, 0xcb, 0x6b, 0xa4, 0xc6, 0x02, 0x8f,
+	0xae, 0xc0, 0xa6, 0x54, 0xb4, 0xf1, 0xd2, 0x81, 0x00, 0x83, 0xc2, 0xeb, 0x12, 0x53, 0x3d, 0x43,
+	0x89, 0x79, 0x8d, 0xd4, 0xc2, 0xf1, 0xce, 0x5b, 0xd4, 0x89, 0xcc, 0x5a, 0x7a, 0x84, 0x96, 0x00,
+	0x83, 0xc2, 0x1b, 0x3f, 0x28, 0x90, 0x8b, 0x43, 0x1a, 0x86, 0xf6, 0x80, 0xae, 0x44, 0x11, 0x73,
+	0x77, 0xc6, 0x11, 0x45, 0xa5, 0x8c, 0x83, 0xfd, 0x52, 0x8e, 0x33, 0x4c, 0x97, 0x97, 0xe6, 0x46,
+	0xb6, 0x03, 0x71, 0x9e, 0xc5, 0xe7, 0xc5, 0x04, 0x1e, 0x26, 0xc7, 0x64, 0x7c, 0x96, 0x5c, 0x90,
+	0xc0, 0x5b, 0x2c, 0x18, 0x8f, 0xee, 0xa0, 0x5a, 0xc7, 0x6f, 0xbb, 0x2a, 0xb9, 0x5c, 0xd8, 0xd0,
+	0xb1, 0xab, 0x90, 0xa1, 0x36, 0xde, 0x24, 0x57, 0x25, 0x64, 0x95, 0xf6, 0xc6, 0x23, 0xcf, 0x75,
+	0x6c, 0xd4, 0x54, 0x77, 0x7a, 0x26, 0xe1, 0x7c, 0xae, 0x49, 0x3e, 0x57, 0x37, 0xa6, 0x51, 0xad,
+	0xc2, 0x11, 0xad, 0x33, 0xdb, 0x73, 0xf6, 0xcc, 0x15, 0xfe, 0x2a, 0xb9, 0x3a, 0x7d, 0x7e, 0x4f,
+	0xa4, 0xfa, 0xff, 0x53, 0x6e, 0xf2, 0xfb, 0xf1, 0x26, 0xff, 0x00, 0xa9, 0xbc, 0x3d, 0xa6, 0x63,
+	0xa5, 0xf0, 0xe7, 0xe5, 0x30, 0x2a, 0xf7, 0x11, 0x08, 0x02, 0x87, 0x8b, 0xc2, 0xff, 0x58, 0x71,
+	0x9c, 0x60, 0xec, 0x47, 0x77, 0x7a, 0x66, 0x31, 0xbd, 0x28, 0xf7, 0x75, 0xec, 0x2a, 0x64, 0xa8,
+	0x35, 0xdd, 0x50, 0x3a, 0xbe, 0x6e, 0x28, 0x3f, 0x07, 0xdd, 0x50, 0x39, 0x75, 0xdd, 0x50, 0x3d,
+	0xbe, 0x6e, 0xa8, 0x9d, 0xa1, 0x6e, 0x78, 0x8e, 0x1b, 0xfe, 0xfe, 0xff, 0x6f, 0xf8, 0x5f, 0x9f,
+	0x0d, 0xff, 0x6e, 0x95, 0x5c, 0x5a, 0x61, 0x83, 0xe0, 0x61, 0xc0, 0xf6, 0xfa, 0x5e, 0xf0, 0x48,
+	0x6d, 0x7b, 0x9f, 0x54, 0xc3, 0x60, 0xcc, 0x1c, 0xb1, 0xef, 0x73, 0x7d, 0xd9, 0x0a, 0x8b, 0xdc,
+	0xbe, 0xed, 0x44, 0x9d, 0x40, 0xcc, 0x5d, 0x8b, 0xe0, 0xce, 0xb6, 0x38, 0x77, 0x90, 0xbd, 0x18,
+	0xb7, 0xc9, 0x4c, 0x30, 0xa2, 0x8c, 0x13, 0x48, 0xe5, 0xf1, 0x41, 0x39, 0x01, 0x33, 0x5b, 0x0a,
+	0xf1, 0xe4, 0xf1, 0xf2, 0x15, 0x7d, 0xb0, 0x31, 0x02, 0x92, 0xc6, 0x99, 0x75, 0x29, 0x9d, 0xf5,
+	0xba, 0x18, 0xdf, 0x2c, 0x90, 0xcb, 0x03, 0x94, 0xbd, 0x37, 0xd1, 0x5b, 0x0a, 0x7c, 0xa0, 0x72,
+	0x22, 0x85, 0xbe, 0xfa, 0x94, 0xa6, 0x5b, 0x62, 0xc7, 0x37, 0xe9, 0x1e, 0xfd, 0x6b, 0xd4, 0x36,
+	0xb7, 0xa6, 0x70, 0x68, 0xbd, 0x5f, 0x76, 0x7d, 0x79, 0x1a, 0x16, 0xa6, 0xf6, 0x6a, 0xfc, 0x21,
+	0x99, 0xb1, 0xd9, 0x60, 0x3c, 0xc4, 0xaf, 0x7c, 0x0e, 0xf6, 0xea, 0x45, 0xb5, 0x48, 0x2b, 0xaa,
+	0x13, 0x48, 0xfa, 0x33, 0xbe, 0x53, 0x20, 0x17, 0x07, 0x36, 0xdb, 0xb1, 0x07, 0xb4, 0x1d, 0x78,
+	0xa8, 0x36, 0x71, 0x79, 0xab, 0x7c, 0x22, 0xee, 0x3d, 0xfb, 0x28, 0x6e, 0x65, 0x59, 0xb6, 0xae,
+	0xa0, 0x3a, 0x99, 0x00, 0xc3, 0x64, 0xe7, 0xc6, 0x57, 0xb9, 0x8f, 0xec, 0x8c, 0x19, 0xa3, 0xbe,
+	0x73, 0xc0, 0x0d, 0xa3, 0xd9, 0xd7, 0x3b, 0xb9, 0x67, 0xa4, 0x9d, 0xf0, 0x6c, 0x2d, 0x48, 0x6f,
+	0x5b, 0x01, 0x40, 0xef, 0xb1, 0xf1, 0x5f, 0xe8, 0x5d, 0x65, 0xb6, 0x84, 0x61, 0x91, 0x62, 0xf8,
+	0x86, 0xdc, 0x6a, 0x9f, 0x3e, 0xfe, 0x60, 0x44, 0x54, 0xa8, 0x69, 0xbd, 0xa1, 0x18, 0xb6, 0xaa,
+	0x87, 0x8f, 0x97, 0x8b, 0xd6, 0x1b, 0x50, 0x0c, 0xdf, 0x30, 0x1a, 0xa4, 0xea, 0xfa, 0x9e, 0xeb,
+	0xab, 0xa8, 0x07, 0xdf, 0x77, 0x77, 0x38, 0x04, 0x24, 0xc6, 0xe8, 0x91, 0x72, 0xdf, 0xf5, 0xa8,
+	0x34, 0xb4, 0xd7, 0x9f, 0x7d, 0x1e, 0xd6, 0x5d, 0x8f, 0xc6, 0xa3, 0xa8, 0x1f, 0x3e, 0x5e, 0x2e,
+	0x23, 0x04, 0x38, 0x77, 0xe3, 0x2b, 0x22, 0x48, 0x23, 0x76, 0xc0, 0xda, 0xb3, 0x77, 0xf2, 0x00,
+	0x3a, 0x71, 0x1f, 0xb5, 0x54, 0x9c, 0xe7, 0x01, 0x99, 0x71, 0xb8, 0xfb, 0x3f, 0xb4, 0x47, 0xf2,
+	0x14, 0x7f, 0x75, 0xda, 0x29, 0x2e, 0x62, 0x04, 0x1b, 0xf6, 0x68, 0xe2, 0x20, 0x6f, 0xab, 0xe6,
+	0x90, 0x70, 0xc2, 0x81, 0x0f, 0xdc, 0xc8, 0xac, 0xe6, 0x1d, 0xf8, 0x2d, 0x37, 0x4a, 0x0f, 0xfc,
+	0x96, 0x1b, 0x01, 0xb2, 0x36, 0x1c, 0x52, 0x67, 0x4a, 0x43, 0x08, 0x61, 0xfc, 0xe4, 0x89, 0xd7,
+	0x3f, 0x56, 0x10, 0x73, 0xe8, 0x7b, 0xa9, 0x5f, 0x10, 0x33, 0x6e, 0xfc, 0x6d, 0x81, 0xcc, 0xb4,
+	0xec, 0xd0, 0x75, 0x56, 0xc6, 0xd1, 0xae, 0xb1, 0x45, 0xea, 0xe3, 0x90, 0x32, 0x5f, 0xb9, 0xf1,
+	0xc7, 0x36, 0x78, 0x38, 0xfb, 0x07, 0xb2, 0x29, 0xc4, 0x4c, 0x90, 0xe1, 0xc8, 0x0e, 0xc3, 0x47,
+	0x01, 0xeb, 0x99, 0xc5, 0x13, 0x33, 0xdc, 0x96, 0x4d, 0x21, 0x66, 0xd2, 0xf8, 0x41, 0x91, 0x5c,
+	0x68, 0xbb, 0xcc, 0x19, 0xbb, 0x51, 0x8b, 0x51, 0x7b, 0x8f, 0x32, 0x63, 0x95, 0x2c, 0xf6, 0x6d,
+	0xd7, 0x1b, 0x33, 0xda, 0xdd, 0x65, 0x34, 0xdc, 0x0d, 0xbc, 0x1e, 0x1f, 0x7c, 0xa5, 0x65, 0x4a,
+	0x15, 0xb4, 0xb8, 0x9e, 0xc1, 0xc3, 0x44, 0x0b, 0x34, 0x26, 0x9c, 0x20, 0xf0, 0xb6, 0xfa, 0x7d,
+	0x8b, 0x3a, 0x81, 0xdf, 0x0b, 0xf9, 0x78, 0x4b, 0x89, 0x31, 0xd1, 0x4e, 0x61, 0x21, 0x43, 0x6d,
+	0x7c, 0xab, 0x40, 0x2e, 0xf6, 0xa8, 0xdd, 0xeb, 0xd0, 0x28, 0xa2, 0x4c, 0xee, 0x7d, 0xb9, 0x79,
+	0xee, 0xe4, 0x56, 0x22, 0x5d, 0x3a, 0x1c, 0x79, 0x76, 0x44, 0x85, 0x3a, 0x5b, 0xcd, 0xf6, 0x03,
+	0x93, 0x5d, 0x37, 0xbe, 0x5b, 0x21, 0xf3, 0xed, 0x71, 0x18, 0x05, 0x43, 0x09, 0x31, 0x6e, 0xa0,
+	0x3d, 0xcb, 0xf6, 0x29, 0x7b, 0x00, 0x1d, 0x69, 0xb4, 0xc7, 0x4a, 0xda, 0x52, 0x08, 0x48, 0x68,
+	0xd0, 0xf8, 0x0e, 0xa9, 0x33, 0x66, 0x42, 0x4d, 0xd4, 0x13, 0xe3, 0xdb, 0xe2, 0x50, 0x90, 0x58,
+	0x8c, 0x00, 0x39, 0x94, 0x45, 0xb8, 0xad, 0xb7, 0xed, 0x68, 0xd7, 0x2c, 0xa5, 0x23, 0x40, 0x6d,
+	0x0d, 0x07, 0x29, 0x4a, 0xe3, 0x2e, 0x31, 0x44, 0x77, 0x18, 0x0f, 0xda, 0xda, 0xa7, 0x8c, 0xb9,
+	0x3d, 0x2a, 0xa3, 0x2c, 0x4b, 0xb2, 0xbd, 0x61, 0x4d, 0x50, 0xc0, 0x94, 0x56, 0x46, 0x48, 0xca,
+	0xe1, 0x88, 0x3a, 0xf2, 0x28, 0xbb, 0xff, 0xec, 0x73, 0x9e, 0x9a, 0xb5, 0xa6, 0x35, 0xa2, 0x8e,
+	0xb0, 0x4e, 0xe7, 0xe4, 0x80, 0xca, 0x08, 0x02, 0xde, 0xd9, 0x79, 0xc7, 0x5e, 0xce, 0xc9, 0x49,
+	0x58, 0xfa, 0x04, 0x99, 0x89, 0xe7, 0xe5, 0x44, 0x56, 0xe5, 0xdf, 0x17, 0x08, 0x59, 0xb5, 0x23,
+	0x7b, 0xdd, 0xf5, 0x22, 0xca, 0x8c, 0xeb, 0xa4, 0x3c, 0x42, 0x89, 0x11, 0xd2, 0x18, 0x4f, 0x30,
+	0x97, 0x14, 0x8e, 0x31, 0x3e, 0x44, 0xca, 0xd1, 0xc1, 0x48, 0x72, 0x8a, 0x77, 0x74, 0x19, 0xc3,
+	0xd3, 0x4f, 0x1e, 0x2f, 0xd7, 0xef, 0x5a, 0x5b, 0x9b, 0xf8, 0x37, 0x70, 0x2a, 0x63, 0x59, 0x75,
+	0x8c, 0xd6, 0xdd, 0x4c, 0x6b, 0x06, 0xfd, 0xd1, 0x37, 0x11, 0x20, 0xc7, 0x60, 0x7c, 0x9e, 0x10,
+	0x27, 0x18, 0xe2, 0x04, 0x46, 0x01, 0x93, 0x82, 0x76, 0x5d, 0xcd, 0x71, 0x3b, 0xc6, 0x3c, 0x49,
+	0xfd, 0x02, 0xad, 0x4d, 0xc3, 0x25, 0x0b, 0xab, 0x74, 0x44, 0xfd, 0x1e, 0x9e, 0xd9, 0xdc, 0xdc,
+	0xc2, 0xaf, 0xf0, 0x93, 0xc8, 0x67, 0xfc, 0x15, 0x3c, 0xe2, 0xc9, 0x31, 0xc6, 0xc7, 0xc8, 0x5c,
+	0x4f, 0x35, 0x72, 0x29, 0xea, 0x16, 0x1c, 0xde, 0x22, 0xee, 0x8e, 0x55, 0x0d, 0x0e, 0x29, 0xaa,
+	0xc6, 0xbf, 0x56, 0xc8, 0xdc, 0xda, 0xd0, 0x76, 0x3d, 0xb5, 0x83, 0xd3, 0xd2, 0x56, 0x38, 0x73,
+	0x69, 0xbb, 0x4e, 0xca, 0xbb, 0x41, 0x18, 0x99, 0xc5, 0xf4, 0x87, 0xde, 0x0e, 0xc2, 0x08, 0x38,
+	0x86, 0x2f, 0x68, 0xc0, 0x22, 0xae, 0x02, 0x2a, 0xda, 0x82, 0x06, 0x2c, 0x02, 0x8e, 0x31, 0x3e,
+	0x43, 0xea, 0x5c, 0x6d, 0xb8, 0xd1, 0x81, 0x9c, 0xff, 0xdf, 0x52, 0xb1, 0x41, 0x4b, 0xc2, 0x9f,
+	0x3c, 0x5e, 0x9e, 0xe7, 0xdf, 0xad, 0x00, 0x10, 0x37, 0x51, 0x59, 0x81, 0xca, 0xf3, 0xca, 0x0a,
+	0xe8, 0x47, 0x60, 0xf5, 0xb4, 0x8f, 0xc0, 0xda, 0x29, 0x1c, 0x81, 0x38, 0xc5, 0x7d, 0x16, 0x0c,
+	0xcd, 0x7a, 0x7a, 0x11, 0xd6, 0x59, 0x30, 0x04, 0x8e, 0x31, 0xae, 0x92, 0x62, 0x14, 0xf0, 0xa4,
+	0xc6, 0x8c, 0x30, 0xfb, 0xba, 0x01, 0x14, 0xa3, 0x00, 0xe1, 0x8e, 0x63, 0x92, 0x04, 0xde, 0x76,
+	0xa0, 0xe8, 0x38, 0x7a, 0x38, 0x70, 0xf6, 0x29, 0xe1, 0xc0, 0xeb, 0xa4, 0xbc, 0x13, 0xf4, 0x0e,
+	0xcc, 0xb9, 0x74, 0xe7, 0xad, 0xa0, 0x77, 0x00, 0x1c, 0xc3, 0x65, 0x24, 0x1a, 0x7a, 0xe6, 0x7c,
+	0x46, 0x46, 0xba, 0x1b, 0x1d, 0xe0, 0x98, 0xc6, 0x5f, 0x14, 0x48, 0x65, 0x0d, 0x97, 0xc7, 0x18,
+	0x92, 0x1a, 0x4f, 0x37, 0xbd, 0x13, 0x99, 0x85, 0xbc, 0x66, 0x26, 0xe7, 0xd8, 0x16, 0xdc, 0x5a,
+	0xb3, 0x38, 0x78, 0xf9, 0x03, 0x54, 0x1f, 0xc6, 0xfb, 0x49, 0xb9, 0x67, 0x47, 0x36, 0x17, 0xdf,
+	0x39, 0x61, 0x8a, 0xa2, 0x2e, 0x02, 0x0e, 0x6d, 0xfc, 0x4f, 0x91, 0x2c, 0x70, 0x26, 0xad, 0x71,
+	0xa8, 0x36, 0xdc, 0x75, 0xa9, 0x7d, 0x32, 0x3b, 0x5b, 0xd3, 0x38, 0xaf, 0xc4, 0xee, 0x70, 0x26,
+	0x78, 0x9d, 0x71, 0x63, 0xb5, 0x39, 0x2e, 0x3d, 0x65, 0x8e, 0x35, 0x9d, 0x5e, 0x3e, 0xb7, 0x34,
+	0x42, 0xe5, 0xcc, 0xd3, 0x08, 0xff, 0x5e, 0x24, 0x73, 0xfa, 0x22, 0x1a, 0x4b, 0xa4, 0xe8, 0xf6,
+	0xe4, 0xd4, 0x13, 0xd9, 0xb8, 0x78, 0x67, 0x15, 0x8a, 0x6e, 0xef, 0xd8, 0xd3, 0xfe, 0x71, 0x32,
+	0x8b, 0xe7, 0xf4, 0xbe, 0xf0, 0x7d, 0xcd, 0x52, 0x3a, 0xf1, 0x89, 0x67, 0x98, 0x72, 0x8b, 0x75,
+	0xba, 0x78, 0xdd, 0xcb, 0x47, 0xae, 0xfb, 0x0a, 0x59, 0x40, 0xa9, 0x91, 0xd9, 0xd2, 0x28, 0xc9,
+	0xaa, 0xbe, 0x28, 0x89, 0x17, 0x50, 0xb4, 0xf4, 0xcc, 0x6a, 0x96, 0x5e, 0x17, 0x89, 0xea, 0x53,
+	0x44, 0xa2, 0x43, 0xca, 0x98, 0xfa, 0x96, 0x0a, 0xe4, 0x83, 0xc7, 0x8b, 0x14, 0x74, 0xdd, 0x21,
+	0xd5, 0xc6, 0xee, 0xe2, 0x69, 0x84, 0x5c, 0x1a, 0xdf, 0x57, 0x92, 0x9e, 0x1c, 0x64, 0xc7, 0x38,
+	0xc3, 0x56, 0xc8, 0x02, 0x5f, 0x73, 0x31, 0xc3, 0x5a, 0xce, 0x3c, 0xfe, 0xe2, 0xb5, 0x34, 0x1a,
+	0xb2, 0xf4, 0x68, 0x81, 0x72, 0x10, 0x6f, 0x5c, 0x4a, 0x5b, 0xa0, 0x6b, 0x0a, 0x01, 0x09, 0x8d,
+	0xb1, 0x4f, 0x6a, 0x7d, 0x6e, 0x29, 0x84, 0xd2, 0x45, 0xdc, 0xca, 0xa9, 0x20, 0x92, 0x2f, 0x16,
+	0x16, 0x88, 0xd0, 0x14, 0xe2, 0xef, 0x10, 0x54, 0x67, 0x8d, 0xff, 0x2e, 0x92, 0x2b, 0x53, 0xe9,
+	0x8f, 0x31, 0x4f, 0x3b, 0x72, 0xad, 0x84, 0xbf, 0xb3, 0x9a, 0x63, 0x07, 0xb9, 0x43, 0x2a, 0x47,
+	0x59, 0x4f, 0xaf, 0xa0, 0xae, 0x38, 0x4b, 0x67, 0xa0, 0x38, 0xfb, 0x52, 0x71, 0x0a, 0x75, 0x94,
+	0xe3, 0x93, 0x12, 0xd3, 0x2f, 0x99, 0x3a, 0x4d, 0x05, 0x7f, 0x84, 0xcc, 0xe9, 0xd1, 0x82, 0xa7,
+	0x9b, 0x87, 0x8d, 0x6f, 0x57, 0xc9, 0xe2, 0xad, 0xf6, 0xf6, 0xf6, 0x78, 0xc7, 0x1a, 0xef, 0x68,
+	0x8e, 0x0e, 0x0e, 0x89, 0x3a, 0xd1, 0x9d, 0xd5, 0xac, 0xa3, 0xb3, 0xad, 0x10, 0x90, 0xd0, 0xa0,
+	0xf3, 0xc7, 0xb3, 0x91, 0x31, 0x32, 0x9b, 0xa5, 0xe8, 0xa6, 0xb0, 0x90, 0xa1, 0xc6, 0x54, 0x08,
+	0x87, 0x98, 0xa5, 0x74, 0x2a, 0x84, 0x37, 0x03, 0x81, 0x33, 0x06, 0x64, 0xd1, 0x61, 0xb4, 0x47,
+	0xfd, 0xc8, 0xb5, 0x3d, 0x71, 0xdc, 0x9f, 0x2c, 0x53, 0x71, 0x19, 0x5d, 0xd9, 0x76, 0x86, 0x05,
+	0x4c, 0x30, 0x3d, 0xaf, 0x34, 0xf4, 0xb7, 0x0a, 0x84, 0xd8, 0x49, 0xc6, 0x40, 0xf8, 0x42, 0x5f,
+	0xc8, 0x11, 0x19, 0xc9, 0x2c, 0x6b, 0x33, 0x9b, 0x2d, 0x88, 0x0f, 0x94, 0x04, 0x01, 0xda, 0x08,
+	0x50, 0xf7, 0x07, 0xac, 0x47, 0x99, 0x2c, 0xd4, 0xa9, 0xa5, 0x75, 0xff, 0x56, 0x82, 0x02, 0x9d,
+	0x2e, 0x73, 0x0e, 0xd6, 0xcf, 0x3c, 0x7c, 0xff, 0x19, 0xb2, 0x90, 0x27, 0x6e, 0xff, 0x83, 0x22,
+	0x99, 0x8c, 0x77, 0xa2, 0x84, 0x07, 0x8f, 0x7c, 0xca, 0x80, 0xf6, 0x29, 0xa3, 0xbe, 0x8c, 0xde,
+	0xd7, 0x13, 0x09, 0xdf, 0x4a, 0x61, 0x21, 0x43, 0x6d, 0x7c, 0x91, 0xbc, 0x14, 0x45, 0x9e, 0x0c,
+	0x76, 0xac, 0xf4, 0x23, 0x8c, 0x6b, 0x0e, 0x47, 0x1e, 0x8d, 0xa3, 0xf2, 0x95, 0xd6, 0xcb, 0x87,
+	0x8f, 0x97, 0x5f, 0xea, 0x76, 0x3b, 0xd3, 0x89, 0xe0, 0xe8, 0xf6, 0xc6, 0x06, 0xb9, 0xe4, 0xc4,
+	0xbf, 0xda, 0x81, 0xdf, 0x73, 0xa3, 0xe4, 0xb0, 0x7e, 0x9f, 0x1c, 0xe1, 0xa5, 0xf6, 0x24, 0x09,
+	0x4c, 0x6b, 0x27, 0x72, 0x86, 0x91, 0xed, 0x8a, 0x72, 0x8d, 0x8a, 0x9e, 0x33, 0x44, 0x28, 0x48,
+	0x6c, 0xe3, 0xcf, 0xcb, 0x64, 0x56, 0x0b, 0xbf, 0x3d, 0xad, 0x60, 0x0c, 0x23, 0x44, 0x5e, 0xe0,
+	0xd3, 0x55, 0x97, 0xf1, 0xfd, 0x78, 0x90, 0x55, 0x12, 0xed, 0x14, 0x16, 0x32, 0xd4, 0x86, 0x43,
+	0x2a, 0xb8, 0x55, 0x43, 0xa9, 0xb1, 0x5b, 0xb9, 0x62, 0x86, 0xa8, 0x06, 0x42, 0xe1, 0xdf, 0xf2,
+	0x3f, 0x41, 0xf0, 0xc6, 0x8a, 0xad, 0x30, 0xdc, 0xbd, 0x47, 0x0f, 0x78, 0x20, 0xa6, 0x9c, 0xae,
+	0x4d, 0xb3, 0xac, 0xdb, 0x12, 0x03, 0x1a, 0x15, 0x56, 0x6b, 0xf4, 0x55, 0xe8, 0xa6, 0x92, 0xae,
+	0xd6, 0x88, 0xc3, 0x36, 0x31, 0x05, 0xce, 0xee, 0x0e, 0xb3, 0x7d, 0x67, 0x57, 0x1a, 0x2d, 0xf1,
+	0xec, 0xb6, 0x38, 0x14, 0x24, 0x16, 0x67, 0x33, 0xb2, 0x07, 0x66, 0x2d, 0x3d, 0x9b, 0x5d, 0x7b,
+	0x00, 0x08, 0x47, 0x34, 0xa3, 0x7d, 0xb3, 0x9e, 0x46, 0x03, 0xed, 0x03, 0xc2, 0x8d, 0x21, 0xae,
+	0xe1, 0x30, 0x88, 0xa8, 0x39, 0x93, 0x37, 0x84, 0x86, 0x01, 0x55, 0xce, 0x4a, 0xfa, 0x7b, 0x44,
+	0x88, 0x02, 0x42, 0x40, 0x76, 0xd2, 0xf8, 0x9b, 0x02, 0xa9, 0xab, 0x59, 0xfd, 0x35, 0x88, 0x82,
+	0xde, 0x27, 0x0b, 0x99, 0xaf, 0x3a, 0x86, 0x5d, 0xf2, 0x7e, 0x52, 0x1e, 0x33, 0x4f, 0xc5, 0x1e,
+	0xb8, 0x45, 0xf1, 0x00, 0x3a, 0x16, 0x70, 0x68, 0xe3, 0x6b, 0x55, 0x32, 0x7b, 0xbb, 0xdb, 0x3d,
+	0x6e, 0xf5, 0xa4, 0x76, 0xc6, 0x14, 0xcf, 0xf0, 0x8c, 0x91, 0xde, 0x7f, 0xe9, 0x79, 0x79, 0xff,
+	0xaf, 0x90, 0xea, 0x90, 0x46, 0xbb, 0x41, 0x2f, 0x5b, 0xe9, 0xb5, 0xc1, 0xa1, 0x20, 0xb1, 0xe7,
+	0xed, 0x2b, 0xa1, 0xeb, 0x80, 0x76, 0x60, 0x30, 0x16, 0xae, 0x43, 0x29, 0x99, 0xb2, 0xae, 0x00,
+	0x83, 0xc2, 0x1b, 0x23, 0x32, 0xb3, 0xa3, 0x02, 0xfc, 0x66, 0x2d, 0xef, 0xc4, 0xc5, 0xb9, 0x02,
+	0x91, 0x1a, 0x89, 0x7f, 0x42, 0xd2, 0x89, 0x5e, 0xeb, 0x5a, 0xcf, 0x5b, 0xeb, 0xaa, 0x89, 0xe4,
+	0x31, 0x6b, 0x5d, 0x73, 0x15, 0x38, 0x7e, 0xbf, 0x42, 0xc8, 0xdd, 0x20, 0x36, 0x24, 0x37, 0x48,
+	0x3d, 0x92, 0x91, 0x77, 0xa9, 0x09, 0x3e, 0x30, 0x6d, 0xe3, 0x6e, 0x07, 0x3d, 0x15, 0xa0, 0x47,
+	0x8f, 0x52, 0x6c, 0x5b, 0x05, 0x81, 0x98, 0x05, 0xda, 0xa5, 0xb8, 0x13, 0xc3, 0x91, 0x1d, 0xfb,
+	0xad, 0xb1, 0x5d, 0xba, 0xa9, 0x10, 0x90, 0xd0, 0x60, 0xd8, 0x70, 0xc7, 0x76, 0xf6, 0x82, 0x7e,
+	0xbf, 0xe3, 0x0e, 0x5d, 0x15, 0x55, 0xe3, 0x61, 0xc3, 0x96, 0x06, 0x87, 0x14, 0x95, 0xb1, 0x45,
+	0xae, 0xd8, 0x4e, 0xe4, 0xee, 0x53, 0xcc, 0x13, 0x60, 0x2e, 0x4f, 0x65, 0x34, 0xca, 0x5c, 0x54,
+	0x5e, 0x3a, 0xc4, 0x64, 0xf9, 0x34, 0x02, 0x98, 0xde, 0xce, 0x78, 0x40, 0x5e, 0xcc, 0x1c, 0xde,
+	0xeb, 0xae, 0x8f, 0xc5, 0xba, 0x3d, 0x7e, 0x5e, 0x54, 0x5a, 0xef, 0x3b, 0x7c, 0xbc, 0xfc, 0x62,
+	0xe6, 0xe8, 0x57, 0x24, 0x70, 0x54, 0x5b, 0x54, 0x59, 0x8f, 0x6c, 0x57, 0xd5, 0x15, 0xc7, 0x2a,
+	0xeb, 0xa1, 0xed, 0x46, 0xc0, 0x31, 0xba, 0x98, 0xd7, 0x9e, 0x22, 0xe6, 0x37, 0xc9, 0x9c, 0xed,
+	0x79, 0xc1, 0x23, 0x99, 0xea, 0xe1, 0x07, 0x4b, 0x3d, 0xc9, 0x41, 0xac, 0x68, 0x38, 0x48, 0x51,
+	0xe2, 0xf1, 0xe7, 0x05, 0x83, 0x8e, 0xeb, 0xd3, 0x90, 0x1f, 0x36, 0xa5, 0xe4, 0xf8, 0xeb, 0x48,
+	0x38, 0xc4, 0x14, 0xe7, 0x5d, 0xbe, 0xdb, 0xf8, 0x87, 0x32, 0xb9, 0x78, 0xef, 0xa6, 0xa5, 0x52,
+	0x79, 0xdb, 0x81, 0xe7, 0x3a, 0x07, 0xc6, 0x57, 0x49, 0xd5, 0xb3, 0x77, 0xa8, 0xa7, 0x82, 0xc2,
+	0x0f, 0x9f, 0x7d, 0x44, 0x13, 0xcc, 0x9b, 0x1d, 0xce, 0x59, 0x6c, 0xbb, 0x58, 0x21, 0x0a, 0x20,
+	0xc8, 0x6e, 0x0d, 0x87, 0xd4, 0xa4, 0x0c, 0xca, 0x13, 0xee, 0xe6, 0x89, 0x73, 0x95, 0x52, 0xa2,
+	0x93, 0x35, 0x96, 0x00, 0x50, 0x9c, 0x0d, 0x8b, 0x5c, 0xa1, 0x8c, 0x05, 0x6c, 0xcb, 0x97, 0x28,
+	0x29, 0x05, 0x7c, 0x5f, 0xd4, 0x5b, 0x2f, 0xcb, 0x86, 0x57, 0xd6, 0xa6, 0x11, 0xc1, 0xf4, 0xb6,
+	0x98, 0x3e, 0x0c, 0xc7, 0xbc, 0xe6, 0x2b, 0xb1, 0x3c, 0xcb, 0xa9, 0x64, 0xc3, 0xa2, 0x95, 0xc1,
+	0xc3, 0x44, 0x0b, 0x2d, 0x09, 0x99, 0x70, 0xa9, 0xa4, 0xb9, 0xac, 0x67, 0xf0, 0x30, 0xd1, 0xe2,
+	0x04, 0x6a, 0x7d, 0xe9, 0x93, 0x64, 0x56, 0x5b, 0x97, 0x13, 0x29, 0xb9, 0x7f, 0xaa, 0x90, 0xb9,
+	0x7b, 0x76, 0x7f, 0xcf, 0x3e, 0xe6, 0x59, 0x1f, 0x7b, 0xb7, 0xc5, 0xf7, 0xf0, 0x6e, 0xd1, 0xe7,
+	0xb6, 0x59, 0x94, 0x58, 0xee, 0x15, 0xcd, 0xe7, 0x56, 0x08, 0x48, 0x68, 0x32, 0x1b, 0xa9, 0x7c,
+	0xe6, 0x47, 0xe8, 0x4d, 0x32, 0xc7, 0xe8, 0xdb, 0x63, 0x97, 0xd1, 0xde, 0x8a, 0xb3, 0x17, 0x4a,
+	0x4d, 0x16, 0x2b, 0x0c, 0xd0, 0x70, 0x90, 0xa2, 0x44, 0x85, 0x81, 0x6e, 0x07, 0xa3, 0x61, 0x28,
+	0x75, 0x57, 0xac, 0x30, 0xda, 0x12, 0x0e, 0x31, 0x05, 0xba, 0x0d, 0x7d, 0x6f, 0x1c, 0xee, 0xae,
+	0x23, 0x8f, 0xb8, 0xb2, 0xa4, 0x92, 0xb8, 0x0d, 0xeb, 0x29, 0x2c, 0x64, 0xa8, 0xff, 0xaf, 0x5e,
+	0x83, 0x58, 0x21, 0x0b, 0xb1, 0x2c, 0x48, 0x07, 0x9d, 0xa4, 0xa3, 0x89, 0xdb, 0x69, 0x34, 0x64,
+	0xe9, 0x1b, 0x7f, 0x5d, 0x24, 0xa4, 0x13, 0x0c, 0x94, 0x14, 0xbf, 0x42, 0xaa, 0xfd, 0x80, 0x0d,
+	0xed, 0x48, 0x0a, 0x72, 0xac, 0xaa, 0xd6, 0x39, 0x14, 0x24, 0xf6, 0xbc, 0x4c, 0xd7, 0x73, 0xae,
+	0x3e, 0x6b, 0xfc, 0x55, 0x99, 0xcc, 0x6e, 0xdc, 0xef, 0x76, 0x4f, 0x73, 0xd3, 0xe3, 0x4e, 0xf0,
+	0x5c, 0xca, 0xeb, 0x7a, 0x4b, 0x69, 0xcf, 0xb1, 0x2d, 0xe0, 0xab, 0x10, 0x53, 0x60, 0x8f, 0x6f,
+	0x07, 0xa1, 0x74, 0xca, 0xe3, 0x1e, 0xef, 0x07, 0x16, 0x20, 0x1c, 0x99, 0x09, 0xc7, 0x5c, 0x9a,
+	0x15, 0xda, 0xb6, 0x02, 0x09, 0x87, 0x98, 0x42, 0x6d, 0x8b, 0xea, 0x19, 0x6c, 0x8b, 0xda, 0xb9,
+	0x49, 0xc9, 0x99, 0x07, 0x9f, 0x1a, 0x5f, 0x2f, 0x91, 0xfa, 0x06, 0x8d, 0x6c, 0x0c, 0xc7, 0x1a,
+	0x5f, 0x2f, 0x90, 0x59, 0xdb, 0xf7, 0x83, 0x88, 0x97, 0xa2, 0x29, 0xdb, 0xc2, 0x7a, 0xf6, 0xe1,
+	0x28, 0xce, 0xcd, 0x95, 0x84, 0xab, 0xb0, 0x2b, 0xe2, 0xb0, 0x9c, 0x86, 0x01, 0xbd, 0x73, 0x63,
+	0x3f, 0x36, 0x71, 0xc4, 0xae, 0xdd, 0x3c, 0x85, 0x61, 0x1c, 0xc3, 0xb2, 0x59, 0xfa, 0x2c, 0x59,
+	0xcc, 0x8e, 0xf6, 0x24, 0xa7, 0x6d, 0x9e, 0x83, 0xfa, 0x87, 0x25, 0x32, 0xbb, 0xb9, 0xd2, 0xb5,
+	0x8e, 0xb9, 0x65, 0xb5, 0x7c, 0x52, 0xf1, 0xf8, 0x29, 0xc6, 0xd2, 0xb9, 0x49, 0xf7, 0xd9, 0x9f,
+	0xf9, 0xcf, 0xb9, 0x78, 0xa0, 0xf1, 0xe3, 0x12, 0xb9, 0xbc, 0xc5, 0x67, 0xd8, 0x8a, 0x02, 0x66,
+	0x0f, 0xa8, 0x5a, 0xb9, 0xe7, 0x52, 0xc5, 0xa9, 0x72, 0xf1, 0xc5, 0x23, 0x73, 0xf1, 0x99, 0x6b,
+	0x9f, 0xa5, 0x63, 0x5e, 0xfb, 0xfc, 0x0d, 0x4d, 0x40, 0x7f, 0xbb, 0x4c, 0x16, 0xb7, 0x46, 0xd4,
+	0x7f, 0xb8, 0xeb, 0x86, 0x7b, 0x5a, 0x05, 0x00, 0x2f, 0x79, 0x29, 0x1c, 0x59, 0xf2, 0xf2, 0x1a,
+	0xa9, 0xa9, 0xf4, 0x72, 0x66, 0xdb, 0xa9, 0xd4, 0xb2, 0xc2, 0xa7, 0x03, 0x00, 0xa5, 0x63, 0x04,
+	0x00, 0xf0, 0x5a, 0xcb, 0x38, 0xda, 0xed, 0x06, 0x7b, 0xd4, 0x7f, 0x96, 0x6b, 0x2d, 0xaa, 0x2d,
+	0x24, 0x6c, 0x30, 0x44, 0x6c, 0x27, 0xb7, 0x35, 0x2b, 0xe9, 0x10, 0xf1, 0x4a, 0x8c, 0x01, 0x8d,
+	0xea, 0xbc, 0xee, 0xa9, 0xa5, 0x25, 0xa2, 0x76, 0xe6, 0x12, 0xf1, 0xbd, 0x1a, 0x99, 0xdf, 0x1e,
+	0x7b, 0xa1, 0xcd, 0x4e, 0xd3, 0x6a, 0xb2, 0xc8, 0x95, 0xc8, 0x0b, 0xbb, 0x6c, 0x1c, 0x46, 0x58,
+	0x1a, 0x19, 0x66, 0xea, 0x26, 0x63, 0x37, 0xb6, 0xdb, 0xb1, 0x26, 0x89, 0x60, 0x7a, 0x5b, 0x63,
+	0x87, 0x2c, 0x45, 0x5e, 0xc8, 0xc3, 0x1c, 0x77, 0x7c, 0x51, 0x97, 0xd9, 0x0e, 0x7c, 0x5f, 0x16,
+	0xd6, 0x97, 0xb9, 0x3d, 0xd5, 0x90, 0x9c, 0x97, 0xba, 0x1d, 0xeb, 0x08, 0x4a, 0x78, 0x0f, 0x2e,
+	0x98, 0xa7, 0x89, 0xbc, 0xf0, 0x4d, 0xdb, 0x73, 0x7b, 0x76, 0x44, 0x71, 0x0b, 0xf8, 0x4a, 0x84,
+	0xea, 0x49, 0x9e, 0xa6, 0xdb, 0xb1, 0xb2, 0x24, 0x30, 0xad, 0xdd, 0x73, 0x37, 0xe1, 0x5e, 0x16,
+	0x47, 0x6d, 0x26, 0x03, 0x81, 0xae, 0x04, 0xc2, 0x8d, 0x6f, 0x14, 0x08, 0x19, 0xb1, 0x60, 0x44,
+	0x59, 0xe4, 0xc6, 0x37, 0x9c, 0x72, 0x04, 0x4e, 0x52, 0x82, 0xd2, 0xdc, 0x8e, 0x39, 0x67, 0x92,
+	0x95, 0x09, 0x02, 0xb4, 0xee, 0x7f, 0x53, 0x6f, 0xa3, 0x7f, 0x86, 0x2c, 0x64, 0x66, 0xea, 0xa4,
+	0xf1, 0xda, 0x39, 0xa0, 0x3d, 0x37, 0x2e, 0xd8, 0xfa, 0x38, 0x99, 0x45, 0xa5, 0xbc, 0xd2, 0xeb,
+	0x71, 0xf7, 0xbc, 0x90, 0x3e, 0xf1, 0x6e, 0x27, 0x28, 0xd0, 0xe9, 0x4e, 0x3d, 0x43, 0x83, 0xb5,
+	0x4b, 0xbd, 0x1d, 0x19, 0x07, 0x89, 0x6b, 0x97, 0x56, 0x5b, 0x50, 0xec, 0xed, 0x28, 0xb9, 0x2f,
+	0x3f, 0x2f, 0xb9, 0x7f, 0x8d, 0xd4, 0x9c, 0x5d, 0xdb, 0xf7, 0xa9, 0x97, 0xbd, 0x7f, 0xdc, 0x16,
+	0x60, 0x50, 0x78, 0x5e, 0x46, 0x15, 0x31, 0x6a, 0x0f, 0xb3, 0xc9, 0x3c, 0x8b, 0x43, 0x41, 0x62,
+	0xd1, 0x5b, 0x1f, 0xda, 0xef, 0x08, 0x60, 0x87, 0xfa, 0x03, 0x99, 0x4a, 0x28, 0x25, 0xde, 0xfa,
+	0x46, 0x1a, 0x0d, 0x59, 0x7a, 0x5d, 0xc0, 0xeb, 0xe7, 0x26, 0xe0, 0x33, 0x67, 0x7e, 0x84, 0xfc,
+	0x63, 0x91, 0x54, 0x2d, 0xce, 0xc4, 0xf8, 0x0a, 0xa9, 0x0f, 0xa5, 0xe7, 0x21, 0x4d, 0xc1, 0x8f,
+	0x1c, 0xaf, 0x90, 0x4b, 0x98, 0x94, 0xe8, 0xb5, 0x24, 0xdd, 0x25, 0x30, 0x88, 0xb9, 0x62, 0x9d,
+	0x0e, 0x2f, 0x81, 0xcf, 0x5d, 0x7a, 0x24, 0x46, 0x2c, 0x92, 0x19, 0x53, 0xaa, 0xde, 0xf1, 0x0e,
+	0x60, 0x64, 0x47, 0xe3, 0x30, 0x7f, 0xf5, 0x91, 0xec, 0x89, 0x73, 0xd3, 0xc5, 0x0f, 0x7f, 0x83,
+	0xec, 0xa5, 0xf1, 0x2f, 0x05, 0x42, 0x04, 0x61, 0xc7, 0x0d, 0x23, 0xe3, 0xf7, 0x26, 0x26, 0xb2,
+	0x79, 0xbc, 0x89, 0xc4, 0xd6, 0x7c, 0x1a, 0x93, 0x08, 0xbf, 0x1b, 0x66, 0x27, 0x91, 0x92, 0x8a,
+	0x1b, 0xd1, 0xa1, 0xf2, 0x33, 0x3f, 0x9f, 0xf7, 0xdb, 0x12, 0x2b, 0xe0, 0x0e, 0xb2, 0x05, 0xc1,
+	0xbd, 0xf1, 0x6e, 0x4d, 0x7d, 0x13, 0x4e, 0xac, 0xf1, 0xb5, 0x42, 0xa6, 0x44, 0x5c, 0x38, 0xdb,
+	0x77, 0x4e, 0xad, 0xde, 0x2d, 0x09, 0x6d, 0x1e, 0x5d, 0x71, 0x6e, 0x04, 0xa4, 0x1e, 0x09, 0x09,
+	0x57, 0x9f, 0xbf, 0x92, 0x7b, 0xaf, 0x24, 0x93, 0x2d, 0x01, 0x21, 0xc4, 0x9d, 0x18, 0x23, 0x2d,
+	0xc3, 0x96, 0xbb, 0x2e, 0x22, 0xbe, 0x25, 0x93, 0xf4, 0x38, 0x99, 0x84, 0x43, 0xed, 0x28, 0x2e,
+	0x10, 0xc9, 0x30, 0x7f, 0xa2, 0x1d, 0x05, 0x18, 0x14, 0xde, 0xf8, 0x76, 0x81, 0x2c, 0xf6, 0xd2,
+	0xb5, 0xfe, 0xca, 0x2f, 0xc9, 0xb1, 0x2e, 0x99, 0xdb, 0x03, 0x49, 0x82, 0x20, 0x83, 0x08, 0x61,
+	0xa2, 0x73, 0xbc, 0x2f, 0x23, 0xb3, 0x18, 0x98, 0x4d, 0xa0, 0x3d, 0x08, 0xc6, 0x7e, 0x4f, 0x06,
+	0xa1, 0xe3, 0xfb, 0x32, 0x6b, 0x13, 0x14, 0x30, 0xa5, 0x15, 0x7f, 0x00, 0x49, 0x96, 0x3b, 0x73,
+	0x4f, 0xa0, 0x96, 0x79, 0x00, 0x49, 0xc3, 0x41, 0x8a, 0x12, 0x0d, 0xd8, 0xa1, 0xfd, 0x4e, 0x7c,
+	0x8f, 0x31, 0x52, 0xeb, 0xca, 0x83, 0xd4, 0x95, 0xc4, 0x80, 0xdd, 0x98, 0x46, 0x04, 0xd3, 0xdb,
+	0x62, 0x06, 0x05, 0xd5, 0xa6, 0xe7, 0x51, 0x2f, 0xe6, 0x37, 0xc3, 0x3f, 0x2c, 0x9e, 0xa0, 0xed,
+	0x0c, 0x1e, 0x26, 0x5a, 0xe0, 0x81, 0xd6, 0x63, 0x07, 0x30, 0xf6, 0x4d, 0x92, 0xbe, 0xb2, 0xb4,
+	0xca, 0xa1, 0x20, 0xb1, 0x22, 0x56, 0x12, 0xe2, 0xec, 0xf2, 0x92, 0xf7, 0xba, 0x1e, 0x2b, 0xe1,
+	0x60, 0x50, 0x78, 0x7c, 0xd0, 0x48, 0xfe, 0xd9, 0x1a, 0xf7, 0xfb, 0x94, 0x59, 0xee, 0x1f, 0x50,
+	0x5e, 0xff, 0x5e, 0x49, 0xee, 0xab, 0x5b, 0x59, 0x02, 0x98, 0x6c, 0xd3, 0xf8, 0x5e, 0x89, 0xcc,
+	0xe9, 0xea, 0xce, 0xf8, 0x72, 0xac, 0x46, 0x85, 0x16, 0xfb, 0xc4, 0xc9, 0x23, 0x03, 0xef, 0xa9,
+	0x37, 0x8d, 0xef, 0x15, 0xc8, 0x82, 0xdc, 0x6a, 0x02, 0x43, 0xd5, 0xb6, 0xfe, 0xe2, 0xe9, 0x68,
+	0x6c, 0xb5, 0xc7, 0x15, 0x77, 0x61, 0xeb, 0xc6, 0x46, 0x41, 0x06, 0x0b, 0xd9, 0xc1, 0x2c, 0x7d,
+	0xa3, 0x40, 0x2e, 0x4f, 0x63, 0x31, 0xc5, 0x08, 0xfc, 0x7d, 0xdd, 0x08, 0x9c, 0x7d, 0xfd, 0x56,
+	0x6e, 0xbd, 0x24, 0xe7, 0x4a, 0xb3, 0x26, 0x7f, 0x5c, 0x24, 0x73, 0x96, 0x67, 0x3b, 0x7b, 0xbf,
+	0x2a, 0xf7, 0x6d, 0x1e, 0x10, 0x12, 0xf2, 0xf1, 0x70, 0xff, 0xff, 0x44, 0x86, 0xe9, 0x05, 0x64,
+	0x6b, 0xc5, 0x8d, 0x41, 0x63, 0xa4, 0x1b, 0x88, 0xa5, 0xa7, 0x18, 0x88, 0xaf, 0x91, 0x9a, 0x7c,
+	0xe5, 0x20, 0xab, 0x2d, 0xe5, 0x13, 0x03, 0xa0, 0xf0, 0x8d, 0x7f, 0xae, 0x13, 0xc3, 0x8a, 0x6c,
+	0xbf, 0x67, 0xb3, 0xde, 0xbd, 0x9b, 0x71, 0xd0, 0xf2, 0xc8, 0x5b, 0xef, 0x85, 0x73, 0xb9, 0xf5,
+	0xee, 0xa7, 0x2e, 0x0e, 0x3c, 0xff, 0xe7, 0x0b, 0x36, 0xf5, 0xe7, 0x0b, 0xc4, 0x6c, 0x7f, 0x64,
+	0xda, 0xf3, 0x05, 0xef, 0xbb, 0x37, 0xde, 0xa1, 0xcc, 0xa7, 0x58, 0xc4, 0x2a, 0xc7, 0x7a, 0x8c,
+	0x47, 0x0c, 0xce, 0x3e, 0x84, 0xda, 0x27, 0xf3, 0x23, 0x3b, 0x72, 0x76, 0xad, 0x88, 0xd9, 0x11,
+	0x1d, 0x1c, 0x48, 0x17, 0xe3, 0xf3, 0xb2, 0xd9, 0xfc, 0xb6, 0x8e, 0x7c, 0xf2, 0x78, 0xf9, 0x77,
+	0x8e, 0x7a, 0xcd, 0x0f, 0xaf, 0x40, 0x84, 0x4d, 0x4e, 0xce, 0x23, 0x90, 0x69, 0xb6, 0x18, 0xa5,
+	0xf2, 0xdc, 0x7d, 0xba, 0x95, 0xdc, 0x8f, 0xd0, 0x9e, 0x9e, 0xeb, 0xc4, 0x18, 0xd0, 0xa8, 0x8c,
+	0x4f, 0x93, 0x79, 0x1e, 0xb4, 0x57, 0x9b, 0x40, 0x1e, 0x69, 0x57, 0xd4, 0xd8, 0x3a, 0x3a, 0x12,
+	0xd2, 0xb4, 0xfc, 0x19, 0x33, 0x97, 0x7a, 0xbd, 0x0d, 0xdb, 0xb7, 0x07, 0x94, 0x99, 0xf5, 0xf4,
+	0x71, 0xb8, 0xae, 0xe1, 0x20, 0x45, 0xc9, 0x33, 0xbc, 0x01, 0x73, 0x78, 0x25, 0x9e, 0xe7, 0x3a,
+	0x91, 0x3a, 0xb7, 0x92, 0x0c, 0x6f, 0x0a, 0x0b, 0x19, 0xea, 0x23, 0xde, 0x42, 0x20, 0xbf, 0x42,
+	0x6f, 0x21, 0xcc, 0x9e, 0xf9, 0x5b, 0x08, 0x37, 0xc8, 0x9c, 0xd0, 0xcf, 0xb2, 0xc0, 0x65, 0x99,
+	0x54, 0x78, 0xd1, 0x0e, 0x57, 0xc2, 0x15, 0x51, 0xf8, 0xca, 0xc3, 0x54, 0x20, 0xe0, 0x8d, 0xbf,
+	0x2b, 0x90, 0x99, 0xd8, 0x21, 0x46, 0xe9, 0x71, 0x6c, 0x0c, 0x90, 0x6d, 0x27, 0xd7, 0x07, 0x62,
+	0xe9, 0x69, 0xaf, 0x28, 0x0c, 0x68, 0x54, 0xa2, 0xbe, 0x17, 0x53, 0x95, 0x71, 0xbb, 0x89, 0xfa,
+	0x5e, 0x1d, 0x0b, 0x19, 0x6a, 0x94, 0x3e, 0x01, 0x51, 0xd5, 0xb7, 0xa5, 0xb4, 0xf4, 0xb5, 0x75,
+	0x24, 0xa4, 0x69, 0x1b, 0xff, 0x51, 0x21, 0xb1, 0xb1, 0x8a, 0x46, 0x71, 0xc6, 0xbf, 0x69, 0xe5,
+	0x4f, 0x76, 0x25, 0x46, 0xb1, 0x82, 0x68, 0x3e, 0x8f, 0xbc, 0x87, 0xed, 0x3a, 0xea, 0xe9, 0x25,
+	0xed, 0x7a, 0x4f, 0xea, 0x1e, 0x76, 0x9a, 0x02, 0xa6, 0xb4, 0x32, 0xee, 0xf2, 0x07, 0x17, 0x78,
+	0x9a, 0x56, 0x5d, 0x80, 0x7f, 0xf9, 0x88, 0x07, 0x17, 0x04, 0x51, 0xfc, 0xca, 0x82, 0xf8, 0x09,
+	0x49, 0x73, 0x63, 0x8d, 0xd4, 0xf6, 0x03, 0x6f, 0x3c, 0xa4, 0x4a, 0xd5, 0x2d, 0x4d, 0xe3, 0xf4,
+	0x26, 0x27, 0xd1, 0xe2, 0xee, 0xa2, 0x09, 0xa8, 0xb6, 0x06, 0x25, 0x0b, 0xea, 0x02, 0xa9, 0xbc,
+	0xdb, 0x22, 0x73, 0x40, 0xaf, 0x1c, 0x51, 0xce, 0x67, 0xa5, 0xa9, 0x5b, 0x97, 0xd0, 0x9a, 0xc9,
+	0x00, 0x21, 0xcb, 0x13, 0x6f, 0x40, 0xcc, 0xf9, 0x41, 0x8f, 0xc6, 0xfa, 0x47, 0xc4, 0xca, 0xbb,
+	0xf9, 0x3d, 0x9a, 0xe6, 0xa6, 0xc6, 0x56, 0x18, 0x59, 0xb1, 0x66, 0xd2, 0x51, 0x90, 0xea, 0xdf,
+	0x78, 0x40, 0x66, 0xa3, 0xc0, 0x93, 0x47, 0x87, 0x0a, 0xa0, 0x5f, 0x9b, 0xf6, 0xcd, 0xdd, 0x98,
+	0x2c, 0x89, 0x96, 0x25, 0xb0, 0x10, 0x74, 0x3e, 0x4b, 0x9f, 0x23, 0x17, 0x27, 0xc6, 0x73, 0xa2,
+	0xb0, 0x9d, 0x45, 0x48, 0x72, 0xb9, 0x09, 0x83, 0xe6, 0x61, 0x64, 0xb3, 0x28, 0xfb, 0x90, 0x98,
+	0x85, 0x40, 0x10, 0x38, 0xcc, 0xc3, 0x84, 0x51, 0x30, 0xca, 0x26, 0xbb, 0xac, 0x28, 0x18, 0x01,
+	0xc7, 0x34, 0x7e, 0x54, 0x21, 0x35, 0x65, 0x74, 0x84, 0x13, 0x85, 0x9b, 0xa7, 0xf8, 0x06, 0xc3,
+	0x51, 0xe5, 0x9d, 0xe9, 0xa3, 0xb9, 0x78, 0xe6, 0x47, 0xf3, 0x1e, 0xa9, 0x8e, 0xb8, 0xb6, 0x34,
+	0x4b, 0xa7, 0x64, 0x22, 0x0b, 0xe5, 0x2b, 0xec, 0x1a, 0xf1, 0x37, 0xc8, 0x2e, 0x8c, 0xb7, 0xc9,
+	0x3c, 0xa3, 0x11, 0x3b, 0x88, 0xed, 0x80, 0x72, 0xce, 0xb2, 0xbf, 0x8b, 0xa8, 0x23, 0x41, 0x67,
+	0x09, 0xe9, 0x1e, 0x8c, 0x3f, 0x2d, 0x90, 0x0b, 0x4e, 0xea, 0xed, 0x0f, 0xb9, 0x8b, 0x6f, 0xe7,
+	0x78, 0xeb, 0x21, 0xc5, 0xaf, 0x65, 0x70, 0x3d, 0x9f, 0x82, 0x41, 0xa6, 0x4f, 0x5e, 0xb6, 0xba,
+	0x4b, 0x7d, 0xb3, 0x9a, 0x96, 0xc4, 0x87, 0xbb, 0xd4, 0x07, 0x8e, 0xd1, 0x9c, 0xd0, 0xda, 0x7b,
+	0x39, 0xa1, 0x8d, 0x1f, 0x15, 0x89, 0x31, 0x79, 0x32, 0x1a, 0x9f, 0x8e, 0xd7, 0x51, 0x6c, 0x88,
+	0x0f, 0xa8, 0xe6, 0x62, 0x09, 0x9e, 0x3c, 0x5e, 0xbe, 0xa8, 0x91, 0x67, 0xd6, 0xe5, 0x88, 0xbb,
+	0x34, 0xc5, 0x67, 0xbc, 0x4b, 0xf3, 0x4d, 0x9c, 0xf3, 0x80, 0x31, 0xea, 0xf1, 0xbd, 0x9f, 0xbc,
+	0xbc, 0xb9, 0x7d, 0x7a, 0x82, 0x2d, 0x2c, 0x66, 0x39, 0xf7, 0xa9, 0xbe, 0x20, 0xd3, 0x77, 0xe3,
+	0xdd, 0x02, 0x59, 0xcc, 0x36, 0x37, 0xf6, 0x48, 0x29, 0x64, 0x8e, 0x59, 0x78, 0x4e, 0xe3, 0xe2,
+	0xc1, 0x75, 0x8b, 0x39, 0x80, 0xbd, 0xe0, 0xea, 0xf7, 0xe8, 0xe4, 0x13, 0x08, 0xab, 0x14, 0xf3,
+	0xc1, 0x88, 0x31, 0x3a, 0x93, 0x16, 0x7f, 0x73, 0x9a, 0xc5, 0xff, 0x52, 0xb6, 0xbf, 0x69, 0xf6,
+	0x7e, 0xe3, 0xdf, 0x8a, 0xe4, 0xea, 0xf4, 0x81, 0xa1, 0xc1, 0x92, 0x04, 0x88, 0xb4, 0xa7, 0x77,
+	0x63, 0x83, 0x65, 0x35, 0x85, 0x85, 0x0c, 0x35, 0x37, 0x92, 0xc4, 0xc9, 0xa5, 0xde, 0xdf, 0xd5,
+	0x8d, 0xa4, 0x18, 0x03, 0x1a, 0x15, 0x26, 0x02, 0xe4, 0xaf, 0xae, 0x1e, 0xb6, 0xd3, 0xca, 0xf6,
+	0xda, 0x69, 0x34, 0x64, 0xe9, 0xd1, 0xa5, 0x44, 0x9b, 0x43, 0x3d, 0xd4, 0xa8, 0xb9, 0x94, 0xab,
+	0x02, 0x0c, 0x0a, 0x8f, 0x36, 0x39, 0xfe, 0x19, 0x77, 0x55, 0x49, 0xdb, 0xe4, 0xab, 0x1a, 0x0e,
+	0x52, 0x94, 0xc9, 0x43, 0x20, 0x62, 0x97, 0x4e, 0x3c, 0x04, 0xd2, 0xf8, 0x65, 0x81, 0xcc, 0xa7,
+	0xb4, 0x9c, 0xd1, 0x27, 0xa5, 0xbd, 0x9b, 0x2a, 0x14, 0x73, 0xef, 0x14, 0x0b, 0xa8, 0x85, 0x04,
+	0xdd, 0xbb, 0x19, 0x02, 0x76, 0x60, 0xbc, 0x15, 0x47, 0x7d, 0x8a, 0xb9, 0x83, 0xe7, 0x9a, 0x89,
+	0x2c, 0xbd, 0xcf, 0x74, 0xe0, 0xfc, 0x2f, 0x4b, 0xf1, 0x57, 0x0a, 0x0c, 0x1e, 0xb6, 0x7d, 0xac,
+	0x64, 0xe5, 0xdf, 0x59, 0x4a, 0x0e, 0xdb, 0x75, 0x04, 0x82, 0xc0, 0xf1, 0x97, 0x82, 0xb0, 0xc2,
+	0x99, 0xf6, 0x68, 0x4f, 0xbe, 0x83, 0x94, 0xbc, 0x14, 0xa4, 0x10, 0x90, 0xd0, 0xf0, 0xda, 0x4b,
+	0x1e, 0x5a, 0xe4, 0xd2, 0x50, 0xd2, 0x6a, 0x2f, 0x39, 0x14, 0x24, 0xd6, 0x08, 0xc9, 0x45, 0xcf,
+	0x0e, 0xa3, 0xb5, 0x77, 0xa8, 0x33, 0x46, 0xf1, 0x46, 0x2b, 0xc0, 0x2c, 0x9f, 0xf8, 0x52, 0x7b,
+	0x1c, 0x77, 0xeb, 0x64, 0x99, 0xc1, 0x24, 0x7f, 0xfc, 0x1a, 0x0e, 0x64, 0x2c, 0x60, 0x52, 0x84,
+	0xe2, 0xaf, 0xe9, 0x28, 0x04, 0x24, 0x34, 0x78, 0xed, 0x82, 0xff, 0xd8, 0xe7, 0x15, 0x8c, 0xe2,
+	0x2a, 0xab, 0x7c, 0xad, 0xa5, 0xa3, 0xc1, 0x21, 0x45, 0xc5, 0x1f, 0x3d, 0x76, 0x87, 0xb4, 0xb7,
+	0x15, 0xdf, 0x56, 0x48, 0xc2, 0xd0, 0x12, 0x0e, 0x31, 0x45, 0x63, 0x2d, 0x59, 0x98, 0x47, 0x6e,
+	0xe4, 0xec, 0x1a, 0x2f, 0x91, 0x92, 0xed, 0x1f, 0x70, 0xff, 0x66, 0x46, 0x48, 0xcc, 0x8a, 0x7f,
+	0x00, 0x08, 0xe3, 0x28, 0xcf, 0x33, 0x8b, 0x1a, 0xca, 0xf3, 0x00, 0x61, 0x8d, 0x1f, 0x5e, 0x22,
+	0x0b, 0x19, 0xfb, 0xe4, 0x18, 0x57, 0xc1, 0xf6, 0x48, 0x35, 0xe4, 0xbd, 0x9e, 0x5e, 0x30, 0x8d,
+	0xb3, 0x93, 0x32, 0xc8, 0xff, 0x06, 0xd9, 0x85, 0x31, 0x10, 0xfb, 0xaa, 0x94, 0xd7, 0x87, 0x9c,
+	0x8c, 0x2d, 0x65, 0x36, 0x16, 0xa6, 0x50, 0x6c, 0xed, 0x11, 0x48, 0x29, 0x58, 0x1b, 0x79, 0x22,
+	0x3c, 0x13, 0xef, 0x5f, 0x0a, 0x31, 0xd0, 0x11, 0x90, 0xea, 0xd4, 0x70, 0xf0, 0xfd, 0x93, 0x48,
+	0x3d, 0x35, 0xb7, 0x76, 0x2a, 0x57, 0x9f, 0xc4, 0x6d, 0x3d, 0x04, 0x00, 0x67, 0x6e, 0x3c, 0x22,
+	0x33, 0xf6, 0xa3, 0x50, 0xbc, 0xc3, 0x2e, 0x0b, 0x28, 0xee, 0xe6, 0x7a, 0x9b, 0x35, 0xf5, 0xa4,
+	0xbb, 0x2c, 0x1e, 0x52, 0x50, 0x48, 0xfa, 0x32, 0x18, 0xa9, 0x3a, 0xfc, 0x79, 0x2c, 0xb3, 0x96,
+	0x57, 0x72, 0x52, 0xcf, 0x6c, 0x09, 0xf3, 0x2f, 0x05, 0x02, 0xd9, 0x93, 0x31, 0x20, 0x95, 0x3d,
+	0xbc, 0xae, 0x60, 0xd6, 0xf3, 0xea, 0x4b, 0xfd, 0xd6, 0x83, 0x38, 0x13, 0x38, 0x04, 0x04, 0x7f,
+	0x5c, 0x3a, 0xdf, 0x96, 0xe1, 0x9b, 0x5c, 0x4b, 0xa7, 0x15, 0x6d, 0x8a, 0xa5, 0x43, 0x00, 0x70,
+	0xe6, 0xf8, 0x35, 0x3c, 0x14, 0x6b, 0x92, 0xbc, 0x5f, 0xa3, 0x87, 0xaa, 0xc5, 0xd7, 0x70, 0x08,
+	0x08, 0xfe, 0x28, 0x23, 0x81, 0xaa, 0x66, 0x33, 0x67, 0xf3, 0xca, 0x48, 0xb6, 0x30, 0x4e, 0xc8,
+	0x48, 0x0c, 0x85, 0xa4, 0x2f, 0xfc, 0x42, 0x8a, 0xaf, 0x37, 0x99, 0x73, 0x79, 0xbf, 0x50, 0x7f,
+	0xfc, 0x4a, 0x7c, 0x21, 0x87, 0x80, 0xe0, 0x8f, 0x6a, 0xcc, 0x7e, 0x14, 0x5a, 0xf7, 0x2d, 0x73,
+	0x3e, 0xaf, 0x30, 0xa6, 0x9e, 0x27, 0x16, 0x6a, 0x4c, 0x80, 0x40, 0x76, 0xa1, 0x3a, 0xdb, 0xb4,
+	0xcc, 0x0b, 0xa7, 0xd1, 0xd9, 0xe6, 0x64, 0x67, 0x9b, 0xb2, 0xb3, 0x4d, 0x0b, 0xd7, 0x6e, 0xe0,
+	0x8c, 0xc4, 0xf3, 0x07, 0xe6, 0x42, 0xde, 0xb5, 0xcb, 0xbe, 0xa4, 0x20, 0xd6, 0x2e, 0x86, 0x42,
+	0xd2, 0x17, 0x6e, 0x01, 0x7b, 0xf8, 0xf6, 0xc8, 0x5c, 0xcc, 0xbb, 0x05, 0xb4, 0x7f, 0x52, 0x22,
+	0xb6, 0x00, 0x02, 0x80, 0x33, 0xc7, 0x4e, 0x86, 0x6f, 0x47, 0x91, 0x79, 0x31, 0x6f, 0x27, 0xda,
+	0x7d, 0x06, 0xd1, 0x09, 0x02, 0x80, 0x33, 0xe7, 0xde, 0x30, 0xaf, 0xc8, 0x32, 0x8d, 0xbc, 0xeb,
+	0x95, 0xaa, 0xec, 0x92, 0xde, 0x30, 0x07, 0x81, 0xec, 0x02, 0x45, 0x9e, 0x61, 0x19, 0x92, 0x79,
+	0x29, 0xaf, 0xc8, 0xeb, 0xd5, 0x4c, 0x42, 0xe4, 0x39, 0x04, 0x04, 0x7f, 0x0c, 0x6c, 0xa8, 0x54,
+	0xac, 0x79, 0x39, 0x6f, 0x60, 0x23, 0xf3, 0xda, 0x95, 0x08, 0x6c, 0x28, 0x20, 0xc4, 0x1d, 0x19,
+	0x5f, 0x26, 0x25, 0x2f, 0x18, 0x98, 0x57, 0xf2, 0x56, 0x95, 0x24, 0x97, 0x75, 0xc4, 0xc9, 0xdd,
+	0x09, 0x06, 0x80, 0x9c, 0x8d, 0x3f, 0x2b, 0x90, 0xf9, 0x40, 0xaf, 0x9b, 0x36, 0xaf, 0x5e, 0x2f,
+	0xe4, 0xab, 0xf1, 0x9f, 0x56, 0x86, 0x2d, 0x0e, 0x99, 0x14, 0x06, 0xd2, 0xfd, 0xe2, 0xa7, 0xbe,
+	0x15, 0xec, 0x98, 0x2f, 0xe6, 0xfd, 0xd4, 0xe4, 0x12, 0xb1, 0xf8, 0xd4, 0xbb, 0xc1, 0x0e, 0x20,
+	0xe7, 0x86, 0x43, 0x66, 0xb5, 0xb7, 0x6a, 0x8f, 0xf1, 0x00, 0xe2, 0xeb, 0x84, 0xec, 0x53, 0xe6,
+	0xf6, 0x0f, 0x30, 0xd0, 0x2c, 0x1f, 0xe2, 0x8c, 0xbd, 0xb4, 0x37, 0x63, 0x0c, 0x68, 0x54, 0xad,
+	0xe6, 0x4f, 0x7e, 0x71, 0xed, 0x85, 0x9f, 0xfe, 0xe2, 0xda, 0x0b, 0x3f, 0xfb, 0xc5, 0xb5, 0x17,
+	0xfe, 0xe4, 0xf0, 0x5a, 0xe1, 0x27, 0x87, 0xd7, 0x0a, 0x3f, 0x3d, 0xbc, 0x56, 0xf8, 0xd9, 0xe1,
+	0xb5, 0xc2, 0xcf, 0x0f, 0xaf, 0x15, 0xbe, 0xf3, 0xcb, 0x6b, 0x2f, 0x7c, 0xa1, 0xae, 0x46, 0xfb,
+	0xbf, 0x03, 0x00, 0x5e, 0xee, 0xb0, 0xbd, 0xf2, 0x6a, 0x00, 0x00,
+}
+
+func (m *AMQPTrigger) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AMQPTrigger) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AMQPTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Parameters) > 0 {
+		for iNdEx := len(m.Parameters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Parameters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Payload) > 0 {
+		for iNdEx := len(m.Payload) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Payload[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.TLS != nil {
+		{
+			size, err := m.TLS.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	i--
+	if m.PublisherConfirms {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x38
+	i--
+	if m.Persistent {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x30
+	i -= len(m.ContentType)
+	copy(dAtA[i:], m.ContentType)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ContentType)))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Headers) > 0 {
+		keysForHeaders := make([]string, 0, len(m.Headers))
+		for k := range m.Headers {
+			keysForHeaders = append(keysForHeaders, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForHeaders)
+		for iNdEx := len(keysForHeaders) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Headers[string(keysForHeaders[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForHeaders[iNdEx])
+			copy(dAtA[i:], keysForHeaders[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForHeaders[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	i -= len(m.RoutingKey)
+	copy(dAtA[i:], m.RoutingKey)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RoutingKey)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.ExchangeName)
+	copy(dAtA[i:], m.ExchangeName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ExchangeName)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
 func (m *AWSLambdaTrigger) Marshal() (dAtA []byte, err error) {
@@ -1474,7 +2210,7 @@ func (m *AWSLambdaTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ArgoWorkflowTrigger) Marshal() (dAtA []byte, err error) {
+func (m *AWSSNSTrigger) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1484,26 +2220,16 @@ func (m *ArgoWorkflowTrigger) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ArgoWorkflowTrigger) MarshalTo(dAtA []byte) (int, error) {
+func (m *AWSSNSTrigger) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ArgoWorkflowTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AWSSNSTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.GroupVersionResource.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
 	if len(m.Parameters) > 0 {
 		for iNdEx := len(m.Parameters) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1515,17 +2241,323 @@ func (m *ArgoWorkflowTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x5a
 		}
 	}
-	i -= len(m.Operation)
-	copy(dAtA[i:], m.Operation)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Operation)))
+	i -= len(m.MessageDeduplicationID)
+	copy(dAtA[i:], m.MessageDeduplicationID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MessageDeduplicationID)))
 	i--
-	dAtA[i] = 0x12
-	if m.Source != nil {
+	dAtA[i] = 0x52
+	i -= len(m.MessageGroupID)
+	copy(dAtA[i:], m.MessageGroupID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MessageGroupID)))
+	i--
+	dAtA[i] = 0x4a
+	if len(m.MessageAttributes) > 0 {
+		keysForMessageAttributes := make([]string, 0, len(m.MessageAttributes))
+		for k := range m.MessageAttributes {
+			keysForMessageAttributes = append(keysForMessageAttributes, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForMessageAttributes)
+		for iNdEx := len(keysForMessageAttributes) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.MessageAttributes[string(keysForMessageAttributes[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForMessageAttributes[iNdEx])
+			copy(dAtA[i:], keysForMessageAttributes[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForMessageAttributes[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	i -= len(m.Subject)
+	copy(dAtA[i:], m.Subject)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Subject)))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.Payload) > 0 {
+		for iNdEx := len(m.Payload) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Payload[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	i -= len(m.RoleARN)
+	copy(dAtA[i:], m.RoleARN)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RoleARN)))
+	i--
+	dAtA[i] = 0x2a
+	if m.SecretKey != nil {
 		{
-			size, err := m.Source.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.SecretKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.AccessKey != nil {
+		{
+			size, err := m.AccessKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	i -= len(m.Region)
+	copy(dAtA[i:], m.Region)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Region)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.TopicArn)
+	copy(dAtA[i:], m.TopicArn)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TopicArn)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AWSSQSTrigger) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AWSSQSTrigger) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AWSSQSTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Parameters) > 0 {
+		for iNdEx := len(m.Parameters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Parameters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	i -= len(m.MessageDeduplicationID)
+	copy(dAtA[i:], m.MessageDeduplicationID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MessageDeduplicationID)))
+	i--
+	dAtA[i] = 0x52
+	i -= len(m.MessageGroupID)
+	copy(dAtA[i:], m.MessageGroupID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MessageGroupID)))
+	i--
+	dAtA[i] = 0x4a
+	if len(m.MessageAttributes) > 0 {
+		keysForMessageAttributes := make([]string, 0, len(m.MessageAttributes))
+		for k := range m.MessageAttributes {
+			keysForMessageAttributes = append(keysForMessageAttributes, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForMessageAttributes)
+		for iNdEx := len(keysForMessageAttributes) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.MessageAttributes[string(keysForMessageAttributes[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForMessageAttributes[iNdEx])
+			copy(dAtA[i:], keysForMessageAttributes[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForMessageAttributes[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Payload) > 0 {
+		for iNdEx := len(m.Payload) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Payload[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	i -= len(m.RoleARN)
+	copy(dAtA[i:], m.RoleARN)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RoleARN)))
+	i--
+	dAtA[i] = 0x32
+	if m.SecretKey != nil {
+		{
+			size, err := m.SecretKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.AccessKey != nil {
+		{
+			size, err := m.AccessKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	i -= len(m.Region)
+	copy(dAtA[i:], m.Region)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Region)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.QueueAccountID)
+	copy(dAtA[i:], m.QueueAccountID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.QueueAccountID)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Queue)
+	copy(dAtA[i:], m.Queue)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Queue)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ArgoWorkflowTrigger) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArgoWorkflowTrigger) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArgoWorkflowTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Concurrency != nil {
+		{
+			size, err := m.Concurrency.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.GarbageCollection != nil {
+		{
+			size, err := m.GarbageCollection.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Arguments) > 0 {
+		for iNdEx := len(m.Arguments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Arguments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size, err := m.GroupVersionResource.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Parameters) > 0 {
+		for iNdEx := len(m.Parameters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Parameters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	i -= len(m.Operation)
+	copy(dAtA[i:], m.Operation)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Operation)))
+	i--
+	dAtA[i] = 0x12
+	if m.Source != nil {
+		{
+			size, err := m.Source.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1687,6 +2719,47 @@ func (m *BasicAuth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CircuitBreaker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CircuitBreaker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CircuitBreaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DeadLetterTrigger != nil {
+		{
+			size, err := m.DeadLetterTrigger.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.CoolOffSeconds))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintGenerated(dAtA, i, uint64(m.FailureThreshold))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *CustomTrigger) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1869,7 +2942,7 @@ func (m *DependencyGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Event) Marshal() (dAtA []byte, err error) {
+func (m *EmailTrigger) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1879,24 +2952,148 @@ func (m *Event) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Event) MarshalTo(dAtA []byte) (int, error) {
+func (m *EmailTrigger) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Event) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EmailTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Data != nil {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintGenerated(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Context != nil {
+	i -= len(m.HTML)
+	copy(dAtA[i:], m.HTML)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.HTML)))
+	i--
+	dAtA[i] = 0x6a
+	i -= len(m.Body)
+	copy(dAtA[i:], m.Body)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Body)))
+	i--
+	dAtA[i] = 0x62
+	i -= len(m.Subject)
+	copy(dAtA[i:], m.Subject)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Subject)))
+	i--
+	dAtA[i] = 0x5a
+	if len(m.Cc) > 0 {
+		for iNdEx := len(m.Cc) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Cc[iNdEx])
+			copy(dAtA[i:], m.Cc[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Cc[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.To) > 0 {
+		for iNdEx := len(m.To) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.To[iNdEx])
+			copy(dAtA[i:], m.To[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.To[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	i -= len(m.From)
+	copy(dAtA[i:], m.From)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.From)))
+	i--
+	dAtA[i] = 0x42
+	if m.Password != nil {
+		{
+			size, err := m.Password.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Username != nil {
+		{
+			size, err := m.Username.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.TLS != nil {
+		{
+			size, err := m.TLS.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	i -= len(m.Security)
+	copy(dAtA[i:], m.Security)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Security)))
+	i--
+	dAtA[i] = 0x22
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Port))
+	i--
+	dAtA[i] = 0x18
+	i -= len(m.Host)
+	copy(dAtA[i:], m.Host)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Host)))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Parameters) > 0 {
+		for iNdEx := len(m.Parameters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Parameters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Event) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Event) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Event) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Data != nil {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Context != nil {
 		{
 			size, err := m.Context.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
@@ -1911,6 +3108,72 @@ func (m *Event) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventBusTrigger) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBusTrigger) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBusTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Parameters) > 0 {
+		for iNdEx := len(m.Parameters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Parameters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Payload) > 0 {
+		for iNdEx := len(m.Payload) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Payload[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	i -= len(m.Subject)
+	copy(dAtA[i:], m.Subject)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Subject)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Source)
+	copy(dAtA[i:], m.Source)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Source)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Type)
+	copy(dAtA[i:], m.Type)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *EventContext) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2118,7 +3381,7 @@ func (m *FileArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *GitArtifact) Marshal() (dAtA []byte, err error) {
+func (m *GCPPubSubTrigger) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2128,56 +3391,76 @@ func (m *GitArtifact) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GitArtifact) MarshalTo(dAtA []byte) (int, error) {
+func (m *GCPPubSubTrigger) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GitArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GCPPubSubTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Remote != nil {
-		{
-			size, err := m.Remote.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Parameters) > 0 {
+		for iNdEx := len(m.Parameters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Parameters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x42
 		}
-		i--
-		dAtA[i] = 0x4a
 	}
-	i -= len(m.Ref)
-	copy(dAtA[i:], m.Ref)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Ref)))
-	i--
-	dAtA[i] = 0x42
-	i -= len(m.Tag)
-	copy(dAtA[i:], m.Tag)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Tag)))
+	i -= len(m.OrderingKey)
+	copy(dAtA[i:], m.OrderingKey)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.OrderingKey)))
 	i--
 	dAtA[i] = 0x3a
-	i -= len(m.Branch)
-	copy(dAtA[i:], m.Branch)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Branch)))
-	i--
-	dAtA[i] = 0x32
-	i -= len(m.FilePath)
-	copy(dAtA[i:], m.FilePath)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.FilePath)))
-	i--
-	dAtA[i] = 0x2a
-	i -= len(m.SSHKeyPath)
-	copy(dAtA[i:], m.SSHKeyPath)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SSHKeyPath)))
-	i--
-	dAtA[i] = 0x22
-	if m.Creds != nil {
+	if len(m.Attributes) > 0 {
+		keysForAttributes := make([]string, 0, len(m.Attributes))
+		for k := range m.Attributes {
+			keysForAttributes = append(keysForAttributes, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForAttributes)
+		for iNdEx := len(keysForAttributes) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Attributes[string(keysForAttributes[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForAttributes[iNdEx])
+			copy(dAtA[i:], keysForAttributes[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForAttributes[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Payload) > 0 {
+		for iNdEx := len(m.Payload) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Payload[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.CredentialSecret != nil {
 		{
-			size, err := m.Creds.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.CredentialSecret.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2185,16 +3468,147 @@ func (m *GitArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x22
 	}
-	i -= len(m.CloneDirectory)
-	copy(dAtA[i:], m.CloneDirectory)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CloneDirectory)))
+	i -= len(m.Topic)
+	copy(dAtA[i:], m.Topic)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Topic)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.TopicProjectID)
+	copy(dAtA[i:], m.TopicProjectID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TopicProjectID)))
 	i--
 	dAtA[i] = 0x12
-	i -= len(m.URL)
-	copy(dAtA[i:], m.URL)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i -= len(m.ProjectID)
+	copy(dAtA[i:], m.ProjectID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ProjectID)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GarbageCollection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GarbageCollection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GarbageCollection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Retain))
+	i--
+	dAtA[i] = 0x20
+	i -= len(m.CompletionCondition)
+	copy(dAtA[i:], m.CompletionCondition)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CompletionCondition)))
+	i--
+	dAtA[i] = 0x1a
+	if m.TTLSecondsAfterCompletion != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.TTLSecondsAfterCompletion))
+		i--
+		dAtA[i] = 0x10
+	}
+	i--
+	if m.OwnerReference {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *GitArtifact) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GitArtifact) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GitArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Remote != nil {
+		{
+			size, err := m.Remote.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	i -= len(m.Ref)
+	copy(dAtA[i:], m.Ref)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Ref)))
+	i--
+	dAtA[i] = 0x42
+	i -= len(m.Tag)
+	copy(dAtA[i:], m.Tag)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Tag)))
+	i--
+	dAtA[i] = 0x3a
+	i -= len(m.Branch)
+	copy(dAtA[i:], m.Branch)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Branch)))
+	i--
+	dAtA[i] = 0x32
+	i -= len(m.FilePath)
+	copy(dAtA[i:], m.FilePath)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.FilePath)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.SSHKeyPath)
+	copy(dAtA[i:], m.SSHKeyPath)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SSHKeyPath)))
+	i--
+	dAtA[i] = 0x22
+	if m.Creds != nil {
+		{
+			size, err := m.Creds.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	i -= len(m.CloneDirectory)
+	copy(dAtA[i:], m.CloneDirectory)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CloneDirectory)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -2396,6 +3810,97 @@ func (m *HTTPTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *JobTrigger) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobTrigger) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Parameters) > 0 {
+		for iNdEx := len(m.Parameters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Parameters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.LogLines))
+	i--
+	dAtA[i] = 0x48
+	i--
+	if m.AllowFailure {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x40
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Timeout))
+	i--
+	dAtA[i] = 0x38
+	i--
+	if m.Wait {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x30
+	if m.TTLSecondsAfterFinished != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.TTLSecondsAfterFinished))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ActiveDeadlineSeconds != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.ActiveDeadlineSeconds))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BackoffLimit != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.BackoffLimit))
+		i--
+		dAtA[i] = 0x18
+	}
+	i -= len(m.Namespace)
+	copy(dAtA[i:], m.Namespace)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i--
+	dAtA[i] = 0x12
+	if m.Template != nil {
+		{
+			size, err := m.Template.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *K8SResourcePolicy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2416,6 +3921,19 @@ func (m *K8SResourcePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Timeout))
+	i--
+	dAtA[i] = 0x30
+	i -= len(m.FailureCondition)
+	copy(dAtA[i:], m.FailureCondition)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.FailureCondition)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.SuccessCondition)
+	copy(dAtA[i:], m.SuccessCondition)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SuccessCondition)))
+	i--
+	dAtA[i] = 0x22
 	i--
 	if m.ErrorOnBackoffTimeout {
 		dAtA[i] = 1
@@ -2556,7 +4074,7 @@ func (m *KafkaTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Metadata) Marshal() (dAtA []byte, err error) {
+func (m *LogTrigger) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2566,60 +4084,205 @@ func (m *Metadata) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Metadata) MarshalTo(dAtA []byte) (int, error) {
+func (m *LogTrigger) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *LogTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Labels) > 0 {
-		keysForLabels := make([]string, 0, len(m.Labels))
-		for k := range m.Labels {
-			keysForLabels = append(keysForLabels, string(k))
-		}
-		github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
-		for iNdEx := len(keysForLabels) - 1; iNdEx >= 0; iNdEx-- {
-			v := m.Labels[string(keysForLabels[iNdEx])]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(keysForLabels[iNdEx])
-			copy(dAtA[i:], keysForLabels[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForLabels[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+	if len(m.Parameters) > 0 {
+		for iNdEx := len(m.Parameters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Parameters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x1a
 		}
 	}
-	if len(m.Annotations) > 0 {
-		keysForAnnotations := make([]string, 0, len(m.Annotations))
-		for k := range m.Annotations {
-			keysForAnnotations = append(keysForAnnotations, string(k))
-		}
-		github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
-		for iNdEx := len(keysForAnnotations) - 1; iNdEx >= 0; iNdEx-- {
-			v := m.Annotations[string(keysForAnnotations[iNdEx])]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+	if len(m.Payload) > 0 {
+		for iNdEx := len(m.Payload) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Payload[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
 			i--
 			dAtA[i] = 0x12
-			i -= len(keysForAnnotations[iNdEx])
-			copy(dAtA[i:], keysForAnnotations[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForAnnotations[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+		}
+	}
+	i -= len(m.Format)
+	copy(dAtA[i:], m.Format)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Format)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MQTTTrigger) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MQTTTrigger) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MQTTTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Parameters) > 0 {
+		for iNdEx := len(m.Parameters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Parameters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Payload) > 0 {
+		for iNdEx := len(m.Payload) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Payload[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.TLS != nil {
+		{
+			size, err := m.TLS.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	i--
+	if m.Retained {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x28
+	i = encodeVarintGenerated(dAtA, i, uint64(m.QoS))
+	i--
+	dAtA[i] = 0x20
+	i -= len(m.ClientID)
+	copy(dAtA[i:], m.ClientID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ClientID)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Topic)
+	copy(dAtA[i:], m.Topic)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Topic)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Metadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Metadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Labels) > 0 {
+		keysForLabels := make([]string, 0, len(m.Labels))
+		for k := range m.Labels {
+			keysForLabels = append(keysForLabels, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+		for iNdEx := len(keysForLabels) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Labels[string(keysForLabels[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForLabels[iNdEx])
+			copy(dAtA[i:], keysForLabels[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForLabels[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Annotations) > 0 {
+		keysForAnnotations := make([]string, 0, len(m.Annotations))
+		for k := range m.Annotations {
+			keysForAnnotations = append(keysForAnnotations, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
+		for iNdEx := len(keysForAnnotations) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Annotations[string(keysForAnnotations[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForAnnotations[iNdEx])
+			copy(dAtA[i:], keysForAnnotations[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForAnnotations[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -2700,6 +4363,79 @@ func (m *NATSTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ObjectStorageTrigger) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ObjectStorageTrigger) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ObjectStorageTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Parameters) > 0 {
+		for iNdEx := len(m.Parameters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Parameters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Payload) > 0 {
+		for iNdEx := len(m.Payload) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Payload[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	i -= len(m.ContentType)
+	copy(dAtA[i:], m.ContentType)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ContentType)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Body)
+	copy(dAtA[i:], m.Body)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Body)))
+	i--
+	dAtA[i] = 0x12
+	if m.S3 != nil {
+		{
+			size, err := m.S3.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *OpenWhiskTrigger) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2783,7 +4519,7 @@ func (m *OpenWhiskTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Sensor) Marshal() (dAtA []byte, err error) {
+func (m *PulsarTrigger) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2793,35 +4529,254 @@ func (m *Sensor) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Sensor) MarshalTo(dAtA []byte) (int, error) {
+func (m *PulsarTrigger) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Sensor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PulsarTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Parameters) > 0 {
+		for iNdEx := len(m.Parameters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Parameters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
 		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Payload) > 0 {
+		for iNdEx := len(m.Payload) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Payload[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
 		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	i--
+	if len(m.Properties) > 0 {
+		keysForProperties := make([]string, 0, len(m.Properties))
+		for k := range m.Properties {
+			keysForProperties = append(keysForProperties, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForProperties)
+		for iNdEx := len(keysForProperties) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Properties[string(keysForProperties[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForProperties[iNdEx])
+			copy(dAtA[i:], keysForProperties[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForProperties[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	i -= len(m.Key)
+	copy(dAtA[i:], m.Key)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Key)))
+	i--
+	dAtA[i] = 0x3a
+	if m.TLS != nil {
+		{
+			size, err := m.TLS.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	i--
+	if m.TLSValidateHostname {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x28
+	i--
+	if m.TLSAllowInsecureConnection {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x20
+	i -= len(m.TLSTrustCertsFilePath)
+	copy(dAtA[i:], m.TLSTrustCertsFilePath)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TLSTrustCertsFilePath)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Topic)
+	copy(dAtA[i:], m.Topic)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Topic)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RedisTrigger) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RedisTrigger) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedisTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Parameters) > 0 {
+		for iNdEx := len(m.Parameters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Parameters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Payload) > 0 {
+		for iNdEx := len(m.Payload) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Payload[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MaxStreamLength))
+	i--
+	dAtA[i] = 0x38
+	i -= len(m.Stream)
+	copy(dAtA[i:], m.Stream)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Stream)))
+	i--
+	dAtA[i] = 0x32
+	i -= len(m.Channel)
+	copy(dAtA[i:], m.Channel)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Channel)))
+	i--
+	dAtA[i] = 0x2a
+	if m.TLS != nil {
+		{
+			size, err := m.TLS.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.DB))
+	i--
+	dAtA[i] = 0x18
+	if m.Password != nil {
+		{
+			size, err := m.Password.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i -= len(m.HostAddress)
+	copy(dAtA[i:], m.HostAddress)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.HostAddress)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Sensor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Sensor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Sensor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
 	dAtA[i] = 0x12
 	{
 		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
@@ -2903,6 +4858,36 @@ func (m *SensorSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.SuspendBufferSize))
+	i--
+	dAtA[i] = 0x60
+	i--
+	if m.Suspend {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x58
+	i--
+	if m.DryRun {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x50
+	i--
+	if m.ParallelTriggers {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x48
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MaxConcurrentTriggers))
+	i--
+	dAtA[i] = 0x40
 	i -= len(m.EventBusName)
 	copy(dAtA[i:], m.EventBusName)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.EventBusName)))
@@ -2996,6 +4981,35 @@ func (m *SensorStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TriggerStatuses) > 0 {
+		keysForTriggerStatuses := make([]string, 0, len(m.TriggerStatuses))
+		for k := range m.TriggerStatuses {
+			keysForTriggerStatuses = append(keysForTriggerStatuses, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForTriggerStatuses)
+		for iNdEx := len(keysForTriggerStatuses) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.TriggerStatuses[string(keysForTriggerStatuses[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForTriggerStatuses[iNdEx])
+			copy(dAtA[i:], keysForTriggerStatuses[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForTriggerStatuses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -3088,6 +5102,48 @@ func (m *StandardK8STrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Concurrency != nil {
+		{
+			size, err := m.Concurrency.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.GarbageCollection != nil {
+		{
+			size, err := m.GarbageCollection.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	i--
+	if m.ForceConflicts {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x48
+	i -= len(m.FieldManager)
+	copy(dAtA[i:], m.FieldManager)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.FieldManager)))
+	i--
+	dAtA[i] = 0x42
+	i -= len(m.LabelSelector)
+	copy(dAtA[i:], m.LabelSelector)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.LabelSelector)))
+	i--
+	dAtA[i] = 0x3a
 	i--
 	if m.LiveObject {
 		dAtA[i] = 1
@@ -3380,15 +5436,52 @@ func (m *Trigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.Policy != nil {
-		{
-			size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
+	i--
+	if m.DryRun {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x38
+	i -= len(m.When)
+	copy(dAtA[i:], m.When)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.When)))
+	i--
+	dAtA[i] = 0x32
+	if m.CircuitBreaker != nil {
+		{
+			size, err := m.CircuitBreaker.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.RetryStrategy != nil {
+		{
+			size, err := m.RetryStrategy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Policy != nil {
+		{
+			size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -3421,6 +5514,51 @@ func (m *Trigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TriggerConcurrency) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TriggerConcurrency) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TriggerConcurrency) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CorrelationKey != nil {
+		{
+			size, err := m.CorrelationKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	i -= len(m.CompletionCondition)
+	copy(dAtA[i:], m.CompletionCondition)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CompletionCondition)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Policy)
+	copy(dAtA[i:], m.Policy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Policy)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *TriggerParameter) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3568,6 +5706,65 @@ func (m *TriggerPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TriggerStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TriggerStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TriggerStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.TimedOut))
+	i--
+	dAtA[i] = 0x38
+	if len(m.LastEventIDs) > 0 {
+		for iNdEx := len(m.LastEventIDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.LastEventIDs[iNdEx])
+			copy(dAtA[i:], m.LastEventIDs[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.LastEventIDs[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	i -= len(m.LastError)
+	copy(dAtA[i:], m.LastError)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.LastError)))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.LastExecutionTime.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Failed))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Succeeded))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Fired))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *TriggerSwitch) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3629,6 +5826,166 @@ func (m *TriggerTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Job != nil {
+		{
+			size, err := m.Job.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if m.ObjectStorage != nil {
+		{
+			size, err := m.ObjectStorage.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	if m.Log != nil {
+		{
+			size, err := m.Log.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if m.EventBus != nil {
+		{
+			size, err := m.EventBus.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if m.Redis != nil {
+		{
+			size, err := m.Redis.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.Pulsar != nil {
+		{
+			size, err := m.Pulsar.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.MQTT != nil {
+		{
+			size, err := m.MQTT.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.AMQP != nil {
+		{
+			size, err := m.AMQP.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.GCPPubSub != nil {
+		{
+			size, err := m.GCPPubSub.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.AWSSNS != nil {
+		{
+			size, err := m.AWSSNS.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.AWSSQS != nil {
+		{
+			size, err := m.AWSSQS.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.Email != nil {
+		{
+			size, err := m.Email.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
 	if m.OpenWhisk != nil {
 		{
 			size, err := m.OpenWhisk.MarshalToSizedBuffer(dAtA[:i])
@@ -3804,24 +6161,34 @@ func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
-func (m *AWSLambdaTrigger) Size() (n int) {
+func (m *AMQPTrigger) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.FunctionName)
+	l = len(m.URL)
 	n += 1 + l + sovGenerated(uint64(l))
-	if m.AccessKey != nil {
-		l = m.AccessKey.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ExchangeName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.RoutingKey)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Headers) > 0 {
+		for k, v := range m.Headers {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
 	}
-	if m.SecretKey != nil {
-		l = m.SecretKey.Size()
+	l = len(m.ContentType)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	n += 2
+	if m.TLS != nil {
+		l = m.TLS.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	l = len(m.Region)
-	n += 1 + l + sovGenerated(uint64(l))
 	if len(m.Payload) > 0 {
 		for _, e := range m.Payload {
 			l = e.Size()
@@ -3837,7 +6204,138 @@ func (m *AWSLambdaTrigger) Size() (n int) {
 	return n
 }
 
-func (m *ArgoWorkflowTrigger) Size() (n int) {
+func (m *AWSLambdaTrigger) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FunctionName)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.AccessKey != nil {
+		l = m.AccessKey.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.SecretKey != nil {
+		l = m.SecretKey.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Region)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Payload) > 0 {
+		for _, e := range m.Payload {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Parameters) > 0 {
+		for _, e := range m.Parameters {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AWSSNSTrigger) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TopicArn)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Region)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.AccessKey != nil {
+		l = m.AccessKey.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.SecretKey != nil {
+		l = m.SecretKey.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.RoleARN)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Payload) > 0 {
+		for _, e := range m.Payload {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.Subject)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.MessageAttributes) > 0 {
+		for k, v := range m.MessageAttributes {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.MessageGroupID)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.MessageDeduplicationID)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Parameters) > 0 {
+		for _, e := range m.Parameters {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AWSSQSTrigger) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Queue)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.QueueAccountID)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Region)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.AccessKey != nil {
+		l = m.AccessKey.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.SecretKey != nil {
+		l = m.SecretKey.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.RoleARN)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Payload) > 0 {
+		for _, e := range m.Payload {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.MessageAttributes) > 0 {
+		for k, v := range m.MessageAttributes {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.MessageGroupID)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.MessageDeduplicationID)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Parameters) > 0 {
+		for _, e := range m.Parameters {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ArgoWorkflowTrigger) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -3857,6 +6355,20 @@ func (m *ArgoWorkflowTrigger) Size() (n int) {
 	}
 	l = m.GroupVersionResource.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Arguments) > 0 {
+		for _, e := range m.Arguments {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.GarbageCollection != nil {
+		l = m.GarbageCollection.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Concurrency != nil {
+		l = m.Concurrency.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -3914,6 +6426,21 @@ func (m *BasicAuth) Size() (n int) {
 	return n
 }
 
+func (m *CircuitBreaker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.FailureThreshold))
+	n += 1 + sovGenerated(uint64(m.CoolOffSeconds))
+	if m.DeadLetterTrigger != nil {
+		l = m.DeadLetterTrigger.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *CustomTrigger) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3988,6 +6515,58 @@ func (m *DependencyGroup) Size() (n int) {
 	return n
 }
 
+func (m *EmailTrigger) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Parameters) > 0 {
+		for _, e := range m.Parameters {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.Host)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Port))
+	l = len(m.Security)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.TLS != nil {
+		l = m.TLS.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Username != nil {
+		l = m.Username.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Password != nil {
+		l = m.Password.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.From)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.To) > 0 {
+		for _, s := range m.To {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Cc) > 0 {
+		for _, s := range m.Cc {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.Subject)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Body)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.HTML)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *Event) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4005,6 +6584,33 @@ func (m *Event) Size() (n int) {
 	return n
 }
 
+func (m *EventBusTrigger) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Source)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Subject)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Payload) > 0 {
+		for _, e := range m.Payload {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Parameters) > 0 {
+		for _, e := range m.Parameters {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *EventContext) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4083,6 +6689,63 @@ func (m *FileArtifact) Size() (n int) {
 	return n
 }
 
+func (m *GCPPubSubTrigger) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProjectID)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.TopicProjectID)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Topic)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.CredentialSecret != nil {
+		l = m.CredentialSecret.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Payload) > 0 {
+		for _, e := range m.Payload {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Attributes) > 0 {
+		for k, v := range m.Attributes {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.OrderingKey)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Parameters) > 0 {
+		for _, e := range m.Parameters {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GarbageCollection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 2
+	if m.TTLSecondsAfterCompletion != nil {
+		n += 1 + sovGenerated(uint64(*m.TTLSecondsAfterCompletion))
+	}
+	l = len(m.CompletionCondition)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Retain))
+	return n
+}
+
 func (m *GitArtifact) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4190,23 +6853,62 @@ func (m *HTTPTrigger) Size() (n int) {
 	return n
 }
 
-func (m *K8SResourcePolicy) Size() (n int) {
+func (m *JobTrigger) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Labels) > 0 {
-		for k, v := range m.Labels {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
-		}
-	}
-	l = m.Backoff.Size()
+	if m.Template != nil {
+		l = m.Template.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.BackoffLimit != nil {
+		n += 1 + sovGenerated(uint64(*m.BackoffLimit))
+	}
+	if m.ActiveDeadlineSeconds != nil {
+		n += 1 + sovGenerated(uint64(*m.ActiveDeadlineSeconds))
+	}
+	if m.TTLSecondsAfterFinished != nil {
+		n += 1 + sovGenerated(uint64(*m.TTLSecondsAfterFinished))
+	}
+	n += 2
+	n += 1 + sovGenerated(uint64(m.Timeout))
+	n += 2
+	n += 1 + sovGenerated(uint64(m.LogLines))
+	if len(m.Parameters) > 0 {
+		for _, e := range m.Parameters {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *K8SResourcePolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	l = m.Backoff.Size()
 	n += 1 + l + sovGenerated(uint64(l))
 	n += 2
+	l = len(m.SuccessCondition)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.FailureCondition)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Timeout))
 	return n
 }
 
@@ -4245,6 +6947,62 @@ func (m *KafkaTrigger) Size() (n int) {
 	return n
 }
 
+func (m *LogTrigger) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Format)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Payload) > 0 {
+		for _, e := range m.Payload {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Parameters) > 0 {
+		for _, e := range m.Parameters {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MQTTTrigger) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Topic)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ClientID)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.QoS))
+	n += 2
+	if m.TLS != nil {
+		l = m.TLS.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Payload) > 0 {
+		for _, e := range m.Payload {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Parameters) > 0 {
+		for _, e := range m.Parameters {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Metadata) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4299,6 +7057,35 @@ func (m *NATSTrigger) Size() (n int) {
 	return n
 }
 
+func (m *ObjectStorageTrigger) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.S3 != nil {
+		l = m.S3.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Body)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ContentType)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Payload) > 0 {
+		for _, e := range m.Payload {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Parameters) > 0 {
+		for _, e := range m.Parameters {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *OpenWhiskTrigger) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4332,6 +7119,86 @@ func (m *OpenWhiskTrigger) Size() (n int) {
 	return n
 }
 
+func (m *PulsarTrigger) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Topic)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.TLSTrustCertsFilePath)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	n += 2
+	if m.TLS != nil {
+		l = m.TLS.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Key)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Properties) > 0 {
+		for k, v := range m.Properties {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Payload) > 0 {
+		for _, e := range m.Payload {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Parameters) > 0 {
+		for _, e := range m.Parameters {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RedisTrigger) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HostAddress)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Password != nil {
+		l = m.Password.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 1 + sovGenerated(uint64(m.DB))
+	if m.TLS != nil {
+		l = m.TLS.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Channel)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Stream)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.MaxStreamLength))
+	if len(m.Payload) > 0 {
+		for _, e := range m.Payload {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Parameters) > 0 {
+		for _, e := range m.Parameters {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Sensor) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4395,6 +7262,11 @@ func (m *SensorSpec) Size() (n int) {
 	n += 2
 	l = len(m.EventBusName)
 	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.MaxConcurrentTriggers))
+	n += 2
+	n += 2
+	n += 2
+	n += 1 + sovGenerated(uint64(m.SuspendBufferSize))
 	return n
 }
 
@@ -4406,6 +7278,15 @@ func (m *SensorStatus) Size() (n int) {
 	_ = l
 	l = m.Status.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.TriggerStatuses) > 0 {
+		for k, v := range m.TriggerStatuses {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -4455,6 +7336,19 @@ func (m *StandardK8STrigger) Size() (n int) {
 	l = len(m.PatchStrategy)
 	n += 1 + l + sovGenerated(uint64(l))
 	n += 2
+	l = len(m.LabelSelector)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.FieldManager)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	if m.GarbageCollection != nil {
+		l = m.GarbageCollection.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Concurrency != nil {
+		l = m.Concurrency.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -4561,6 +7455,34 @@ func (m *Trigger) Size() (n int) {
 		l = m.Policy.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.RetryStrategy != nil {
+		l = m.RetryStrategy.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.CircuitBreaker != nil {
+		l = m.CircuitBreaker.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.When)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	return n
+}
+
+func (m *TriggerConcurrency) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Policy)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.CompletionCondition)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.CorrelationKey != nil {
+		l = m.CorrelationKey.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -4621,6 +7543,29 @@ func (m *TriggerPolicy) Size() (n int) {
 	return n
 }
 
+func (m *TriggerStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.Fired))
+	n += 1 + sovGenerated(uint64(m.Succeeded))
+	n += 1 + sovGenerated(uint64(m.Failed))
+	l = m.LastExecutionTime.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.LastError)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.LastEventIDs) > 0 {
+		for _, s := range m.LastEventIDs {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	n += 1 + sovGenerated(uint64(m.TimedOut))
+	return n
+}
+
 func (m *TriggerSwitch) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4690,6 +7635,54 @@ func (m *TriggerTemplate) Size() (n int) {
 		l = m.OpenWhisk.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Email != nil {
+		l = m.Email.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.AWSSQS != nil {
+		l = m.AWSSQS.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.AWSSNS != nil {
+		l = m.AWSSNS.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.GCPPubSub != nil {
+		l = m.GCPPubSub.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.AMQP != nil {
+		l = m.AMQP.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.MQTT != nil {
+		l = m.MQTT.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.Pulsar != nil {
+		l = m.Pulsar.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.Redis != nil {
+		l = m.Redis.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.EventBus != nil {
+		l = m.EventBus.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.Log != nil {
+		l = m.Log.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.ObjectStorage != nil {
+		l = m.ObjectStorage.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.Job != nil {
+		l = m.Job.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -4711,7 +7704,7 @@ func sovGenerated(x uint64) (n int) {
 func sozGenerated(x uint64) (n int) {
 	return sovGenerated(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (this *AWSLambdaTrigger) String() string {
+func (this *AMQPTrigger) String() string {
 	if this == nil {
 		return "nil"
 	}
@@ -4725,12 +7718,131 @@ func (this *AWSLambdaTrigger) String() string {
 		repeatedStringForParameters += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForParameters += "}"
-	s := strings.Join([]string{`&AWSLambdaTrigger{`,
-		`FunctionName:` + fmt.Sprintf("%v", this.FunctionName) + `,`,
-		`AccessKey:` + strings.Replace(fmt.Sprintf("%v", this.AccessKey), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`SecretKey:` + strings.Replace(fmt.Sprintf("%v", this.SecretKey), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`Region:` + fmt.Sprintf("%v", this.Region) + `,`,
-		`Payload:` + repeatedStringForPayload + `,`,
+	keysForHeaders := make([]string, 0, len(this.Headers))
+	for k := range this.Headers {
+		keysForHeaders = append(keysForHeaders, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForHeaders)
+	mapStringForHeaders := "map[string]string{"
+	for _, k := range keysForHeaders {
+		mapStringForHeaders += fmt.Sprintf("%v: %v,", k, this.Headers[k])
+	}
+	mapStringForHeaders += "}"
+	s := strings.Join([]string{`&AMQPTrigger{`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`ExchangeName:` + fmt.Sprintf("%v", this.ExchangeName) + `,`,
+		`RoutingKey:` + fmt.Sprintf("%v", this.RoutingKey) + `,`,
+		`Headers:` + mapStringForHeaders + `,`,
+		`ContentType:` + fmt.Sprintf("%v", this.ContentType) + `,`,
+		`Persistent:` + fmt.Sprintf("%v", this.Persistent) + `,`,
+		`PublisherConfirms:` + fmt.Sprintf("%v", this.PublisherConfirms) + `,`,
+		`TLS:` + strings.Replace(this.TLS.String(), "TLSConfig", "TLSConfig", 1) + `,`,
+		`Payload:` + repeatedStringForPayload + `,`,
+		`Parameters:` + repeatedStringForParameters + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AWSLambdaTrigger) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPayload := "[]TriggerParameter{"
+	for _, f := range this.Payload {
+		repeatedStringForPayload += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForPayload += "}"
+	repeatedStringForParameters := "[]TriggerParameter{"
+	for _, f := range this.Parameters {
+		repeatedStringForParameters += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForParameters += "}"
+	s := strings.Join([]string{`&AWSLambdaTrigger{`,
+		`FunctionName:` + fmt.Sprintf("%v", this.FunctionName) + `,`,
+		`AccessKey:` + strings.Replace(fmt.Sprintf("%v", this.AccessKey), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`SecretKey:` + strings.Replace(fmt.Sprintf("%v", this.SecretKey), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`Region:` + fmt.Sprintf("%v", this.Region) + `,`,
+		`Payload:` + repeatedStringForPayload + `,`,
+		`Parameters:` + repeatedStringForParameters + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AWSSNSTrigger) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPayload := "[]TriggerParameter{"
+	for _, f := range this.Payload {
+		repeatedStringForPayload += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForPayload += "}"
+	repeatedStringForParameters := "[]TriggerParameter{"
+	for _, f := range this.Parameters {
+		repeatedStringForParameters += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForParameters += "}"
+	keysForMessageAttributes := make([]string, 0, len(this.MessageAttributes))
+	for k := range this.MessageAttributes {
+		keysForMessageAttributes = append(keysForMessageAttributes, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForMessageAttributes)
+	mapStringForMessageAttributes := "map[string]string{"
+	for _, k := range keysForMessageAttributes {
+		mapStringForMessageAttributes += fmt.Sprintf("%v: %v,", k, this.MessageAttributes[k])
+	}
+	mapStringForMessageAttributes += "}"
+	s := strings.Join([]string{`&AWSSNSTrigger{`,
+		`TopicArn:` + fmt.Sprintf("%v", this.TopicArn) + `,`,
+		`Region:` + fmt.Sprintf("%v", this.Region) + `,`,
+		`AccessKey:` + strings.Replace(fmt.Sprintf("%v", this.AccessKey), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`SecretKey:` + strings.Replace(fmt.Sprintf("%v", this.SecretKey), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`RoleARN:` + fmt.Sprintf("%v", this.RoleARN) + `,`,
+		`Payload:` + repeatedStringForPayload + `,`,
+		`Subject:` + fmt.Sprintf("%v", this.Subject) + `,`,
+		`MessageAttributes:` + mapStringForMessageAttributes + `,`,
+		`MessageGroupID:` + fmt.Sprintf("%v", this.MessageGroupID) + `,`,
+		`MessageDeduplicationID:` + fmt.Sprintf("%v", this.MessageDeduplicationID) + `,`,
+		`Parameters:` + repeatedStringForParameters + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AWSSQSTrigger) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPayload := "[]TriggerParameter{"
+	for _, f := range this.Payload {
+		repeatedStringForPayload += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForPayload += "}"
+	repeatedStringForParameters := "[]TriggerParameter{"
+	for _, f := range this.Parameters {
+		repeatedStringForParameters += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForParameters += "}"
+	keysForMessageAttributes := make([]string, 0, len(this.MessageAttributes))
+	for k := range this.MessageAttributes {
+		keysForMessageAttributes = append(keysForMessageAttributes, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForMessageAttributes)
+	mapStringForMessageAttributes := "map[string]string{"
+	for _, k := range keysForMessageAttributes {
+		mapStringForMessageAttributes += fmt.Sprintf("%v: %v,", k, this.MessageAttributes[k])
+	}
+	mapStringForMessageAttributes += "}"
+	s := strings.Join([]string{`&AWSSQSTrigger{`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`QueueAccountID:` + fmt.Sprintf("%v", this.QueueAccountID) + `,`,
+		`Region:` + fmt.Sprintf("%v", this.Region) + `,`,
+		`AccessKey:` + strings.Replace(fmt.Sprintf("%v", this.AccessKey), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`SecretKey:` + strings.Replace(fmt.Sprintf("%v", this.SecretKey), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`RoleARN:` + fmt.Sprintf("%v", this.RoleARN) + `,`,
+		`Payload:` + repeatedStringForPayload + `,`,
+		`MessageAttributes:` + mapStringForMessageAttributes + `,`,
+		`MessageGroupID:` + fmt.Sprintf("%v", this.MessageGroupID) + `,`,
+		`MessageDeduplicationID:` + fmt.Sprintf("%v", this.MessageDeduplicationID) + `,`,
 		`Parameters:` + repeatedStringForParameters + `,`,
 		`}`,
 	}, "")
@@ -4745,11 +7857,19 @@ func (this *ArgoWorkflowTrigger) String() string {
 		repeatedStringForParameters += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForParameters += "}"
+	repeatedStringForArguments := "[]TriggerParameter{"
+	for _, f := range this.Arguments {
+		repeatedStringForArguments += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForArguments += "}"
 	s := strings.Join([]string{`&ArgoWorkflowTrigger{`,
 		`Source:` + strings.Replace(this.Source.String(), "ArtifactLocation", "ArtifactLocation", 1) + `,`,
 		`Operation:` + fmt.Sprintf("%v", this.Operation) + `,`,
 		`Parameters:` + repeatedStringForParameters + `,`,
 		`GroupVersionResource:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.GroupVersionResource), "GroupVersionResource", "v11.GroupVersionResource", 1), `&`, ``, 1) + `,`,
+		`Arguments:` + repeatedStringForArguments + `,`,
+		`GarbageCollection:` + strings.Replace(this.GarbageCollection.String(), "GarbageCollection", "GarbageCollection", 1) + `,`,
+		`Concurrency:` + strings.Replace(this.Concurrency.String(), "TriggerConcurrency", "TriggerConcurrency", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4781,6 +7901,18 @@ func (this *BasicAuth) String() string {
 	}, "")
 	return s
 }
+func (this *CircuitBreaker) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CircuitBreaker{`,
+		`FailureThreshold:` + fmt.Sprintf("%v", this.FailureThreshold) + `,`,
+		`CoolOffSeconds:` + fmt.Sprintf("%v", this.CoolOffSeconds) + `,`,
+		`DeadLetterTrigger:` + strings.Replace(this.DeadLetterTrigger.String(), "TriggerTemplate", "TriggerTemplate", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *CustomTrigger) String() string {
 	if this == nil {
 		return "nil"
@@ -4841,6 +7973,33 @@ func (this *DependencyGroup) String() string {
 	}, "")
 	return s
 }
+func (this *EmailTrigger) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForParameters := "[]TriggerParameter{"
+	for _, f := range this.Parameters {
+		repeatedStringForParameters += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForParameters += "}"
+	s := strings.Join([]string{`&EmailTrigger{`,
+		`Parameters:` + repeatedStringForParameters + `,`,
+		`Host:` + fmt.Sprintf("%v", this.Host) + `,`,
+		`Port:` + fmt.Sprintf("%v", this.Port) + `,`,
+		`Security:` + fmt.Sprintf("%v", this.Security) + `,`,
+		`TLS:` + strings.Replace(this.TLS.String(), "TLSConfig", "TLSConfig", 1) + `,`,
+		`Username:` + strings.Replace(fmt.Sprintf("%v", this.Username), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`Password:` + strings.Replace(fmt.Sprintf("%v", this.Password), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`From:` + fmt.Sprintf("%v", this.From) + `,`,
+		`To:` + fmt.Sprintf("%v", this.To) + `,`,
+		`Cc:` + fmt.Sprintf("%v", this.Cc) + `,`,
+		`Subject:` + fmt.Sprintf("%v", this.Subject) + `,`,
+		`Body:` + fmt.Sprintf("%v", this.Body) + `,`,
+		`HTML:` + fmt.Sprintf("%v", this.HTML) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Event) String() string {
 	if this == nil {
 		return "nil"
@@ -4852,6 +8011,30 @@ func (this *Event) String() string {
 	}, "")
 	return s
 }
+func (this *EventBusTrigger) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPayload := "[]TriggerParameter{"
+	for _, f := range this.Payload {
+		repeatedStringForPayload += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForPayload += "}"
+	repeatedStringForParameters := "[]TriggerParameter{"
+	for _, f := range this.Parameters {
+		repeatedStringForParameters += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForParameters += "}"
+	s := strings.Join([]string{`&EventBusTrigger{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Source:` + fmt.Sprintf("%v", this.Source) + `,`,
+		`Subject:` + fmt.Sprintf("%v", this.Subject) + `,`,
+		`Payload:` + repeatedStringForPayload + `,`,
+		`Parameters:` + repeatedStringForParameters + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *EventContext) String() string {
 	if this == nil {
 		return "nil"
@@ -4909,6 +8092,56 @@ func (this *FileArtifact) String() string {
 	}, "")
 	return s
 }
+func (this *GCPPubSubTrigger) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPayload := "[]TriggerParameter{"
+	for _, f := range this.Payload {
+		repeatedStringForPayload += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForPayload += "}"
+	repeatedStringForParameters := "[]TriggerParameter{"
+	for _, f := range this.Parameters {
+		repeatedStringForParameters += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForParameters += "}"
+	keysForAttributes := make([]string, 0, len(this.Attributes))
+	for k := range this.Attributes {
+		keysForAttributes = append(keysForAttributes, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAttributes)
+	mapStringForAttributes := "map[string]string{"
+	for _, k := range keysForAttributes {
+		mapStringForAttributes += fmt.Sprintf("%v: %v,", k, this.Attributes[k])
+	}
+	mapStringForAttributes += "}"
+	s := strings.Join([]string{`&GCPPubSubTrigger{`,
+		`ProjectID:` + fmt.Sprintf("%v", this.ProjectID) + `,`,
+		`TopicProjectID:` + fmt.Sprintf("%v", this.TopicProjectID) + `,`,
+		`Topic:` + fmt.Sprintf("%v", this.Topic) + `,`,
+		`CredentialSecret:` + strings.Replace(fmt.Sprintf("%v", this.CredentialSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`Payload:` + repeatedStringForPayload + `,`,
+		`Attributes:` + mapStringForAttributes + `,`,
+		`OrderingKey:` + fmt.Sprintf("%v", this.OrderingKey) + `,`,
+		`Parameters:` + repeatedStringForParameters + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GarbageCollection) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GarbageCollection{`,
+		`OwnerReference:` + fmt.Sprintf("%v", this.OwnerReference) + `,`,
+		`TTLSecondsAfterCompletion:` + valueToStringGenerated(this.TTLSecondsAfterCompletion) + `,`,
+		`CompletionCondition:` + fmt.Sprintf("%v", this.CompletionCondition) + `,`,
+		`Retain:` + fmt.Sprintf("%v", this.Retain) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *GitArtifact) String() string {
 	if this == nil {
 		return "nil"
@@ -4986,6 +8219,30 @@ func (this *HTTPTrigger) String() string {
 	}, "")
 	return s
 }
+func (this *JobTrigger) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForParameters := "[]TriggerParameter{"
+	for _, f := range this.Parameters {
+		repeatedStringForParameters += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForParameters += "}"
+	s := strings.Join([]string{`&JobTrigger{`,
+		`Template:` + strings.Replace(fmt.Sprintf("%v", this.Template), "PodTemplateSpec", "v1.PodTemplateSpec", 1) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`BackoffLimit:` + valueToStringGenerated(this.BackoffLimit) + `,`,
+		`ActiveDeadlineSeconds:` + valueToStringGenerated(this.ActiveDeadlineSeconds) + `,`,
+		`TTLSecondsAfterFinished:` + valueToStringGenerated(this.TTLSecondsAfterFinished) + `,`,
+		`Wait:` + fmt.Sprintf("%v", this.Wait) + `,`,
+		`Timeout:` + fmt.Sprintf("%v", this.Timeout) + `,`,
+		`AllowFailure:` + fmt.Sprintf("%v", this.AllowFailure) + `,`,
+		`LogLines:` + fmt.Sprintf("%v", this.LogLines) + `,`,
+		`Parameters:` + repeatedStringForParameters + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *K8SResourcePolicy) String() string {
 	if this == nil {
 		return "nil"
@@ -5004,6 +8261,9 @@ func (this *K8SResourcePolicy) String() string {
 		`Labels:` + mapStringForLabels + `,`,
 		`Backoff:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Backoff), "Backoff", "common.Backoff", 1), `&`, ``, 1) + `,`,
 		`ErrorOnBackoffTimeout:` + fmt.Sprintf("%v", this.ErrorOnBackoffTimeout) + `,`,
+		`SuccessCondition:` + fmt.Sprintf("%v", this.SuccessCondition) + `,`,
+		`FailureCondition:` + fmt.Sprintf("%v", this.FailureCondition) + `,`,
+		`Timeout:` + fmt.Sprintf("%v", this.Timeout) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5037,6 +8297,55 @@ func (this *KafkaTrigger) String() string {
 	}, "")
 	return s
 }
+func (this *LogTrigger) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPayload := "[]TriggerParameter{"
+	for _, f := range this.Payload {
+		repeatedStringForPayload += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForPayload += "}"
+	repeatedStringForParameters := "[]TriggerParameter{"
+	for _, f := range this.Parameters {
+		repeatedStringForParameters += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForParameters += "}"
+	s := strings.Join([]string{`&LogTrigger{`,
+		`Format:` + fmt.Sprintf("%v", this.Format) + `,`,
+		`Payload:` + repeatedStringForPayload + `,`,
+		`Parameters:` + repeatedStringForParameters + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MQTTTrigger) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPayload := "[]TriggerParameter{"
+	for _, f := range this.Payload {
+		repeatedStringForPayload += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForPayload += "}"
+	repeatedStringForParameters := "[]TriggerParameter{"
+	for _, f := range this.Parameters {
+		repeatedStringForParameters += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForParameters += "}"
+	s := strings.Join([]string{`&MQTTTrigger{`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`Topic:` + fmt.Sprintf("%v", this.Topic) + `,`,
+		`ClientID:` + fmt.Sprintf("%v", this.ClientID) + `,`,
+		`QoS:` + fmt.Sprintf("%v", this.QoS) + `,`,
+		`Retained:` + fmt.Sprintf("%v", this.Retained) + `,`,
+		`TLS:` + strings.Replace(this.TLS.String(), "TLSConfig", "TLSConfig", 1) + `,`,
+		`Payload:` + repeatedStringForPayload + `,`,
+		`Parameters:` + repeatedStringForParameters + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Metadata) String() string {
 	if this == nil {
 		return "nil"
@@ -5092,7 +8401,7 @@ func (this *NATSTrigger) String() string {
 	}, "")
 	return s
 }
-func (this *OpenWhiskTrigger) String() string {
+func (this *ObjectStorageTrigger) String() string {
 	if this == nil {
 		return "nil"
 	}
@@ -5106,39 +8415,130 @@ func (this *OpenWhiskTrigger) String() string {
 		repeatedStringForParameters += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForParameters += "}"
-	s := strings.Join([]string{`&OpenWhiskTrigger{`,
-		`Host:` + fmt.Sprintf("%v", this.Host) + `,`,
-		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
-		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
-		`AuthToken:` + strings.Replace(fmt.Sprintf("%v", this.AuthToken), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
-		`ActionName:` + fmt.Sprintf("%v", this.ActionName) + `,`,
+	s := strings.Join([]string{`&ObjectStorageTrigger{`,
+		`S3:` + strings.Replace(fmt.Sprintf("%v", this.S3), "S3Artifact", "common.S3Artifact", 1) + `,`,
+		`Body:` + fmt.Sprintf("%v", this.Body) + `,`,
+		`ContentType:` + fmt.Sprintf("%v", this.ContentType) + `,`,
 		`Payload:` + repeatedStringForPayload + `,`,
 		`Parameters:` + repeatedStringForParameters + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *Sensor) String() string {
+func (this *OpenWhiskTrigger) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&Sensor{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "SensorSpec", "SensorSpec", 1), `&`, ``, 1) + `,`,
-		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "SensorStatus", "SensorStatus", 1), `&`, ``, 1) + `,`,
+	repeatedStringForPayload := "[]TriggerParameter{"
+	for _, f := range this.Payload {
+		repeatedStringForPayload += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForPayload += "}"
+	repeatedStringForParameters := "[]TriggerParameter{"
+	for _, f := range this.Parameters {
+		repeatedStringForParameters += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForParameters += "}"
+	s := strings.Join([]string{`&OpenWhiskTrigger{`,
+		`Host:` + fmt.Sprintf("%v", this.Host) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`AuthToken:` + strings.Replace(fmt.Sprintf("%v", this.AuthToken), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`ActionName:` + fmt.Sprintf("%v", this.ActionName) + `,`,
+		`Payload:` + repeatedStringForPayload + `,`,
+		`Parameters:` + repeatedStringForParameters + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *SensorList) String() string {
+func (this *PulsarTrigger) String() string {
 	if this == nil {
 		return "nil"
 	}
-	repeatedStringForItems := "[]Sensor{"
-	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Sensor", "Sensor", 1), `&`, ``, 1) + ","
+	repeatedStringForPayload := "[]TriggerParameter{"
+	for _, f := range this.Payload {
+		repeatedStringForPayload += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
 	}
-	repeatedStringForItems += "}"
+	repeatedStringForPayload += "}"
+	repeatedStringForParameters := "[]TriggerParameter{"
+	for _, f := range this.Parameters {
+		repeatedStringForParameters += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForParameters += "}"
+	keysForProperties := make([]string, 0, len(this.Properties))
+	for k := range this.Properties {
+		keysForProperties = append(keysForProperties, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForProperties)
+	mapStringForProperties := "map[string]string{"
+	for _, k := range keysForProperties {
+		mapStringForProperties += fmt.Sprintf("%v: %v,", k, this.Properties[k])
+	}
+	mapStringForProperties += "}"
+	s := strings.Join([]string{`&PulsarTrigger{`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`Topic:` + fmt.Sprintf("%v", this.Topic) + `,`,
+		`TLSTrustCertsFilePath:` + fmt.Sprintf("%v", this.TLSTrustCertsFilePath) + `,`,
+		`TLSAllowInsecureConnection:` + fmt.Sprintf("%v", this.TLSAllowInsecureConnection) + `,`,
+		`TLSValidateHostname:` + fmt.Sprintf("%v", this.TLSValidateHostname) + `,`,
+		`TLS:` + strings.Replace(this.TLS.String(), "TLSConfig", "TLSConfig", 1) + `,`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Properties:` + mapStringForProperties + `,`,
+		`Payload:` + repeatedStringForPayload + `,`,
+		`Parameters:` + repeatedStringForParameters + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RedisTrigger) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPayload := "[]TriggerParameter{"
+	for _, f := range this.Payload {
+		repeatedStringForPayload += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForPayload += "}"
+	repeatedStringForParameters := "[]TriggerParameter{"
+	for _, f := range this.Parameters {
+		repeatedStringForParameters += strings.Replace(strings.Replace(f.String(), "TriggerParameter", "TriggerParameter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForParameters += "}"
+	s := strings.Join([]string{`&RedisTrigger{`,
+		`HostAddress:` + fmt.Sprintf("%v", this.HostAddress) + `,`,
+		`Password:` + strings.Replace(fmt.Sprintf("%v", this.Password), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`DB:` + fmt.Sprintf("%v", this.DB) + `,`,
+		`TLS:` + strings.Replace(this.TLS.String(), "TLSConfig", "TLSConfig", 1) + `,`,
+		`Channel:` + fmt.Sprintf("%v", this.Channel) + `,`,
+		`Stream:` + fmt.Sprintf("%v", this.Stream) + `,`,
+		`MaxStreamLength:` + fmt.Sprintf("%v", this.MaxStreamLength) + `,`,
+		`Payload:` + repeatedStringForPayload + `,`,
+		`Parameters:` + repeatedStringForParameters + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Sensor) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Sensor{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "SensorSpec", "SensorSpec", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "SensorStatus", "SensorStatus", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SensorList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]Sensor{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Sensor", "Sensor", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
 	s := strings.Join([]string{`&SensorList{`,
 		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
 		`Items:` + repeatedStringForItems + `,`,
@@ -5173,6 +8573,11 @@ func (this *SensorSpec) String() string {
 		`DependencyGroups:` + repeatedStringForDependencyGroups + `,`,
 		`ErrorOnFailedRound:` + fmt.Sprintf("%v", this.ErrorOnFailedRound) + `,`,
 		`EventBusName:` + fmt.Sprintf("%v", this.EventBusName) + `,`,
+		`MaxConcurrentTriggers:` + fmt.Sprintf("%v", this.MaxConcurrentTriggers) + `,`,
+		`ParallelTriggers:` + fmt.Sprintf("%v", this.ParallelTriggers) + `,`,
+		`DryRun:` + fmt.Sprintf("%v", this.DryRun) + `,`,
+		`Suspend:` + fmt.Sprintf("%v", this.Suspend) + `,`,
+		`SuspendBufferSize:` + fmt.Sprintf("%v", this.SuspendBufferSize) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5181,8 +8586,19 @@ func (this *SensorStatus) String() string {
 	if this == nil {
 		return "nil"
 	}
+	keysForTriggerStatuses := make([]string, 0, len(this.TriggerStatuses))
+	for k := range this.TriggerStatuses {
+		keysForTriggerStatuses = append(keysForTriggerStatuses, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForTriggerStatuses)
+	mapStringForTriggerStatuses := "map[string]TriggerStatus{"
+	for _, k := range keysForTriggerStatuses {
+		mapStringForTriggerStatuses += fmt.Sprintf("%v: %v,", k, this.TriggerStatuses[k])
+	}
+	mapStringForTriggerStatuses += "}"
 	s := strings.Join([]string{`&SensorStatus{`,
 		`Status:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Status), "Status", "common.Status", 1), `&`, ``, 1) + `,`,
+		`TriggerStatuses:` + mapStringForTriggerStatuses + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5221,6 +8637,11 @@ func (this *StandardK8STrigger) String() string {
 		`Parameters:` + repeatedStringForParameters + `,`,
 		`PatchStrategy:` + fmt.Sprintf("%v", this.PatchStrategy) + `,`,
 		`LiveObject:` + fmt.Sprintf("%v", this.LiveObject) + `,`,
+		`LabelSelector:` + fmt.Sprintf("%v", this.LabelSelector) + `,`,
+		`FieldManager:` + fmt.Sprintf("%v", this.FieldManager) + `,`,
+		`ForceConflicts:` + fmt.Sprintf("%v", this.ForceConflicts) + `,`,
+		`GarbageCollection:` + strings.Replace(this.GarbageCollection.String(), "GarbageCollection", "GarbageCollection", 1) + `,`,
+		`Concurrency:` + strings.Replace(this.Concurrency.String(), "TriggerConcurrency", "TriggerConcurrency", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5307,6 +8728,22 @@ func (this *Trigger) String() string {
 		`Template:` + strings.Replace(this.Template.String(), "TriggerTemplate", "TriggerTemplate", 1) + `,`,
 		`Parameters:` + repeatedStringForParameters + `,`,
 		`Policy:` + strings.Replace(this.Policy.String(), "TriggerPolicy", "TriggerPolicy", 1) + `,`,
+		`RetryStrategy:` + strings.Replace(fmt.Sprintf("%v", this.RetryStrategy), "Backoff", "common.Backoff", 1) + `,`,
+		`CircuitBreaker:` + strings.Replace(this.CircuitBreaker.String(), "CircuitBreaker", "CircuitBreaker", 1) + `,`,
+		`When:` + fmt.Sprintf("%v", this.When) + `,`,
+		`DryRun:` + fmt.Sprintf("%v", this.DryRun) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TriggerConcurrency) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TriggerConcurrency{`,
+		`Policy:` + fmt.Sprintf("%v", this.Policy) + `,`,
+		`CompletionCondition:` + fmt.Sprintf("%v", this.CompletionCondition) + `,`,
+		`CorrelationKey:` + strings.Replace(this.CorrelationKey.String(), "TriggerParameterSource", "TriggerParameterSource", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5349,6 +8786,22 @@ func (this *TriggerPolicy) String() string {
 	}, "")
 	return s
 }
+func (this *TriggerStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TriggerStatus{`,
+		`Fired:` + fmt.Sprintf("%v", this.Fired) + `,`,
+		`Succeeded:` + fmt.Sprintf("%v", this.Succeeded) + `,`,
+		`Failed:` + fmt.Sprintf("%v", this.Failed) + `,`,
+		`LastExecutionTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LastExecutionTime), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`LastError:` + fmt.Sprintf("%v", this.LastError) + `,`,
+		`LastEventIDs:` + fmt.Sprintf("%v", this.LastEventIDs) + `,`,
+		`TimedOut:` + fmt.Sprintf("%v", this.TimedOut) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *TriggerSwitch) String() string {
 	if this == nil {
 		return "nil"
@@ -5376,6 +8829,18 @@ func (this *TriggerTemplate) String() string {
 		`NATS:` + strings.Replace(this.NATS.String(), "NATSTrigger", "NATSTrigger", 1) + `,`,
 		`Slack:` + strings.Replace(this.Slack.String(), "SlackTrigger", "SlackTrigger", 1) + `,`,
 		`OpenWhisk:` + strings.Replace(this.OpenWhisk.String(), "OpenWhiskTrigger", "OpenWhiskTrigger", 1) + `,`,
+		`Email:` + strings.Replace(this.Email.String(), "EmailTrigger", "EmailTrigger", 1) + `,`,
+		`AWSSQS:` + strings.Replace(this.AWSSQS.String(), "AWSSQSTrigger", "AWSSQSTrigger", 1) + `,`,
+		`AWSSNS:` + strings.Replace(this.AWSSNS.String(), "AWSSNSTrigger", "AWSSNSTrigger", 1) + `,`,
+		`GCPPubSub:` + strings.Replace(this.GCPPubSub.String(), "GCPPubSubTrigger", "GCPPubSubTrigger", 1) + `,`,
+		`AMQP:` + strings.Replace(this.AMQP.String(), "AMQPTrigger", "AMQPTrigger", 1) + `,`,
+		`MQTT:` + strings.Replace(this.MQTT.String(), "MQTTTrigger", "MQTTTrigger", 1) + `,`,
+		`Pulsar:` + strings.Replace(this.Pulsar.String(), "PulsarTrigger", "PulsarTrigger", 1) + `,`,
+		`Redis:` + strings.Replace(this.Redis.String(), "RedisTrigger", "RedisTrigger", 1) + `,`,
+		`EventBus:` + strings.Replace(this.EventBus.String(), "EventBusTrigger", "EventBusTrigger", 1) + `,`,
+		`Log:` + strings.Replace(this.Log.String(), "LogTrigger", "LogTrigger", 1) + `,`,
+		`ObjectStorage:` + strings.Replace(this.ObjectStorage.String(), "ObjectStorageTrigger", "ObjectStorageTrigger", 1) + `,`,
+		`Job:` + strings.Replace(this.Job.String(), "JobTrigger", "JobTrigger", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5399,7 +8864,7 @@ func valueToStringGenerated(v interface{}) string {
 	pv := reflect.Indirect(rv).Interface()
 	return fmt.Sprintf("*%v", pv)
 }
-func (m *AWSLambdaTrigger) Unmarshal(dAtA []byte) error {
+func (m *AMQPTrigger) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5422,15 +8887,15 @@ func (m *AWSLambdaTrigger) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AWSLambdaTrigger: wiretype end group for non-group")
+			return fmt.Errorf("proto: AMQPTrigger: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AWSLambdaTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AMQPTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FunctionName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5458,13 +8923,13 @@ func (m *AWSLambdaTrigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FunctionName = string(dAtA[iNdEx:postIndex])
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5474,33 +8939,29 @@ func (m *AWSLambdaTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AccessKey == nil {
-				m.AccessKey = &v1.SecretKeySelector{}
-			}
-			if err := m.AccessKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ExchangeName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RoutingKey", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5510,33 +8971,29 @@ func (m *AWSLambdaTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SecretKey == nil {
-				m.SecretKey = &v1.SecretKeySelector{}
-			}
-			if err := m.SecretKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RoutingKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5546,29 +9003,124 @@ func (m *AWSLambdaTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Region = string(dAtA[iNdEx:postIndex])
+			if m.Headers == nil {
+				m.Headers = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Headers[mapkey] = mapvalue
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5578,31 +9130,29 @@ func (m *AWSLambdaTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Payload = append(m.Payload, TriggerParameter{})
-			if err := m.Payload[len(m.Payload)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ContentType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Persistent", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5612,84 +9162,17 @@ func (m *AWSLambdaTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Persistent = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublisherConfirms", wireType)
 			}
-			m.Parameters = append(m.Parameters, TriggerParameter{})
-			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ArgoWorkflowTrigger) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ArgoWorkflowTrigger: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArgoWorkflowTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
-			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5699,33 +9182,17 @@ func (m *ArgoWorkflowTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Source == nil {
-				m.Source = &ArtifactLocation{}
-			}
-			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
+			m.PublisherConfirms = bool(v != 0)
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5735,27 +9202,31 @@ func (m *ArgoWorkflowTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operation = ArgoWorkflowOperation(dAtA[iNdEx:postIndex])
+			if m.TLS == nil {
+				m.TLS = &TLSConfig{}
+			}
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5782,14 +9253,14 @@ func (m *ArgoWorkflowTrigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Parameters = append(m.Parameters, TriggerParameter{})
-			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Payload = append(m.Payload, TriggerParameter{})
+			if err := m.Payload[len(m.Payload)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupVersionResource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5816,7 +9287,8 @@ func (m *ArgoWorkflowTrigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GroupVersionResource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Parameters = append(m.Parameters, TriggerParameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5844,7 +9316,7 @@ func (m *ArgoWorkflowTrigger) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
+func (m *AWSLambdaTrigger) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5867,51 +9339,15 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ArtifactLocation: wiretype end group for non-group")
+			return fmt.Errorf("proto: AWSLambdaTrigger: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArtifactLocation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AWSLambdaTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field S3", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.S3 == nil {
-				m.S3 = &common.S3Artifact{}
-			}
-			if err := m.S3.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FunctionName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5939,12 +9375,11 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Inline = &s
+			m.FunctionName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessKey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5971,16 +9406,16 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.File == nil {
-				m.File = &FileArtifact{}
+			if m.AccessKey == nil {
+				m.AccessKey = &v1.SecretKeySelector{}
 			}
-			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AccessKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretKey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6007,18 +9442,18 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.URL == nil {
-				m.URL = &URLArtifact{}
+			if m.SecretKey == nil {
+				m.SecretKey = &v1.SecretKeySelector{}
 			}
-			if err := m.URL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SecretKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Configmap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6028,31 +9463,27 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Configmap == nil {
-				m.Configmap = &v1.ConfigMapKeySelector{}
-			}
-			if err := m.Configmap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Region = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Git", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6079,16 +9510,14 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Git == nil {
-				m.Git = &GitArtifact{}
-			}
-			if err := m.Git.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Payload = append(m.Payload, TriggerParameter{})
+			if err := m.Payload[len(m.Payload)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6115,10 +9544,8 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Resource == nil {
-				m.Resource = &common.Resource{}
-			}
-			if err := m.Resource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Parameters = append(m.Parameters, TriggerParameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6146,7 +9573,7 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BasicAuth) Unmarshal(dAtA []byte) error {
+func (m *AWSSNSTrigger) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6169,17 +9596,17 @@ func (m *BasicAuth) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BasicAuth: wiretype end group for non-group")
+			return fmt.Errorf("proto: AWSSNSTrigger: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BasicAuth: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AWSSNSTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicArn", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6189,33 +9616,29 @@ func (m *BasicAuth) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Username == nil {
-				m.Username = &v1.SecretKeySelector{}
-			}
-			if err := m.Username.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TopicArn = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6225,86 +9648,65 @@ func (m *BasicAuth) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Password == nil {
-				m.Password = &v1.SecretKeySelector{}
-			}
-			if err := m.Password.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Region = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessKey", wireType)
 			}
-			if skippy < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CustomTrigger) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.AccessKey == nil {
+				m.AccessKey = &v1.SecretKeySelector{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.AccessKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CustomTrigger: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CustomTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServerURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretKey", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6314,29 +9716,33 @@ func (m *CustomTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServerURL = string(dAtA[iNdEx:postIndex])
+			if m.SecretKey == nil {
+				m.SecretKey = &v1.SecretKeySelector{}
+			}
+			if err := m.SecretKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Secure", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleARN", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6346,17 +9752,29 @@ func (m *CustomTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Secure = bool(v != 0)
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoleARN = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CertFilePath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6366,27 +9784,29 @@ func (m *CustomTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CertFilePath = string(dAtA[iNdEx:postIndex])
+			m.Payload = append(m.Payload, TriggerParameter{})
+			if err := m.Payload[len(m.Payload)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServerNameOverride", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6414,11 +9834,11 @@ func (m *CustomTrigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServerNameOverride = string(dAtA[iNdEx:postIndex])
+			m.Subject = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageAttributes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6445,8 +9865,8 @@ func (m *CustomTrigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Spec == nil {
-				m.Spec = make(map[string]string)
+			if m.MessageAttributes == nil {
+				m.MessageAttributes = make(map[string]string)
 			}
 			var mapkey string
 			var mapvalue string
@@ -6541,13 +9961,13 @@ func (m *CustomTrigger) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Spec[mapkey] = mapvalue
+			m.MessageAttributes[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 6:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageGroupID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6557,29 +9977,59 @@ func (m *CustomTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Parameters = append(m.Parameters, TriggerParameter{})
-			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.MessageGroupID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageDeduplicationID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.MessageDeduplicationID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6606,8 +10056,8 @@ func (m *CustomTrigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Payload = append(m.Payload, TriggerParameter{})
-			if err := m.Payload[len(m.Payload)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Parameters = append(m.Parameters, TriggerParameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6635,7 +10085,7 @@ func (m *CustomTrigger) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DataFilter) Unmarshal(dAtA []byte) error {
+func (m *AWSSQSTrigger) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6658,15 +10108,15 @@ func (m *DataFilter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DataFilter: wiretype end group for non-group")
+			return fmt.Errorf("proto: AWSSQSTrigger: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DataFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AWSSQSTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6694,11 +10144,11 @@ func (m *DataFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.Queue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueAccountID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6726,11 +10176,11 @@ func (m *DataFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = JSONType(dAtA[iNdEx:postIndex])
+			m.QueueAccountID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6758,13 +10208,13 @@ func (m *DataFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value, string(dAtA[iNdEx:postIndex]))
+			m.Region = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Comparator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessKey", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6774,80 +10224,67 @@ func (m *DataFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Comparator = Comparator(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if m.AccessKey == nil {
+				m.AccessKey = &v1.SecretKeySelector{}
+			}
+			if err := m.AccessKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretKey", wireType)
 			}
-			if (iNdEx + skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DependencyGroup) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.SecretKey == nil {
+				m.SecretKey = &v1.SecretKeySelector{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DependencyGroup: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DependencyGroup: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.SecretKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleARN", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6875,13 +10312,13 @@ func (m *DependencyGroup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.RoleARN = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dependencies", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6891,80 +10328,29 @@ func (m *DependencyGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Dependencies = append(m.Dependencies, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			m.Payload = append(m.Payload, TriggerParameter{})
+			if err := m.Payload[len(m.Payload)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Event) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Event: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Event: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageAttributes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6991,18 +10377,109 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Context == nil {
-				m.Context = &EventContext{}
+			if m.MessageAttributes == nil {
+				m.MessageAttributes = make(map[string]string)
 			}
-			if err := m.Context.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.MessageAttributes[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageGroupID", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7012,51 +10489,115 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
+			m.MessageGroupID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageDeduplicationID", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageDeduplicationID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parameters = append(m.Parameters, TriggerParameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *EventContext) Unmarshal(dAtA []byte) error {
+func (m *ArgoWorkflowTrigger) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7079,17 +10620,17 @@ func (m *EventContext) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventContext: wiretype end group for non-group")
+			return fmt.Errorf("proto: ArgoWorkflowTrigger: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventContext: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ArgoWorkflowTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7099,27 +10640,31 @@ func (m *EventContext) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			if m.Source == nil {
+				m.Source = &ArtifactLocation{}
+			}
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7147,13 +10692,13 @@ func (m *EventContext) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Source = string(dAtA[iNdEx:postIndex])
+			m.Operation = ArgoWorkflowOperation(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7163,29 +10708,31 @@ func (m *EventContext) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpecVersion = string(dAtA[iNdEx:postIndex])
+			m.Parameters = append(m.Parameters, TriggerParameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupVersionResource", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7195,29 +10742,30 @@ func (m *EventContext) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			if err := m.GroupVersionResource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataContentType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Arguments", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7227,29 +10775,31 @@ func (m *EventContext) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DataContentType = string(dAtA[iNdEx:postIndex])
+			m.Arguments = append(m.Arguments, TriggerParameter{})
+			if err := m.Arguments[len(m.Arguments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GarbageCollection", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7259,27 +10809,31 @@ func (m *EventContext) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Subject = string(dAtA[iNdEx:postIndex])
+			if m.GarbageCollection == nil {
+				m.GarbageCollection = &GarbageCollection{}
+			}
+			if err := m.GarbageCollection.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Concurrency", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7306,7 +10860,10 @@ func (m *EventContext) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Time.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Concurrency == nil {
+				m.Concurrency = &TriggerConcurrency{}
+			}
+			if err := m.Concurrency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7334,7 +10891,7 @@ func (m *EventContext) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventDependency) Unmarshal(dAtA []byte) error {
+func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7357,17 +10914,17 @@ func (m *EventDependency) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventDependency: wiretype end group for non-group")
+			return fmt.Errorf("proto: ArtifactLocation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDependency: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ArtifactLocation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field S3", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7377,27 +10934,31 @@ func (m *EventDependency) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.S3 == nil {
+				m.S3 = &common.S3Artifact{}
+			}
+			if err := m.S3.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventSourceName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Inline", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7425,13 +10986,14 @@ func (m *EventDependency) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EventSourceName = string(dAtA[iNdEx:postIndex])
+			s := string(dAtA[iNdEx:postIndex])
+			m.Inline = &s
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7441,27 +11003,31 @@ func (m *EventDependency) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EventName = string(dAtA[iNdEx:postIndex])
+			if m.File == nil {
+				m.File = &FileArtifact{}
+			}
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7488,71 +11054,54 @@ func (m *EventDependency) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Filters == nil {
-				m.Filters = &EventDependencyFilter{}
+			if m.URL == nil {
+				m.URL = &URLArtifact{}
 			}
-			if err := m.Filters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.URL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Configmap", wireType)
 			}
-			if skippy < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventDependencyFilter) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Configmap == nil {
+				m.Configmap = &v1.ConfigMapKeySelector{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Configmap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventDependencyFilter: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDependencyFilter: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Git", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7562,27 +11111,31 @@ func (m *EventDependencyFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.Git == nil {
+				m.Git = &GitArtifact{}
+			}
+			if err := m.Git.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7609,16 +11162,69 @@ func (m *EventDependencyFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Time == nil {
-				m.Time = &TimeFilter{}
+			if m.Resource == nil {
+				m.Resource = &common.Resource{}
 			}
-			if err := m.Time.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Resource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BasicAuth) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BasicAuth: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BasicAuth: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7645,16 +11251,16 @@ func (m *EventDependencyFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Context == nil {
-				m.Context = &EventContext{}
+			if m.Username == nil {
+				m.Username = &v1.SecretKeySelector{}
 			}
-			if err := m.Context.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Username.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7681,8 +11287,10 @@ func (m *EventDependencyFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data, DataFilter{})
-			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Password == nil {
+				m.Password = &v1.SecretKeySelector{}
+			}
+			if err := m.Password.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7710,7 +11318,7 @@ func (m *EventDependencyFilter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FileArtifact) Unmarshal(dAtA []byte) error {
+func (m *CircuitBreaker) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7733,17 +11341,55 @@ func (m *FileArtifact) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FileArtifact: wiretype end group for non-group")
+			return fmt.Errorf("proto: CircuitBreaker: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FileArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CircuitBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureThreshold", wireType)
+			}
+			m.FailureThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailureThreshold |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoolOffSeconds", wireType)
+			}
+			m.CoolOffSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CoolOffSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadLetterTrigger", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7753,23 +11399,27 @@ func (m *FileArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			if m.DeadLetterTrigger == nil {
+				m.DeadLetterTrigger = &TriggerTemplate{}
+			}
+			if err := m.DeadLetterTrigger.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7795,7 +11445,7 @@ func (m *FileArtifact) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GitArtifact) Unmarshal(dAtA []byte) error {
+func (m *CustomTrigger) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7818,15 +11468,15 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GitArtifact: wiretype end group for non-group")
+			return fmt.Errorf("proto: CustomTrigger: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GitArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CustomTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerURL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7854,13 +11504,13 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			m.ServerURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CloneDirectory", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secure", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7870,29 +11520,17 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CloneDirectory = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Secure = bool(v != 0)
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CertFilePath", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7902,31 +11540,27 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Creds == nil {
-				m.Creds = &GitCreds{}
-			}
-			if err := m.Creds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CertFilePath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SSHKeyPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerNameOverride", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7954,13 +11588,13 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SSHKeyPath = string(dAtA[iNdEx:postIndex])
+			m.ServerNameOverride = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FilePath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7970,61 +11604,124 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FilePath = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+			if m.Spec == nil {
+				m.Spec = make(map[string]string)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Branch = string(dAtA[iNdEx:postIndex])
+			m.Spec[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 7:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8034,59 +11731,29 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tag = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ref", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Parameters = append(m.Parameters, TriggerParameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Ref = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Remote", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8113,10 +11780,8 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Remote == nil {
-				m.Remote = &GitRemoteConfig{}
-			}
-			if err := m.Remote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Payload = append(m.Payload, TriggerParameter{})
+			if err := m.Payload[len(m.Payload)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8144,7 +11809,7 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GitCreds) Unmarshal(dAtA []byte) error {
+func (m *DataFilter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8167,17 +11832,17 @@ func (m *GitCreds) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GitCreds: wiretype end group for non-group")
+			return fmt.Errorf("proto: DataFilter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GitCreds: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DataFilter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8187,33 +11852,29 @@ func (m *GitCreds) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Username == nil {
-				m.Username = &v1.SecretKeySelector{}
-			}
-			if err := m.Username.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8223,45 +11884,105 @@ func (m *GitCreds) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Password == nil {
-				m.Password = &v1.SecretKeySelector{}
-			}
-			if err := m.Password.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Type = JSONType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			if skippy < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
+			m.Value = append(m.Value, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Comparator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Comparator = Comparator(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
 
 	if iNdEx > l {
@@ -8269,7 +11990,7 @@ func (m *GitCreds) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GitRemoteConfig) Unmarshal(dAtA []byte) error {
+func (m *DependencyGroup) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8292,10 +12013,10 @@ func (m *GitRemoteConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GitRemoteConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: DependencyGroup: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GitRemoteConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DependencyGroup: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8332,7 +12053,7 @@ func (m *GitRemoteConfig) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URLS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dependencies", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8360,7 +12081,7 @@ func (m *GitRemoteConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URLS = append(m.URLS, string(dAtA[iNdEx:postIndex]))
+			m.Dependencies = append(m.Dependencies, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8386,7 +12107,7 @@ func (m *GitRemoteConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HTTPTrigger) Unmarshal(dAtA []byte) error {
+func (m *EmailTrigger) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8409,15 +12130,49 @@ func (m *HTTPTrigger) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HTTPTrigger: wiretype end group for non-group")
+			return fmt.Errorf("proto: EmailTrigger: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HTTPTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EmailTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parameters = append(m.Parameters, TriggerParameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8445,13 +12200,32 @@ func (m *HTTPTrigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			m.Host = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			m.Port = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Port |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Security", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8461,27 +12235,25 @@ func (m *HTTPTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Payload = append(m.Payload, TriggerParameter{})
-			if err := m.Payload[len(m.Payload)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Security = EmailSecurity(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
 			}
@@ -8517,11 +12289,11 @@ func (m *HTTPTrigger) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8531,27 +12303,31 @@ func (m *HTTPTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Method = string(dAtA[iNdEx:postIndex])
+			if m.Username == nil {
+				m.Username = &v1.SecretKeySelector{}
+			}
+			if err := m.Username.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8578,16 +12354,18 @@ func (m *HTTPTrigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Parameters = append(m.Parameters, TriggerParameter{})
-			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Password == nil {
+				m.Password = &v1.SecretKeySelector{}
+			}
+			if err := m.Password.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
-			m.Timeout = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8597,16 +12375,29 @@ func (m *HTTPTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timeout |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BasicAuth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8616,33 +12407,61 @@ func (m *HTTPTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BasicAuth == nil {
-				m.BasicAuth = &BasicAuth{}
+			m.To = append(m.To, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cc", wireType)
 			}
-			if err := m.BasicAuth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cc = append(m.Cc, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 8:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8652,118 +12471,87 @@ func (m *HTTPTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Headers == nil {
-				m.Headers = make(map[string]string)
+			m.Subject = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Headers[mapkey] = mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Body = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HTML", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HTML = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8789,7 +12577,7 @@ func (m *HTTPTrigger) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *K8SResourcePolicy) Unmarshal(dAtA []byte) error {
+func (m *Event) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8812,15 +12600,15 @@ func (m *K8SResourcePolicy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: K8SResourcePolicy: wiretype end group for non-group")
+			return fmt.Errorf("proto: Event: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: K8SResourcePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Event: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8847,109 +12635,18 @@ func (m *K8SResourcePolicy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
+			if m.Context == nil {
+				m.Context = &EventContext{}
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.Context.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Backoff", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8959,45 +12656,26 @@ func (m *K8SResourcePolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Backoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ErrorOnBackoffTimeout", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
-			m.ErrorOnBackoffTimeout = bool(v != 0)
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -9022,7 +12700,7 @@ func (m *K8SResourcePolicy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KafkaTrigger) Unmarshal(dAtA []byte) error {
+func (m *EventBusTrigger) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9045,15 +12723,15 @@ func (m *KafkaTrigger) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KafkaTrigger: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventBusTrigger: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KafkaTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventBusTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9081,11 +12759,11 @@ func (m *KafkaTrigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9113,13 +12791,13 @@ func (m *KafkaTrigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Topic = string(dAtA[iNdEx:postIndex])
+			m.Source = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
 			}
-			m.Partition = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9129,14 +12807,27 @@ func (m *KafkaTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Partition |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subject = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9163,16 +12854,16 @@ func (m *KafkaTrigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Parameters = append(m.Parameters, TriggerParameter{})
-			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Payload = append(m.Payload, TriggerParameter{})
+			if err := m.Payload[len(m.Payload)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequiredAcks", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
 			}
-			m.RequiredAcks = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9182,16 +12873,84 @@ func (m *KafkaTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RequiredAcks |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Compress", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parameters = append(m.Parameters, TriggerParameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventContext) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventContext: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventContext: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9201,17 +12960,29 @@ func (m *KafkaTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Compress = bool(v != 0)
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FlushFrequency", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.FlushFrequency = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9221,16 +12992,29 @@ func (m *KafkaTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FlushFrequency |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecVersion", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9240,33 +13024,29 @@ func (m *KafkaTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TLS == nil {
-				m.TLS = &TLSConfig{}
-			}
-			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SpecVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9276,29 +13056,27 @@ func (m *KafkaTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Payload = append(m.Payload, TriggerParameter{})
-			if err := m.Payload[len(m.Payload)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PartitioningKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataContentType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9326,18 +13104,83 @@ func (m *KafkaTrigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PartitioningKey = string(dAtA[iNdEx:postIndex])
+			m.DataContentType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subject = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Time.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if (iNdEx + skippy) > l {
@@ -9352,7 +13195,7 @@ func (m *KafkaTrigger) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Metadata) Unmarshal(dAtA []byte) error {
+func (m *EventDependency) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9375,15 +13218,111 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Metadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventDependency: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventDependency: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventSourceName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventSourceName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9410,39 +13349,4576 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
+			if m.Filters == nil {
+				m.Filters = &EventDependencyFilter{}
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if err := m.Filters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDependencyFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDependencyFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDependencyFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Time == nil {
+				m.Time = &TimeFilter{}
+			}
+			if err := m.Time.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Context == nil {
+				m.Context = &EventContext{}
+			}
+			if err := m.Context.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data, DataFilter{})
+			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FileArtifact) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FileArtifact: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FileArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GCPPubSubTrigger) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GCPPubSubTrigger: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GCPPubSubTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProjectID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopicProjectID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopicProjectID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topic = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialSecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CredentialSecret == nil {
+				m.CredentialSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.CredentialSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload, TriggerParameter{})
+			if err := m.Payload[len(m.Payload)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Attributes == nil {
+				m.Attributes = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Attributes[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderingKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderingKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parameters = append(m.Parameters, TriggerParameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GarbageCollection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GarbageCollection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GarbageCollection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerReference", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OwnerReference = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TTLSecondsAfterCompletion", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TTLSecondsAfterCompletion = &v
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionCondition", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompletionCondition = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retain", wireType)
+			}
+			m.Retain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Retain |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GitArtifact) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GitArtifact: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GitArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CloneDirectory", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CloneDirectory = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Creds == nil {
+				m.Creds = &GitCreds{}
+			}
+			if err := m.Creds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SSHKeyPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SSHKeyPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FilePath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FilePath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Branch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ref", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ref = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remote", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Remote == nil {
+				m.Remote = &GitRemoteConfig{}
+			}
+			if err := m.Remote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GitCreds) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GitCreds: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GitCreds: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Username == nil {
+				m.Username = &v1.SecretKeySelector{}
+			}
+			if err := m.Username.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Password == nil {
+				m.Password = &v1.SecretKeySelector{}
+			}
+			if err := m.Password.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GitRemoteConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GitRemoteConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GitRemoteConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URLS", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URLS = append(m.URLS, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HTTPTrigger) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HTTPTrigger: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HTTPTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload, TriggerParameter{})
+			if err := m.Payload[len(m.Payload)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TLS == nil {
+				m.TLS = &TLSConfig{}
+			}
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parameters = append(m.Parameters, TriggerParameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasicAuth", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BasicAuth == nil {
+				m.BasicAuth = &BasicAuth{}
+			}
+			if err := m.BasicAuth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Headers[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobTrigger) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobTrigger: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Template == nil {
+				m.Template = &v1.PodTemplateSpec{}
+			}
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackoffLimit", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BackoffLimit = &v
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveDeadlineSeconds", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ActiveDeadlineSeconds = &v
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TTLSecondsAfterFinished", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TTLSecondsAfterFinished = &v
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wait", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Wait = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowFailure", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowFailure = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogLines", wireType)
+			}
+			m.LogLines = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogLines |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parameters = append(m.Parameters, TriggerParameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *K8SResourcePolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: K8SResourcePolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: K8SResourcePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Backoff", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Backoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorOnBackoffTimeout", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ErrorOnBackoffTimeout = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessCondition", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SuccessCondition = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureCondition", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailureCondition = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KafkaTrigger) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KafkaTrigger: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KafkaTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topic = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+			}
+			m.Partition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Partition |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parameters = append(m.Parameters, TriggerParameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredAcks", wireType)
+			}
+			m.RequiredAcks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequiredAcks |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compress", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Compress = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlushFrequency", wireType)
+			}
+			m.FlushFrequency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FlushFrequency |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TLS == nil {
+				m.TLS = &TLSConfig{}
+			}
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload, TriggerParameter{})
+			if err := m.Payload[len(m.Payload)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitioningKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PartitioningKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LogTrigger) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LogTrigger: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LogTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Format = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload, TriggerParameter{})
+			if err := m.Payload[len(m.Payload)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parameters = append(m.Parameters, TriggerParameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MQTTTrigger) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MQTTTrigger: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MQTTTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topic = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QoS", wireType)
+			}
+			m.QoS = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QoS |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retained", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Retained = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TLS == nil {
+				m.TLS = &TLSConfig{}
+			}
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload, TriggerParameter{})
+			if err := m.Payload[len(m.Payload)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parameters = append(m.Parameters, TriggerParameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Metadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Metadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Annotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NATSTrigger) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NATSTrigger: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NATSTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subject = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload, TriggerParameter{})
+			if err := m.Payload[len(m.Payload)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parameters = append(m.Parameters, TriggerParameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TLS == nil {
+				m.TLS = &TLSConfig{}
+			}
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ObjectStorageTrigger) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ObjectStorageTrigger: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ObjectStorageTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field S3", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.S3 == nil {
+				m.S3 = &common.S3Artifact{}
+			}
+			if err := m.S3.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Body = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload, TriggerParameter{})
+			if err := m.Payload[len(m.Payload)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parameters = append(m.Parameters, TriggerParameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OpenWhiskTrigger) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OpenWhiskTrigger: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OpenWhiskTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Host = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthToken", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AuthToken == nil {
+				m.AuthToken = &v1.SecretKeySelector{}
+			}
+			if err := m.AuthToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActionName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload, TriggerParameter{})
+			if err := m.Payload[len(m.Payload)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parameters = append(m.Parameters, TriggerParameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PulsarTrigger) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PulsarTrigger: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PulsarTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topic = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSTrustCertsFilePath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TLSTrustCertsFilePath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSAllowInsecureConnection", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TLSAllowInsecureConnection = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSValidateHostname", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TLSValidateHostname = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TLS == nil {
+				m.TLS = &TLSConfig{}
+			}
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Properties", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Properties == nil {
+				m.Properties = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
 						iNdEx++
 						stringLenmapkey |= uint64(b&0x7F) << shift
 						if b < 0x80 {
@@ -9506,11 +17982,1134 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Annotations[mapkey] = mapvalue
+			m.Properties[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload, TriggerParameter{})
+			if err := m.Payload[len(m.Payload)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parameters = append(m.Parameters, TriggerParameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RedisTrigger) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RedisTrigger: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RedisTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Password == nil {
+				m.Password = &v1.SecretKeySelector{}
+			}
+			if err := m.Password.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DB", wireType)
+			}
+			m.DB = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DB |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TLS == nil {
+				m.TLS = &TLSConfig{}
+			}
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stream = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStreamLength", wireType)
+			}
+			m.MaxStreamLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxStreamLength |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload, TriggerParameter{})
+			if err := m.Payload[len(m.Payload)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parameters = append(m.Parameters, TriggerParameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Sensor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Sensor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Sensor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SensorList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SensorList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SensorList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, Sensor{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SensorSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SensorSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SensorSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dependencies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dependencies = append(m.Dependencies, EventDependency{})
+			if err := m.Dependencies[len(m.Dependencies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Triggers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Triggers = append(m.Triggers, Trigger{})
+			if err := m.Triggers[len(m.Triggers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Circuit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Circuit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DependencyGroups", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DependencyGroups = append(m.DependencyGroups, DependencyGroup{})
+			if err := m.DependencyGroups[len(m.DependencyGroups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorOnFailedRound", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ErrorOnFailedRound = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventBusName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventBusName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxConcurrentTriggers", wireType)
+			}
+			m.MaxConcurrentTriggers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxConcurrentTriggers |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParallelTriggers", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ParallelTriggers = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Suspend", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Suspend = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuspendBufferSize", wireType)
+			}
+			m.SuspendBufferSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SuspendBufferSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SensorStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SensorStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SensorStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerStatuses", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9537,11 +19136,11 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
+			if m.TriggerStatuses == nil {
+				m.TriggerStatuses = make(map[string]TriggerStatus)
 			}
 			var mapkey string
-			var mapvalue string
+			mapvalue := &TriggerStatus{}
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -9590,7 +19189,7 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
+					var mapmsglen int
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowGenerated
@@ -9600,24 +19199,26 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
+						mapmsglen |= int(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
+					if mapmsglen < 0 {
 						return ErrInvalidLengthGenerated
 					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
 						return ErrInvalidLengthGenerated
 					}
-					if postStringIndexmapvalue > l {
+					if postmsgIndex > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
+					mapvalue = &TriggerStatus{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -9633,7 +19234,7 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Labels[mapkey] = mapvalue
+			m.TriggerStatuses[mapkey] = *mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9659,7 +19260,7 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NATSTrigger) Unmarshal(dAtA []byte) error {
+func (m *SlackTrigger) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9682,17 +19283,17 @@ func (m *NATSTrigger) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NATSTrigger: wiretype end group for non-group")
+			return fmt.Errorf("proto: SlackTrigger: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NATSTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SlackTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9702,59 +19303,29 @@ func (m *NATSTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Parameters = append(m.Parameters, TriggerParameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Subject = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlackToken", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9781,16 +19352,18 @@ func (m *NATSTrigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Payload = append(m.Payload, TriggerParameter{})
-			if err := m.Payload[len(m.Payload)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.SlackToken == nil {
+				m.SlackToken = &v1.SecretKeySelector{}
+			}
+			if err := m.SlackToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9800,31 +19373,29 @@ func (m *NATSTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Parameters = append(m.Parameters, TriggerParameter{})
-			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9834,27 +19405,23 @@ func (m *NATSTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TLS == nil {
-				m.TLS = &TLSConfig{}
-			}
-			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9880,7 +19447,7 @@ func (m *NATSTrigger) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OpenWhiskTrigger) Unmarshal(dAtA []byte) error {
+func (m *StandardK8STrigger) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9903,15 +19470,84 @@ func (m *OpenWhiskTrigger) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OpenWhiskTrigger: wiretype end group for non-group")
+			return fmt.Errorf("proto: StandardK8STrigger: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OpenWhiskTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StandardK8STrigger: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupVersionResource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GroupVersionResource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Source == nil {
+				m.Source = &ArtifactLocation{}
+			}
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9939,13 +19575,13 @@ func (m *OpenWhiskTrigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Host = string(dAtA[iNdEx:postIndex])
+			m.Operation = KubernetesResourceOperation(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9955,27 +19591,29 @@ func (m *OpenWhiskTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.Parameters = append(m.Parameters, TriggerParameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PatchStrategy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10003,13 +19641,33 @@ func (m *OpenWhiskTrigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.PatchStrategy = k8s_io_apimachinery_pkg_types.PatchType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiveObject", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LiveObject = bool(v != 0)
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10019,31 +19677,27 @@ func (m *OpenWhiskTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AuthToken == nil {
-				m.AuthToken = &v1.SecretKeySelector{}
-			}
-			if err := m.AuthToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.LabelSelector = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActionName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldManager", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10071,11 +19725,31 @@ func (m *OpenWhiskTrigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActionName = string(dAtA[iNdEx:postIndex])
+			m.FieldManager = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceConflicts", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ForceConflicts = bool(v != 0)
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GarbageCollection", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10102,14 +19776,16 @@ func (m *OpenWhiskTrigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Payload = append(m.Payload, TriggerParameter{})
-			if err := m.Payload[len(m.Payload)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.GarbageCollection == nil {
+				m.GarbageCollection = &GarbageCollection{}
+			}
+			if err := m.GarbageCollection.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Concurrency", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10136,8 +19812,10 @@ func (m *OpenWhiskTrigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Parameters = append(m.Parameters, TriggerParameter{})
-			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Concurrency == nil {
+				m.Concurrency = &TriggerConcurrency{}
+			}
+			if err := m.Concurrency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10165,7 +19843,7 @@ func (m *OpenWhiskTrigger) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Sensor) Unmarshal(dAtA []byte) error {
+func (m *StatusPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10188,111 +19866,88 @@ func (m *Sensor) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Sensor: wiretype end group for non-group")
+			return fmt.Errorf("proto: StatusPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Sensor: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StatusPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+				m.Allow = append(m.Allow, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				if packedLen < 0 {
+					return ErrInvalidLengthGenerated
 				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenerated
 				}
-				if iNdEx >= l {
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+				elementCount = count
+				if elementCount != 0 && len(m.Allow) == 0 {
+					m.Allow = make([]int32, 0, elementCount)
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Allow = append(m.Allow, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allow", wireType)
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -10317,7 +19972,7 @@ func (m *Sensor) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SensorList) Unmarshal(dAtA []byte) error {
+func (m *TLSConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10340,17 +19995,17 @@ func (m *SensorList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SensorList: wiretype end group for non-group")
+			return fmt.Errorf("proto: TLSConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SensorList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TLSConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CACertPath", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10360,30 +20015,29 @@ func (m *SensorList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CACertPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientCertPath", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10393,25 +20047,55 @@ func (m *SensorList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Sensor{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ClientCertPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientKeyPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.ClientKeyPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10437,7 +20121,7 @@ func (m *SensorList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SensorSpec) Unmarshal(dAtA []byte) error {
+func (m *Template) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10460,15 +20144,15 @@ func (m *SensorSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SensorSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: Template: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SensorSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Template: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dependencies", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10495,16 +20179,15 @@ func (m *SensorSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Dependencies = append(m.Dependencies, EventDependency{})
-			if err := m.Dependencies[len(m.Dependencies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Triggers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10514,29 +20197,27 @@ func (m *SensorSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Triggers = append(m.Triggers, Trigger{})
-			if err := m.Triggers[len(m.Triggers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ServiceAccountName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10563,15 +20244,18 @@ func (m *SensorSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Container == nil {
+				m.Container = &v1.Container{}
+			}
+			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Circuit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Volumes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10581,27 +20265,29 @@ func (m *SensorSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Circuit = string(dAtA[iNdEx:postIndex])
+			m.Volumes = append(m.Volumes, v1.Volume{})
+			if err := m.Volumes[len(m.Volumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DependencyGroups", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContext", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10628,16 +20314,18 @@ func (m *SensorSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DependencyGroups = append(m.DependencyGroups, DependencyGroup{})
-			if err := m.DependencyGroups[len(m.DependencyGroups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.SecurityContext == nil {
+				m.SecurityContext = &v1.PodSecurityContext{}
+			}
+			if err := m.SecurityContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ErrorOnFailedRound", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeSelector", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10647,17 +20335,124 @@ func (m *SensorSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ErrorOnFailedRound = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NodeSelector == nil {
+				m.NodeSelector = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.NodeSelector[mapkey] = mapvalue
+			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventBusName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tolerations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10667,23 +20462,25 @@ func (m *SensorSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EventBusName = string(dAtA[iNdEx:postIndex])
+			m.Tolerations = append(m.Tolerations, v1.Toleration{})
+			if err := m.Tolerations[len(m.Tolerations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10709,7 +20506,7 @@ func (m *SensorSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SensorStatus) Unmarshal(dAtA []byte) error {
+func (m *TimeFilter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10732,17 +20529,17 @@ func (m *SensorStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SensorStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: TimeFilter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SensorStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TimeFilter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10752,24 +20549,55 @@ func (m *SensorStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Start = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stop", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Stop = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10795,7 +20623,7 @@ func (m *SensorStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SlackTrigger) Unmarshal(dAtA []byte) error {
+func (m *Trigger) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10818,15 +20646,15 @@ func (m *SlackTrigger) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SlackTrigger: wiretype end group for non-group")
+			return fmt.Errorf("proto: Trigger: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SlackTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Trigger: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10853,14 +20681,16 @@ func (m *SlackTrigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Parameters = append(m.Parameters, TriggerParameter{})
-			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Template == nil {
+				m.Template = &TriggerTemplate{}
+			}
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlackToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10887,18 +20717,16 @@ func (m *SlackTrigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SlackToken == nil {
-				m.SlackToken = &v1.SecretKeySelector{}
-			}
-			if err := m.SlackToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Parameters = append(m.Parameters, TriggerParameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10908,29 +20736,33 @@ func (m *SlackTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Channel = string(dAtA[iNdEx:postIndex])
+			if m.Policy == nil {
+				m.Policy = &TriggerPolicy{}
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryStrategy", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10940,80 +20772,31 @@ func (m *SlackTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *StandardK8STrigger) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.RetryStrategy == nil {
+				m.RetryStrategy = &common.Backoff{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.RetryStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: StandardK8STrigger: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StandardK8STrigger: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupVersionResource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreaker", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11040,15 +20823,18 @@ func (m *StandardK8STrigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GroupVersionResource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.CircuitBreaker == nil {
+				m.CircuitBreaker = &CircuitBreaker{}
+			}
+			if err := m.CircuitBreaker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field When", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11058,33 +20844,29 @@ func (m *StandardK8STrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Source == nil {
-				m.Source = &ArtifactLocation{}
-			}
-			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.When = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11094,29 +20876,70 @@ func (m *StandardK8STrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			m.DryRun = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operation = KubernetesResourceOperation(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TriggerConcurrency) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TriggerConcurrency: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TriggerConcurrency: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11126,29 +20949,27 @@ func (m *StandardK8STrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Parameters = append(m.Parameters, TriggerParameter{})
-			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Policy = ConcurrencyPolicy(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PatchStrategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionCondition", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11176,13 +20997,13 @@ func (m *StandardK8STrigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PatchStrategy = k8s_io_apimachinery_pkg_types.PatchType(dAtA[iNdEx:postIndex])
+			m.CompletionCondition = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiveObject", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorrelationKey", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11192,141 +21013,28 @@ func (m *StandardK8STrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.LiveObject = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *StatusPolicy) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.CorrelationKey == nil {
+				m.CorrelationKey = &TriggerParameterSource{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: StatusPolicy: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StatusPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType == 0 {
-				var v int32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Allow = append(m.Allow, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthGenerated
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthGenerated
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Allow) == 0 {
-					m.Allow = make([]int32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Allow = append(m.Allow, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allow", wireType)
+			if err := m.CorrelationKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -11351,7 +21059,7 @@ func (m *StatusPolicy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TLSConfig) Unmarshal(dAtA []byte) error {
+func (m *TriggerParameter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11374,17 +21082,17 @@ func (m *TLSConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TLSConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: TriggerParameter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TLSConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TriggerParameter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CACertPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Src", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11394,27 +21102,31 @@ func (m *TLSConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CACertPath = string(dAtA[iNdEx:postIndex])
+			if m.Src == nil {
+				m.Src = &TriggerParameterSource{}
+			}
+			if err := m.Src.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientCertPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dest", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11442,11 +21154,11 @@ func (m *TLSConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientCertPath = string(dAtA[iNdEx:postIndex])
+			m.Dest = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientKeyPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11474,7 +21186,7 @@ func (m *TLSConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientKeyPath = string(dAtA[iNdEx:postIndex])
+			m.Operation = TriggerParameterOperation(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11500,7 +21212,7 @@ func (m *TLSConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Template) Unmarshal(dAtA []byte) error {
+func (m *TriggerParameterSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11523,17 +21235,17 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Template: wiretype end group for non-group")
+			return fmt.Errorf("proto: TriggerParameterSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Template: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TriggerParameterSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DependencyName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11543,28 +21255,27 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DependencyName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContextKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11592,49 +21303,13 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServiceAccountName = string(dAtA[iNdEx:postIndex])
+			m.ContextKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Container == nil {
-				m.Container = &v1.Container{}
-			}
-			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Volumes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContextTemplate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11644,31 +21319,29 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Volumes = append(m.Volumes, v1.Volume{})
-			if err := m.Volumes[len(m.Volumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContextTemplate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContext", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataKey", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11678,33 +21351,29 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SecurityContext == nil {
-				m.SecurityContext = &v1.PodSecurityContext{}
-			}
-			if err := m.SecurityContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DataKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeSelector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataTemplate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11714,124 +21383,29 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NodeSelector == nil {
-				m.NodeSelector = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.NodeSelector[mapkey] = mapvalue
+			m.DataTemplate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tolerations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11841,25 +21415,24 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tolerations = append(m.Tolerations, v1.Toleration{})
-			if err := m.Tolerations[len(m.Tolerations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Value = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11885,7 +21458,7 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TimeFilter) Unmarshal(dAtA []byte) error {
+func (m *TriggerPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11908,17 +21481,17 @@ func (m *TimeFilter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TimeFilter: wiretype end group for non-group")
+			return fmt.Errorf("proto: TriggerPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TimeFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TriggerPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field K8s", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11928,29 +21501,33 @@ func (m *TimeFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Start = string(dAtA[iNdEx:postIndex])
+			if m.K8s == nil {
+				m.K8s = &K8SResourcePolicy{}
+			}
+			if err := m.K8s.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stop", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11960,23 +21537,27 @@ func (m *TimeFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Stop = string(dAtA[iNdEx:postIndex])
+			if m.Status == nil {
+				m.Status = &StatusPolicy{}
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12002,7 +21583,7 @@ func (m *TimeFilter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Trigger) Unmarshal(dAtA []byte) error {
+func (m *TriggerStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12025,15 +21606,72 @@ func (m *Trigger) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Trigger: wiretype end group for non-group")
+			return fmt.Errorf("proto: TriggerStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Trigger: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TriggerStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fired", wireType)
+			}
+			m.Fired = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Fired |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Succeeded", wireType)
+			}
+			m.Succeeded = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Succeeded |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			}
+			m.Failed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Failed |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastExecutionTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12060,18 +21698,15 @@ func (m *Trigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Template == nil {
-				m.Template = &TriggerTemplate{}
-			}
-			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastExecutionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12081,31 +21716,29 @@ func (m *Trigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Parameters = append(m.Parameters, TriggerParameter{})
-			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.LastError = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEventIDs", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12115,28 +21748,43 @@ func (m *Trigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Policy == nil {
-				m.Policy = &TriggerPolicy{}
+			m.LastEventIDs = append(m.LastEventIDs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimedOut", wireType)
 			}
-			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.TimedOut = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimedOut |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -12161,7 +21809,7 @@ func (m *Trigger) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TriggerParameter) Unmarshal(dAtA []byte) error {
+func (m *TriggerSwitch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12184,51 +21832,15 @@ func (m *TriggerParameter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TriggerParameter: wiretype end group for non-group")
+			return fmt.Errorf("proto: TriggerSwitch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TriggerParameter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TriggerSwitch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Src", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Src == nil {
-				m.Src = &TriggerParameterSource{}
-			}
-			if err := m.Src.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Any", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12256,11 +21868,11 @@ func (m *TriggerParameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Dest = string(dAtA[iNdEx:postIndex])
+			m.Any = append(m.Any, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field All", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12288,7 +21900,7 @@ func (m *TriggerParameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operation = TriggerParameterOperation(dAtA[iNdEx:postIndex])
+			m.All = append(m.All, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12314,7 +21926,7 @@ func (m *TriggerParameter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TriggerParameterSource) Unmarshal(dAtA []byte) error {
+func (m *TriggerTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12337,15 +21949,15 @@ func (m *TriggerParameterSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TriggerParameterSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: TriggerTemplate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TriggerParameterSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TriggerTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DependencyName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12373,13 +21985,13 @@ func (m *TriggerParameterSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DependencyName = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContextKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Switch", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12389,29 +22001,33 @@ func (m *TriggerParameterSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContextKey = string(dAtA[iNdEx:postIndex])
+			if m.Switch == nil {
+				m.Switch = &TriggerSwitch{}
+			}
+			if err := m.Switch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContextTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field K8s", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12421,29 +22037,33 @@ func (m *TriggerParameterSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContextTemplate = string(dAtA[iNdEx:postIndex])
+			if m.K8s == nil {
+				m.K8s = &StandardK8STrigger{}
+			}
+			if err := m.K8s.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ArgoWorkflow", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12453,29 +22073,33 @@ func (m *TriggerParameterSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DataKey = string(dAtA[iNdEx:postIndex])
+			if m.ArgoWorkflow == nil {
+				m.ArgoWorkflow = &ArgoWorkflowTrigger{}
+			}
+			if err := m.ArgoWorkflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HTTP", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12485,29 +22109,33 @@ func (m *TriggerParameterSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DataTemplate = string(dAtA[iNdEx:postIndex])
+			if m.HTTP == nil {
+				m.HTTP = &HTTPTrigger{}
+			}
+			if err := m.HTTP.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AWSLambda", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12517,81 +22145,67 @@ func (m *TriggerParameterSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Value = &s
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if m.AWSLambda == nil {
+				m.AWSLambda = &AWSLambdaTrigger{}
+			}
+			if err := m.AWSLambda.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomTrigger", wireType)
 			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TriggerPolicy) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.CustomTrigger == nil {
+				m.CustomTrigger = &CustomTrigger{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TriggerPolicy: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TriggerPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.CustomTrigger.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field K8s", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kafka", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12618,16 +22232,16 @@ func (m *TriggerPolicy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.K8s == nil {
-				m.K8s = &K8SResourcePolicy{}
+			if m.Kafka == nil {
+				m.Kafka = &KafkaTrigger{}
 			}
-			if err := m.K8s.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Kafka.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NATS", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12654,71 +22268,54 @@ func (m *TriggerPolicy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Status == nil {
-				m.Status = &StatusPolicy{}
+			if m.NATS == nil {
+				m.NATS = &NATSTrigger{}
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NATS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slack", wireType)
 			}
-			if skippy < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TriggerSwitch) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Slack == nil {
+				m.Slack = &SlackTrigger{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Slack.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TriggerSwitch: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TriggerSwitch: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Any", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenWhisk", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12728,29 +22325,33 @@ func (m *TriggerSwitch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Any = append(m.Any, string(dAtA[iNdEx:postIndex]))
+			if m.OpenWhisk == nil {
+				m.OpenWhisk = &OpenWhiskTrigger{}
+			}
+			if err := m.OpenWhisk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field All", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12760,82 +22361,33 @@ func (m *TriggerSwitch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.All = append(m.All, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TriggerTemplate) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Email == nil {
+				m.Email = &EmailTrigger{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Email.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TriggerTemplate: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TriggerTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AWSSQS", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12845,27 +22397,31 @@ func (m *TriggerTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.AWSSQS == nil {
+				m.AWSSQS = &AWSSQSTrigger{}
+			}
+			if err := m.AWSSQS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Switch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AWSSNS", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12892,16 +22448,16 @@ func (m *TriggerTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Switch == nil {
-				m.Switch = &TriggerSwitch{}
+			if m.AWSSNS == nil {
+				m.AWSSNS = &AWSSNSTrigger{}
 			}
-			if err := m.Switch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AWSSNS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field K8s", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GCPPubSub", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12928,16 +22484,16 @@ func (m *TriggerTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.K8s == nil {
-				m.K8s = &StandardK8STrigger{}
+			if m.GCPPubSub == nil {
+				m.GCPPubSub = &GCPPubSubTrigger{}
 			}
-			if err := m.K8s.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GCPPubSub.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ArgoWorkflow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AMQP", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12964,16 +22520,16 @@ func (m *TriggerTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ArgoWorkflow == nil {
-				m.ArgoWorkflow = &ArgoWorkflowTrigger{}
+			if m.AMQP == nil {
+				m.AMQP = &AMQPTrigger{}
 			}
-			if err := m.ArgoWorkflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AMQP.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HTTP", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MQTT", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13000,16 +22556,16 @@ func (m *TriggerTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HTTP == nil {
-				m.HTTP = &HTTPTrigger{}
+			if m.MQTT == nil {
+				m.MQTT = &MQTTTrigger{}
 			}
-			if err := m.HTTP.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MQTT.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AWSLambda", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pulsar", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13036,16 +22592,16 @@ func (m *TriggerTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AWSLambda == nil {
-				m.AWSLambda = &AWSLambdaTrigger{}
+			if m.Pulsar == nil {
+				m.Pulsar = &PulsarTrigger{}
 			}
-			if err := m.AWSLambda.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pulsar.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CustomTrigger", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Redis", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13072,16 +22628,16 @@ func (m *TriggerTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CustomTrigger == nil {
-				m.CustomTrigger = &CustomTrigger{}
+			if m.Redis == nil {
+				m.Redis = &RedisTrigger{}
 			}
-			if err := m.CustomTrigger.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Redis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kafka", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventBus", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13108,16 +22664,16 @@ func (m *TriggerTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Kafka == nil {
-				m.Kafka = &KafkaTrigger{}
+			if m.EventBus == nil {
+				m.EventBus = &EventBusTrigger{}
 			}
-			if err := m.Kafka.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EventBus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NATS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13144,16 +22700,16 @@ func (m *TriggerTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NATS == nil {
-				m.NATS = &NATSTrigger{}
+			if m.Log == nil {
+				m.Log = &LogTrigger{}
 			}
-			if err := m.NATS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Log.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slack", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectStorage", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13180,16 +22736,16 @@ func (m *TriggerTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Slack == nil {
-				m.Slack = &SlackTrigger{}
+			if m.ObjectStorage == nil {
+				m.ObjectStorage = &ObjectStorageTrigger{}
 			}
-			if err := m.Slack.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectStorage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OpenWhisk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13216,10 +22772,10 @@ func (m *TriggerTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OpenWhisk == nil {
-				m.OpenWhisk = &OpenWhiskTrigger{}
+			if m.Job == nil {
+				m.Job = &JobTrigger{}
 			}
-			if err := m.OpenWhisk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -26,6 +26,48 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AMQPTrigger) DeepCopyInto(out *AMQPTrigger) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(TLSConfig)
+		**out = **in
+	}
+	if in.Payload != nil {
+		in, out := &in.Payload, &out.Payload
+		*out = make([]TriggerParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]TriggerParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AMQPTrigger.
+func (in *AMQPTrigger) DeepCopy() *AMQPTrigger {
+	if in == nil {
+		return nil
+	}
+	out := new(AMQPTrigger)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AWSLambdaTrigger) DeepCopyInto(out *AWSLambdaTrigger) {
 	*out = *in
@@ -66,6 +108,100 @@ func (in *AWSLambdaTrigger) DeepCopy() *AWSLambdaTrigger {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSSNSTrigger) DeepCopyInto(out *AWSSNSTrigger) {
+	*out = *in
+	if in.AccessKey != nil {
+		in, out := &in.AccessKey, &out.AccessKey
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecretKey != nil {
+		in, out := &in.SecretKey, &out.SecretKey
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Payload != nil {
+		in, out := &in.Payload, &out.Payload
+		*out = make([]TriggerParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MessageAttributes != nil {
+		in, out := &in.MessageAttributes, &out.MessageAttributes
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]TriggerParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSSNSTrigger.
+func (in *AWSSNSTrigger) DeepCopy() *AWSSNSTrigger {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSSNSTrigger)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSSQSTrigger) DeepCopyInto(out *AWSSQSTrigger) {
+	*out = *in
+	if in.AccessKey != nil {
+		in, out := &in.AccessKey, &out.AccessKey
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecretKey != nil {
+		in, out := &in.SecretKey, &out.SecretKey
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Payload != nil {
+		in, out := &in.Payload, &out.Payload
+		*out = make([]TriggerParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MessageAttributes != nil {
+		in, out := &in.MessageAttributes, &out.MessageAttributes
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]TriggerParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSSQSTrigger.
+func (in *AWSSQSTrigger) DeepCopy() *AWSSQSTrigger {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSSQSTrigger)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArgoWorkflowTrigger) DeepCopyInto(out *ArgoWorkflowTrigger) {
 	*out = *in
@@ -82,6 +218,23 @@ func (in *ArgoWorkflowTrigger) DeepCopyInto(out *ArgoWorkflowTrigger) {
 		}
 	}
 	out.GroupVersionResource = in.GroupVersionResource
+	if in.Arguments != nil {
+		in, out := &in.Arguments, &out.Arguments
+		*out = make([]TriggerParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.GarbageCollection != nil {
+		in, out := &in.GarbageCollection, &out.GarbageCollection
+		*out = new(GarbageCollection)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Concurrency != nil {
+		in, out := &in.Concurrency, &out.Concurrency
+		*out = new(TriggerConcurrency)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -172,6 +325,27 @@ func (in *BasicAuth) DeepCopy() *BasicAuth {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CircuitBreaker) DeepCopyInto(out *CircuitBreaker) {
+	*out = *in
+	if in.DeadLetterTrigger != nil {
+		in, out := &in.DeadLetterTrigger, &out.DeadLetterTrigger
+		*out = new(TriggerTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CircuitBreaker.
+func (in *CircuitBreaker) DeepCopy() *CircuitBreaker {
+	if in == nil {
+		return nil
+	}
+	out := new(CircuitBreaker)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomTrigger) DeepCopyInto(out *CustomTrigger) {
 	*out = *in
@@ -251,6 +425,54 @@ func (in *DependencyGroup) DeepCopy() *DependencyGroup {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EmailTrigger) DeepCopyInto(out *EmailTrigger) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]TriggerParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(TLSConfig)
+		**out = **in
+	}
+	if in.Username != nil {
+		in, out := &in.Username, &out.Username
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Password != nil {
+		in, out := &in.Password, &out.Password
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.To != nil {
+		in, out := &in.To, &out.To
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Cc != nil {
+		in, out := &in.Cc, &out.Cc
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EmailTrigger.
+func (in *EmailTrigger) DeepCopy() *EmailTrigger {
+	if in == nil {
+		return nil
+	}
+	out := new(EmailTrigger)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Event) DeepCopyInto(out *Event) {
 	*out = *in
@@ -277,6 +499,36 @@ func (in *Event) DeepCopy() *Event {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventBusTrigger) DeepCopyInto(out *EventBusTrigger) {
+	*out = *in
+	if in.Payload != nil {
+		in, out := &in.Payload, &out.Payload
+		*out = make([]TriggerParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]TriggerParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventBusTrigger.
+func (in *EventBusTrigger) DeepCopy() *EventBusTrigger {
+	if in == nil {
+		return nil
+	}
+	out := new(EventBusTrigger)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EventContext) DeepCopyInto(out *EventContext) {
 	*out = *in
@@ -364,6 +616,69 @@ func (in *FileArtifact) DeepCopy() *FileArtifact {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPPubSubTrigger) DeepCopyInto(out *GCPPubSubTrigger) {
+	*out = *in
+	if in.CredentialSecret != nil {
+		in, out := &in.CredentialSecret, &out.CredentialSecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Payload != nil {
+		in, out := &in.Payload, &out.Payload
+		*out = make([]TriggerParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Attributes != nil {
+		in, out := &in.Attributes, &out.Attributes
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]TriggerParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPPubSubTrigger.
+func (in *GCPPubSubTrigger) DeepCopy() *GCPPubSubTrigger {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPPubSubTrigger)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GarbageCollection) DeepCopyInto(out *GarbageCollection) {
+	*out = *in
+	if in.TTLSecondsAfterCompletion != nil {
+		in, out := &in.TTLSecondsAfterCompletion, &out.TTLSecondsAfterCompletion
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GarbageCollection.
+func (in *GarbageCollection) DeepCopy() *GarbageCollection {
+	if in == nil {
+		return nil
+	}
+	out := new(GarbageCollection)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GitArtifact) DeepCopyInto(out *GitArtifact) {
 	*out = *in
@@ -484,6 +799,49 @@ func (in *HTTPTrigger) DeepCopy() *HTTPTrigger {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JobTrigger) DeepCopyInto(out *JobTrigger) {
+	*out = *in
+	if in.Template != nil {
+		in, out := &in.Template, &out.Template
+		*out = new(v1.PodTemplateSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BackoffLimit != nil {
+		in, out := &in.BackoffLimit, &out.BackoffLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ActiveDeadlineSeconds != nil {
+		in, out := &in.ActiveDeadlineSeconds, &out.ActiveDeadlineSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.TTLSecondsAfterFinished != nil {
+		in, out := &in.TTLSecondsAfterFinished, &out.TTLSecondsAfterFinished
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]TriggerParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobTrigger.
+func (in *JobTrigger) DeepCopy() *JobTrigger {
+	if in == nil {
+		return nil
+	}
+	out := new(JobTrigger)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *K8SResourcePolicy) DeepCopyInto(out *K8SResourcePolicy) {
 	*out = *in
@@ -543,6 +901,71 @@ func (in *KafkaTrigger) DeepCopy() *KafkaTrigger {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogTrigger) DeepCopyInto(out *LogTrigger) {
+	*out = *in
+	if in.Payload != nil {
+		in, out := &in.Payload, &out.Payload
+		*out = make([]TriggerParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]TriggerParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogTrigger.
+func (in *LogTrigger) DeepCopy() *LogTrigger {
+	if in == nil {
+		return nil
+	}
+	out := new(LogTrigger)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MQTTTrigger) DeepCopyInto(out *MQTTTrigger) {
+	*out = *in
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(TLSConfig)
+		**out = **in
+	}
+	if in.Payload != nil {
+		in, out := &in.Payload, &out.Payload
+		*out = make([]TriggerParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]TriggerParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MQTTTrigger.
+func (in *MQTTTrigger) DeepCopy() *MQTTTrigger {
+	if in == nil {
+		return nil
+	}
+	out := new(MQTTTrigger)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Metadata) DeepCopyInto(out *Metadata) {
 	*out = *in
@@ -608,6 +1031,41 @@ func (in *NATSTrigger) DeepCopy() *NATSTrigger {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectStorageTrigger) DeepCopyInto(out *ObjectStorageTrigger) {
+	*out = *in
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = new(common.S3Artifact)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Payload != nil {
+		in, out := &in.Payload, &out.Payload
+		*out = make([]TriggerParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]TriggerParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectStorageTrigger.
+func (in *ObjectStorageTrigger) DeepCopy() *ObjectStorageTrigger {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectStorageTrigger)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OpenWhiskTrigger) DeepCopyInto(out *OpenWhiskTrigger) {
 	*out = *in
@@ -643,6 +1101,88 @@ func (in *OpenWhiskTrigger) DeepCopy() *OpenWhiskTrigger {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PulsarTrigger) DeepCopyInto(out *PulsarTrigger) {
+	*out = *in
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(TLSConfig)
+		**out = **in
+	}
+	if in.Properties != nil {
+		in, out := &in.Properties, &out.Properties
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Payload != nil {
+		in, out := &in.Payload, &out.Payload
+		*out = make([]TriggerParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]TriggerParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PulsarTrigger.
+func (in *PulsarTrigger) DeepCopy() *PulsarTrigger {
+	if in == nil {
+		return nil
+	}
+	out := new(PulsarTrigger)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisTrigger) DeepCopyInto(out *RedisTrigger) {
+	*out = *in
+	if in.Password != nil {
+		in, out := &in.Password, &out.Password
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(TLSConfig)
+		**out = **in
+	}
+	if in.Payload != nil {
+		in, out := &in.Payload, &out.Payload
+		*out = make([]TriggerParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]TriggerParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisTrigger.
+func (in *RedisTrigger) DeepCopy() *RedisTrigger {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisTrigger)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Sensor) DeepCopyInto(out *Sensor) {
 	*out = *in
@@ -746,6 +1286,13 @@ func (in *SensorSpec) DeepCopy() *SensorSpec {
 func (in *SensorStatus) DeepCopyInto(out *SensorStatus) {
 	*out = *in
 	in.Status.DeepCopyInto(&out.Status)
+	if in.TriggerStatuses != nil {
+		in, out := &in.TriggerStatuses, &out.TriggerStatuses
+		*out = make(map[string]TriggerStatus, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 	return
 }
 
@@ -803,6 +1350,16 @@ func (in *StandardK8STrigger) DeepCopyInto(out *StandardK8STrigger) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.GarbageCollection != nil {
+		in, out := &in.GarbageCollection, &out.GarbageCollection
+		*out = new(GarbageCollection)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Concurrency != nil {
+		in, out := &in.Concurrency, &out.Concurrency
+		*out = new(TriggerConcurrency)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -937,6 +1494,16 @@ func (in *Trigger) DeepCopyInto(out *Trigger) {
 		*out = new(TriggerPolicy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RetryStrategy != nil {
+		in, out := &in.RetryStrategy, &out.RetryStrategy
+		*out = new(common.Backoff)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CircuitBreaker != nil {
+		in, out := &in.CircuitBreaker, &out.CircuitBreaker
+		*out = new(CircuitBreaker)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -950,6 +1517,27 @@ func (in *Trigger) DeepCopy() *Trigger {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TriggerConcurrency) DeepCopyInto(out *TriggerConcurrency) {
+	*out = *in
+	if in.CorrelationKey != nil {
+		in, out := &in.CorrelationKey, &out.CorrelationKey
+		*out = new(TriggerParameterSource)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TriggerConcurrency.
+func (in *TriggerConcurrency) DeepCopy() *TriggerConcurrency {
+	if in == nil {
+		return nil
+	}
+	out := new(TriggerConcurrency)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TriggerParameter) DeepCopyInto(out *TriggerParameter) {
 	*out = *in
@@ -1018,6 +1606,28 @@ func (in *TriggerPolicy) DeepCopy() *TriggerPolicy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TriggerStatus) DeepCopyInto(out *TriggerStatus) {
+	*out = *in
+	in.LastExecutionTime.DeepCopyInto(&out.LastExecutionTime)
+	if in.LastEventIDs != nil {
+		in, out := &in.LastEventIDs, &out.LastEventIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TriggerStatus.
+func (in *TriggerStatus) DeepCopy() *TriggerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TriggerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TriggerSwitch) DeepCopyInto(out *TriggerSwitch) {
 	*out = *in
@@ -1097,6 +1707,66 @@ func (in *TriggerTemplate) DeepCopyInto(out *TriggerTemplate) {
 		*out = new(OpenWhiskTrigger)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Email != nil {
+		in, out := &in.Email, &out.Email
+		*out = new(EmailTrigger)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AWSSQS != nil {
+		in, out := &in.AWSSQS, &out.AWSSQS
+		*out = new(AWSSQSTrigger)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AWSSNS != nil {
+		in, out := &in.AWSSNS, &out.AWSSNS
+		*out = new(AWSSNSTrigger)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GCPPubSub != nil {
+		in, out := &in.GCPPubSub, &out.GCPPubSub
+		*out = new(GCPPubSubTrigger)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AMQP != nil {
+		in, out := &in.AMQP, &out.AMQP
+		*out = new(AMQPTrigger)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MQTT != nil {
+		in, out := &in.MQTT, &out.MQTT
+		*out = new(MQTTTrigger)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Pulsar != nil {
+		in, out := &in.Pulsar, &out.Pulsar
+		*out = new(PulsarTrigger)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Redis != nil {
+		in, out := &in.Redis, &out.Redis
+		*out = new(RedisTrigger)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EventBus != nil {
+		in, out := &in.EventBus, &out.EventBus
+		*out = new(EventBusTrigger)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Log != nil {
+		in, out := &in.Log, &out.Log
+		*out = new(LogTrigger)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ObjectStorage != nil {
+		in, out := &in.ObjectStorage, &out.ObjectStorage
+		*out = new(ObjectStorageTrigger)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Job != nil {
+		in, out := &in.Job, &out.Job
+		*out = new(JobTrigger)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
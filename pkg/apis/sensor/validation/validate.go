@@ -0,0 +1,1160 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/Knetic/govaluate"
+	"github.com/Masterminds/sprig"
+	"github.com/antonmedv/expr"
+	"github.com/antonmedv/expr/ast"
+	"github.com/antonmedv/expr/parser"
+	"github.com/argoproj/argo-events/common"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// ValidateSensor accepts a sensor and performs validation against it
+// we return an error so that it can be logged as a message on the sensor status
+// the error is ignored by the operation context as subsequent re-queues would produce the same error.
+// Exporting this function so that external APIs can use this to validate sensor resource.
+func ValidateSensor(s *v1alpha1.Sensor) error {
+	if err := validateDependencies(s.Spec.Dependencies); err != nil {
+		s.Status.MarkDependenciesNotProvided("InvalidDependencies", "Faild to validate dependencies.")
+		return err
+	}
+	if s.Spec.DependencyGroups != nil {
+		if s.Spec.Circuit == "" {
+			s.Status.MarkDependenciesNotProvided("InvalidCircuit", "Circuit is empty.")
+			return errors.Errorf("no circuit expression provided to resolve dependency groups")
+		}
+		c := strings.ReplaceAll(s.Spec.Circuit, "-", "\\-")
+		expression, err := govaluate.NewEvaluableExpression(c)
+		if err != nil {
+			s.Status.MarkDependenciesNotProvided("InvalidCircuit", "Invalid circurit expression.")
+			return errors.Errorf("circuit expression can't be created for dependency groups. err: %+v", err)
+		}
+
+		groups := make(map[string]interface{}, len(s.Spec.DependencyGroups))
+		for _, group := range s.Spec.DependencyGroups {
+			groups[group.Name] = false
+		}
+		if _, err = expression.Evaluate(groups); err != nil {
+			s.Status.MarkDependenciesNotProvided("InvalidCircuit", "Circuit expression can not be evaluated for dependency groups.")
+			return errors.Errorf("circuit expression can't be evaluated for dependency groups. err: %+v", err)
+		}
+	}
+	s.Status.MarkDependenciesProvided()
+	err := validateTriggers(s.Spec.Triggers)
+	if err != nil {
+		s.Status.MarkTriggersNotProvided("InvalidTriggers", "Invalid triggers.")
+		return err
+	}
+	if err := validateEventBusTriggers(s); err != nil {
+		s.Status.MarkTriggersNotProvided("InvalidTriggers", "Invalid triggers.")
+		return err
+	}
+	if err := validateJobTriggers(s); err != nil {
+		s.Status.MarkTriggersNotProvided("InvalidTriggers", "Invalid triggers.")
+		return err
+	}
+	if err := validateParallelTriggers(s); err != nil {
+		s.Status.MarkTriggersNotProvided("InvalidParallelTriggers", "Parallel triggers can't use the outcome of other triggers.")
+		return err
+	}
+	if s.Spec.MaxConcurrentTriggers < 0 {
+		s.Status.MarkTriggersNotProvided("InvalidMaxConcurrentTriggers", "Max concurrent triggers can't be negative.")
+		return errors.New("max concurrent triggers can't be negative")
+	}
+	if s.Spec.SuspendBufferSize < 0 {
+		s.Status.MarkTriggersNotProvided("InvalidSuspendBufferSize", "Suspend buffer size can't be negative.")
+		return errors.New("suspend buffer size can't be negative")
+	}
+	s.Status.MarkTriggersProvided()
+	return nil
+}
+
+// validateTriggers validates triggers
+func validateTriggers(triggers []v1alpha1.Trigger) error {
+	if len(triggers) < 1 {
+		return errors.Errorf("no triggers found")
+	}
+
+	for _, trigger := range triggers {
+		if err := validateTriggerTemplate(trigger.Template); err != nil {
+			return err
+		}
+		if err := validateTriggerPolicy(&trigger); err != nil {
+			return err
+		}
+		if err := validateRetryStrategy(trigger.RetryStrategy); err != nil {
+			return err
+		}
+		if trigger.When != "" {
+			if _, err := expr.Compile(trigger.When, expr.AllowUndefinedVariables(), expr.AsBool()); err != nil {
+				return errors.Wrapf(err, "when expression of trigger %s is invalid", trigger.Template.Name)
+			}
+		}
+		if err := validateCircuitBreaker(trigger.CircuitBreaker); err != nil {
+			return errors.Wrapf(err, "circuit breaker of trigger %s is invalid", trigger.Template.Name)
+		}
+		if err := validateTriggerTemplateParameters(&trigger); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateTriggerTemplate validates trigger template
+func validateTriggerTemplate(template *v1alpha1.TriggerTemplate) error {
+	if template == nil {
+		return errors.Errorf("trigger template can't be nil")
+	}
+	if template.Name == "" {
+		return errors.Errorf("trigger must define a name")
+	}
+	if template.Switch != nil && template.Switch.All != nil && template.Switch.Any != nil {
+		return errors.Errorf("trigger condition can't have both any and all condition")
+	}
+	if template.K8s != nil {
+		if err := validateK8STrigger(template.K8s); err != nil {
+			return errors.Wrapf(err, "trigger for template %s is invalid", template.Name)
+		}
+	}
+	if template.ArgoWorkflow != nil {
+		if err := validateArgoWorkflowTrigger(template.ArgoWorkflow); err != nil {
+			return errors.Wrapf(err, "template %s is invalid", template.Name)
+		}
+	}
+	if template.HTTP != nil {
+		if err := validateHTTPTrigger(template.HTTP); err != nil {
+			return errors.Wrapf(err, "template %s is invalid", template.Name)
+		}
+	}
+	if template.AWSLambda != nil {
+		if err := validateAWSLambdaTrigger(template.AWSLambda); err != nil {
+			return errors.Wrapf(err, "template %s is invalid", template.Name)
+		}
+	}
+	if template.AWSSQS != nil {
+		if err := validateAWSSQSTrigger(template.AWSSQS); err != nil {
+			return errors.Wrapf(err, "template %s is invalid", template.Name)
+		}
+	}
+	if template.AWSSNS != nil {
+		if err := validateAWSSNSTrigger(template.AWSSNS); err != nil {
+			return errors.Wrapf(err, "template %s is invalid", template.Name)
+		}
+	}
+	if template.GCPPubSub != nil {
+		if err := validateGCPPubSubTrigger(template.GCPPubSub); err != nil {
+			return errors.Wrapf(err, "template %s is invalid", template.Name)
+		}
+	}
+	if template.Kafka != nil {
+		if err := validateKafkaTrigger(template.Kafka); err != nil {
+			return errors.Wrapf(err, "template %s is invalid", template.Name)
+		}
+	}
+	if template.NATS != nil {
+		if err := validateNATSTrigger(template.NATS); err != nil {
+			return errors.Wrapf(err, "template %s is invalid", template.Name)
+		}
+	}
+	if template.AMQP != nil {
+		if err := validateAMQPTrigger(template.AMQP); err != nil {
+			return errors.Wrapf(err, "template %s is invalid", template.Name)
+		}
+	}
+	if template.MQTT != nil {
+		if err := validateMQTTTrigger(template.MQTT); err != nil {
+			return errors.Wrapf(err, "template %s is invalid", template.Name)
+		}
+	}
+	if template.Pulsar != nil {
+		if err := validatePulsarTrigger(template.Pulsar); err != nil {
+			return errors.Wrapf(err, "template %s is invalid", template.Name)
+		}
+	}
+	if template.Redis != nil {
+		if err := validateRedisTrigger(template.Redis); err != nil {
+			return errors.Wrapf(err, "template %s is invalid", template.Name)
+		}
+	}
+	if template.EventBus != nil {
+		if err := validateEventBusTrigger(template.EventBus); err != nil {
+			return errors.Wrapf(err, "template %s is invalid", template.Name)
+		}
+	}
+	if template.Log != nil {
+		if err := validateLogTrigger(template.Log); err != nil {
+			return errors.Wrapf(err, "template %s is invalid", template.Name)
+		}
+	}
+	if template.ObjectStorage != nil {
+		if err := validateObjectStorageTrigger(template.ObjectStorage); err != nil {
+			return errors.Wrapf(err, "template %s is invalid", template.Name)
+		}
+	}
+	if template.Job != nil {
+		if err := validateJobTrigger(template.Job); err != nil {
+			return errors.Wrapf(err, "template %s is invalid", template.Name)
+		}
+	}
+	if template.Slack != nil {
+		if err := validateSlackTrigger(template.Slack); err != nil {
+			return errors.Wrapf(err, "template %s is invalid", template.Name)
+		}
+	}
+	if template.Email != nil {
+		if err := validateEmailTrigger(template.Email); err != nil {
+			return errors.Wrapf(err, "template %s is invalid", template.Name)
+		}
+	}
+	if template.OpenWhisk != nil {
+		if err := validateOpenWhiskTrigger(template.OpenWhisk); err != nil {
+			return errors.Wrapf(err, "template %s is invalid", template.Name)
+		}
+	}
+	if template.CustomTrigger != nil {
+		if err := validateCustomTrigger(template.CustomTrigger); err != nil {
+			return errors.Wrapf(err, "template %s is invalid", template.Name)
+		}
+	}
+	return nil
+}
+
+// validateK8STrigger validates a kubernetes trigger
+func validateK8STrigger(trigger *v1alpha1.StandardK8STrigger) error {
+	if trigger == nil {
+		return errors.New("k8s trigger for can't be nil")
+	}
+	if trigger.Source == nil {
+		return errors.New("k8s trigger for does not contain an absolute action")
+	}
+	if trigger.GroupVersionResource.Size() == 0 {
+		return errors.New("must provide group, version and resource for the resource")
+	}
+	switch trigger.Operation {
+	case "", v1alpha1.Create, v1alpha1.Patch, v1alpha1.Update, v1alpha1.Delete, v1alpha1.Apply, v1alpha1.CreateOrUpdate:
+	default:
+		return errors.Errorf("unknown operation type %s", string(trigger.Operation))
+	}
+	if err := validateGarbageCollection(trigger.GarbageCollection, false); err != nil {
+		return err
+	}
+	if trigger.Concurrency != nil && trigger.Operation != "" && trigger.Operation != v1alpha1.Create {
+		return errors.Errorf("concurrency is only supported by the %s operation", v1alpha1.Create)
+	}
+	if err := validateConcurrency(trigger.Concurrency, false); err != nil {
+		return err
+	}
+	if trigger.LabelSelector != "" {
+		if trigger.Operation != v1alpha1.Delete {
+			return errors.Errorf("label selector is only supported by the %s operation", v1alpha1.Delete)
+		}
+		if _, err := labels.Parse(trigger.LabelSelector); err != nil {
+			return errors.Wrap(err, "invalid label selector")
+		}
+	}
+	if trigger.Parameters != nil {
+		for i, parameter := range trigger.Parameters {
+			if err := validateTriggerParameter(&parameter); err != nil {
+				return errors.Errorf("resource parameter index: %d. err: %+v", i, err)
+			}
+		}
+	}
+	return nil
+}
+
+// validateArgoWorkflowTrigger validates an Argo workflow trigger
+func validateArgoWorkflowTrigger(trigger *v1alpha1.ArgoWorkflowTrigger) error {
+	if trigger == nil {
+		return errors.New("k8s trigger for can't be nil")
+	}
+	if trigger.Source == nil {
+		return errors.New("k8s trigger for does not contain an absolute action")
+	}
+	if trigger.GroupVersionResource.Size() == 0 {
+		return errors.New("must provide group, version and resource for the resource")
+	}
+	switch trigger.Operation {
+	case v1alpha1.Submit, v1alpha1.Suspend, v1alpha1.Retry, v1alpha1.Resume, v1alpha1.Resubmit, v1alpha1.SubmitFrom, v1alpha1.Terminate, v1alpha1.Stop:
+	default:
+		return errors.Errorf("unknown operation type %s", string(trigger.Operation))
+	}
+	if trigger.Parameters != nil {
+		for i, parameter := range trigger.Parameters {
+			if err := validateTriggerParameter(&parameter); err != nil {
+				return errors.Errorf("resource parameter index: %d. err: %+v", i, err)
+			}
+		}
+	}
+	if err := validateGarbageCollection(trigger.GarbageCollection, true); err != nil {
+		return err
+	}
+	if trigger.Concurrency != nil {
+		switch trigger.Operation {
+		case v1alpha1.Submit, v1alpha1.SubmitFrom, v1alpha1.Resubmit:
+		default:
+			return errors.Errorf("concurrency is only supported by the %s, %s and %s operations", v1alpha1.Submit, v1alpha1.SubmitFrom, v1alpha1.Resubmit)
+		}
+	}
+	if err := validateConcurrency(trigger.Concurrency, true); err != nil {
+		return err
+	}
+	if trigger.Arguments != nil {
+		if trigger.Operation != v1alpha1.Submit && trigger.Operation != v1alpha1.SubmitFrom {
+			return errors.Errorf("arguments are only supported by the %s and %s operations", v1alpha1.Submit, v1alpha1.SubmitFrom)
+		}
+		for i, parameter := range trigger.Arguments {
+			if err := validateTriggerParameter(&parameter); err != nil {
+				return errors.Errorf("argument index: %d. err: %+v", i, err)
+			}
+		}
+	}
+	return nil
+}
+
+// validateGarbageCollection validates the garbage collection policy of a trigger.
+// Workflows have a default completion condition, other resources need one for the TTL.
+func validateGarbageCollection(gc *v1alpha1.GarbageCollection, defaultCompletion bool) error {
+	if gc == nil {
+		return nil
+	}
+	if gc.TTLSecondsAfterCompletion != nil {
+		if *gc.TTLSecondsAfterCompletion < 0 {
+			return errors.New("garbage collection ttl can't be negative")
+		}
+		if gc.CompletionCondition == "" && !defaultCompletion {
+			return errors.New("garbage collection ttl requires a completion condition")
+		}
+	}
+	if gc.Retain < 0 {
+		return errors.New("garbage collection retain can't be negative")
+	}
+	if _, err := common.CompileCondition(gc.CompletionCondition); err != nil {
+		return errors.Wrap(err, "invalid garbage collection completion condition")
+	}
+	return nil
+}
+
+// validateConcurrency validates the concurrency policy of a trigger.
+// Workflows have a default completion condition, other resources need one to tell the running resources.
+func validateConcurrency(concurrency *v1alpha1.TriggerConcurrency, defaultCompletion bool) error {
+	if concurrency == nil {
+		return nil
+	}
+	switch concurrency.Policy {
+	case "", v1alpha1.ConcurrencyPolicyAllow:
+	case v1alpha1.ConcurrencyPolicyForbid, v1alpha1.ConcurrencyPolicyReplace:
+		if concurrency.CompletionCondition == "" && !defaultCompletion {
+			return errors.Errorf("concurrency policy %s requires a completion condition", concurrency.Policy)
+		}
+	default:
+		return errors.Errorf("unknown concurrency policy %s", concurrency.Policy)
+	}
+	if _, err := common.CompileCondition(concurrency.CompletionCondition); err != nil {
+		return errors.Wrap(err, "invalid concurrency completion condition")
+	}
+	if concurrency.CorrelationKey != nil && concurrency.CorrelationKey.DependencyName == "" {
+		return errors.New("correlation key dependency name can't be empty")
+	}
+	return nil
+}
+
+// validateHTTPTrigger validates the HTTP trigger
+func validateHTTPTrigger(trigger *v1alpha1.HTTPTrigger) error {
+	if trigger == nil {
+		return errors.New("openfaas trigger for can't be nil")
+	}
+	if trigger.URL == "" {
+		return errors.New("server URL is not specified")
+	}
+	if trigger.Method != "" {
+		switch trigger.Method {
+		case http.MethodGet, http.MethodDelete, http.MethodPatch, http.MethodPost, http.MethodPut:
+		default:
+			return errors.New("only GET, DELETE, PATCH, POST and PUT methods are supported")
+		}
+	}
+	if trigger.Parameters != nil {
+		for i, parameter := range trigger.Parameters {
+			if err := validateTriggerParameter(&parameter); err != nil {
+				return errors.Errorf("resource parameter index: %d. err: %+v", i, err)
+			}
+		}
+	}
+	if trigger.Payload != nil {
+		for i, p := range trigger.Payload {
+			if err := validateTriggerParameter(&p); err != nil {
+				return errors.Errorf("payload index: %d. err: %+v", i, err)
+			}
+		}
+	}
+	return nil
+}
+
+// validateOpenWhiskTrigger validates the OpenWhisk trigger
+func validateOpenWhiskTrigger(trigger *v1alpha1.OpenWhiskTrigger) error {
+	if trigger == nil {
+		return errors.New("openwhisk trigger for can't be nil")
+	}
+	if trigger.ActionName == "" {
+		return errors.New("action name is not specified")
+	}
+	if trigger.Host == "" {
+		return errors.New("host URL is not specified")
+	}
+	if trigger.AuthToken != nil {
+		if trigger.AuthToken.Name == "" || trigger.AuthToken.Key == "" {
+			return errors.New("auth token key and name must be specified")
+		}
+	}
+	if trigger.Parameters != nil {
+		for i, parameter := range trigger.Parameters {
+			if err := validateTriggerParameter(&parameter); err != nil {
+				return errors.Errorf("resource parameter index: %d. err: %+v", i, err)
+			}
+		}
+	}
+	if trigger.Payload == nil {
+		return errors.New("payload parameters are not specified")
+	}
+	if trigger.Payload != nil {
+		for i, p := range trigger.Payload {
+			if err := validateTriggerParameter(&p); err != nil {
+				return errors.Errorf("resource parameter index: %d. err: %+v", i, err)
+			}
+		}
+	}
+	return nil
+}
+
+// validateAWSLambdaTrigger validates the AWS Lambda trigger
+func validateAWSLambdaTrigger(trigger *v1alpha1.AWSLambdaTrigger) error {
+	if trigger == nil {
+		return errors.New("openfaas trigger for can't be nil")
+	}
+	if trigger.FunctionName == "" {
+		return errors.New("function name is not specified")
+	}
+	if trigger.Region == "" {
+		return errors.New("region in not specified")
+	}
+	if trigger.AccessKey == nil || trigger.SecretKey == nil {
+		return errors.New("either accesskey or secretkey secret selector is not specified")
+	}
+	if trigger.Payload == nil {
+		return errors.New("payload parameters are not specified")
+	}
+	if trigger.Parameters != nil {
+		for i, parameter := range trigger.Parameters {
+			if err := validateTriggerParameter(&parameter); err != nil {
+				return errors.Errorf("resource parameter index: %d. err: %+v", i, err)
+			}
+		}
+	}
+	if trigger.Payload != nil {
+		for i, p := range trigger.Payload {
+			if err := validateTriggerParameter(&p); err != nil {
+				return errors.Errorf("payload index: %d. err: %+v", i, err)
+			}
+		}
+	}
+	return nil
+}
+
+// validateAWSSQSTrigger validates the AWS SQS trigger
+func validateAWSSQSTrigger(trigger *v1alpha1.AWSSQSTrigger) error {
+	if trigger == nil {
+		return errors.New("aws sqs trigger can't be nil")
+	}
+	if trigger.Queue == "" {
+		return errors.New("queue is not specified")
+	}
+	if trigger.Region == "" {
+		return errors.New("region in not specified")
+	}
+	if (trigger.AccessKey == nil) != (trigger.SecretKey == nil) {
+		return errors.New("both accesskey and secretkey secret selectors must be specified")
+	}
+	return validatePayloadAndParameters(trigger.Payload, trigger.Parameters)
+}
+
+// validateAWSSNSTrigger validates the AWS SNS trigger
+func validateAWSSNSTrigger(trigger *v1alpha1.AWSSNSTrigger) error {
+	if trigger == nil {
+		return errors.New("aws sns trigger can't be nil")
+	}
+	if trigger.TopicArn == "" {
+		return errors.New("topic arn is not specified")
+	}
+	if trigger.Region == "" {
+		return errors.New("region in not specified")
+	}
+	if (trigger.AccessKey == nil) != (trigger.SecretKey == nil) {
+		return errors.New("both accesskey and secretkey secret selectors must be specified")
+	}
+	return validatePayloadAndParameters(trigger.Payload, trigger.Parameters)
+}
+
+// validateGCPPubSubTrigger validates the GCP Pub/Sub trigger
+func validateGCPPubSubTrigger(trigger *v1alpha1.GCPPubSubTrigger) error {
+	if trigger == nil {
+		return errors.New("gcp pubsub trigger can't be nil")
+	}
+	if trigger.ProjectID == "" {
+		return errors.New("project id is not specified")
+	}
+	if trigger.Topic == "" {
+		return errors.New("topic is not specified")
+	}
+	return validatePayloadAndParameters(trigger.Payload, trigger.Parameters)
+}
+
+// validatePayloadAndParameters validates the payload and the resource parameters of a trigger
+func validatePayloadAndParameters(payload, parameters []v1alpha1.TriggerParameter) error {
+	if payload == nil {
+		return errors.New("payload parameters are not specified")
+	}
+	for i, p := range payload {
+		if err := validateTriggerParameter(&p); err != nil {
+			return errors.Errorf("payload index: %d. err: %+v", i, err)
+		}
+	}
+	for i, parameter := range parameters {
+		if err := validateTriggerParameter(&parameter); err != nil {
+			return errors.Errorf("resource parameter index: %d. err: %+v", i, err)
+		}
+	}
+	return nil
+}
+
+// validateKafkaTrigger validates the kafka trigger.
+func validateKafkaTrigger(trigger *v1alpha1.KafkaTrigger) error {
+	if trigger == nil {
+		return errors.New("trigger can't be nil")
+	}
+	if trigger.URL == "" {
+		return errors.New("broker url must not be empty")
+	}
+	if trigger.Payload == nil {
+		return errors.New("payload must not be empty")
+	}
+	if trigger.Topic == "" {
+		return errors.New("topic must not be empty")
+	}
+	if trigger.Payload != nil {
+		for i, p := range trigger.Payload {
+			if err := validateTriggerParameter(&p); err != nil {
+				return errors.Errorf("payload index: %d. err: %+v", i, err)
+			}
+		}
+	}
+	return nil
+}
+
+// validateNATSTrigger validates the NATS trigger.
+func validateNATSTrigger(trigger *v1alpha1.NATSTrigger) error {
+	if trigger == nil {
+		return errors.New("trigger can't be nil")
+	}
+	if trigger.URL == "" {
+		return errors.New("nats server url can't be empty")
+	}
+	if trigger.Subject == "" {
+		return errors.New("nats subject can't be empty")
+	}
+	if trigger.Payload == nil {
+		return errors.New("payload can't be nil")
+	}
+	if trigger.Payload != nil {
+		for i, p := range trigger.Payload {
+			if err := validateTriggerParameter(&p); err != nil {
+				return errors.Errorf("payload index: %d. err: %+v", i, err)
+			}
+		}
+	}
+	return nil
+}
+
+// validateAMQPTrigger validates the AMQP trigger.
+func validateAMQPTrigger(trigger *v1alpha1.AMQPTrigger) error {
+	if trigger == nil {
+		return errors.New("trigger can't be nil")
+	}
+	if trigger.URL == "" {
+		return errors.New("amqp server url can't be empty")
+	}
+	if trigger.ExchangeName == "" && trigger.RoutingKey == "" && len(trigger.Parameters) == 0 {
+		return errors.New("either exchange name or routing key must be specified")
+	}
+	if trigger.TLS != nil {
+		if trigger.TLS.CACertPath == "" || trigger.TLS.ClientCertPath == "" || trigger.TLS.ClientKeyPath == "" {
+			return errors.New("ca cert path, client cert path and client key path must be specified for the tls configuration")
+		}
+	}
+	return validatePayloadAndParameters(trigger.Payload, trigger.Parameters)
+}
+
+// validateMQTTTrigger validates the MQTT trigger.
+func validateMQTTTrigger(trigger *v1alpha1.MQTTTrigger) error {
+	if trigger == nil {
+		return errors.New("trigger can't be nil")
+	}
+	if trigger.URL == "" {
+		return errors.New("mqtt broker url can't be empty")
+	}
+	if trigger.Topic == "" {
+		return errors.New("mqtt topic can't be empty")
+	}
+	if trigger.QoS < 0 || trigger.QoS > 2 {
+		return errors.Errorf("invalid qos %d, it must be 0, 1 or 2", trigger.QoS)
+	}
+	if trigger.TLS != nil {
+		if trigger.TLS.CACertPath == "" || trigger.TLS.ClientCertPath == "" || trigger.TLS.ClientKeyPath == "" {
+			return errors.New("ca cert path, client cert path and client key path must be specified for the tls configuration")
+		}
+	}
+	return validatePayloadAndParameters(trigger.Payload, trigger.Parameters)
+}
+
+// validatePulsarTrigger validates the Pulsar trigger.
+func validatePulsarTrigger(trigger *v1alpha1.PulsarTrigger) error {
+	if trigger == nil {
+		return errors.New("trigger can't be nil")
+	}
+	if trigger.URL == "" {
+		return errors.New("pulsar service url can't be empty")
+	}
+	if trigger.Topic == "" {
+		return errors.New("pulsar topic can't be empty")
+	}
+	if trigger.TLS != nil {
+		if trigger.TLS.ClientCertPath == "" || trigger.TLS.ClientKeyPath == "" {
+			return errors.New("client cert path and client key path must be specified for the tls authentication")
+		}
+	}
+	return validatePayloadAndParameters(trigger.Payload, trigger.Parameters)
+}
+
+// validateRedisTrigger validates the Redis trigger.
+func validateRedisTrigger(trigger *v1alpha1.RedisTrigger) error {
+	if trigger == nil {
+		return errors.New("trigger can't be nil")
+	}
+	if trigger.HostAddress == "" {
+		return errors.New("redis host address can't be empty")
+	}
+	if (trigger.Channel == "") == (trigger.Stream == "") {
+		return errors.New("exactly one of channel or stream must be specified")
+	}
+	if trigger.MaxStreamLength < 0 {
+		return errors.Errorf("invalid max stream length %d, it can't be negative", trigger.MaxStreamLength)
+	}
+	if trigger.TLS != nil {
+		if trigger.TLS.CACertPath == "" || trigger.TLS.ClientCertPath == "" || trigger.TLS.ClientKeyPath == "" {
+			return errors.New("ca cert path, client cert path and client key path must be specified for the tls configuration")
+		}
+	}
+	return validatePayloadAndParameters(trigger.Payload, trigger.Parameters)
+}
+
+// validateEventBusTrigger validates the EventBus trigger.
+func validateEventBusTrigger(trigger *v1alpha1.EventBusTrigger) error {
+	if trigger == nil {
+		return errors.New("trigger can't be nil")
+	}
+	if trigger.Source == "" {
+		return errors.New("event source can't be empty")
+	}
+	if trigger.Subject == "" {
+		return errors.New("event subject can't be empty")
+	}
+	return validatePayloadAndParameters(trigger.Payload, trigger.Parameters)
+}
+
+// validateEventBusTriggers makes sure that the EventBus triggers don't publish the events the sensor depends on,
+// which would execute the triggers again and again.
+func validateEventBusTriggers(s *v1alpha1.Sensor) error {
+	for _, trigger := range s.Spec.Triggers {
+		eventBusTrigger := trigger.Template.EventBus
+		if eventBusTrigger == nil {
+			continue
+		}
+		for _, dep := range s.Spec.Dependencies {
+			if dep.EventSourceName == eventBusTrigger.Source && dep.EventName == eventBusTrigger.Subject {
+				return errors.Errorf("trigger %s publishes the events of the dependency %s of the same sensor", trigger.Template.Name, dep.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// validateLogTrigger validates the Log trigger.
+func validateLogTrigger(trigger *v1alpha1.LogTrigger) error {
+	if trigger == nil {
+		return errors.New("trigger can't be nil")
+	}
+	if trigger.Format != "" {
+		if _, err := template.New("log").Funcs(sprig.HermeticTxtFuncMap()).Parse(trigger.Format); err != nil {
+			return errors.Wrap(err, "log format is invalid")
+		}
+	}
+	for i, p := range trigger.Payload {
+		if err := validateTriggerParameter(&p); err != nil {
+			return errors.Errorf("payload index: %d. err: %+v", i, err)
+		}
+	}
+	for i, parameter := range trigger.Parameters {
+		if err := validateTriggerParameter(&parameter); err != nil {
+			return errors.Errorf("resource parameter index: %d. err: %+v", i, err)
+		}
+	}
+	return nil
+}
+
+// validateObjectStorageTrigger validates the ObjectStorage trigger.
+func validateObjectStorageTrigger(trigger *v1alpha1.ObjectStorageTrigger) error {
+	if trigger == nil {
+		return errors.New("trigger can't be nil")
+	}
+	if trigger.S3 == nil {
+		return errors.New("s3 store can't be nil")
+	}
+	if trigger.S3.Endpoint == "" {
+		return errors.New("s3 endpoint can't be empty")
+	}
+	if trigger.S3.Bucket == nil || trigger.S3.Bucket.Name == "" {
+		return errors.New("s3 bucket name can't be empty")
+	}
+	if trigger.S3.Bucket.Key == "" {
+		return errors.New("s3 bucket key can't be empty")
+	}
+	if trigger.S3.AccessKey == nil || trigger.S3.SecretKey == nil {
+		return errors.New("s3 access key and secret key must be specified")
+	}
+	if _, err := template.New("key").Funcs(sprig.HermeticTxtFuncMap()).Parse(trigger.S3.Bucket.Key); err != nil {
+		return errors.Wrap(err, "s3 bucket key is invalid")
+	}
+	if trigger.Body != "" {
+		if _, err := template.New("body").Funcs(sprig.HermeticTxtFuncMap()).Parse(trigger.Body); err != nil {
+			return errors.Wrap(err, "body is invalid")
+		}
+	}
+	return validatePayloadAndParameters(trigger.Payload, trigger.Parameters)
+}
+
+// validateJobTrigger validates the Job trigger.
+func validateJobTrigger(trigger *v1alpha1.JobTrigger) error {
+	if trigger == nil {
+		return errors.New("trigger can't be nil")
+	}
+	if trigger.Template == nil || len(trigger.Template.Spec.Containers) == 0 {
+		return errors.New("pod template must define at least one container")
+	}
+	if trigger.Timeout < 0 {
+		return errors.Errorf("invalid timeout %d, it can't be negative", trigger.Timeout)
+	}
+	if trigger.LogLines < 0 {
+		return errors.Errorf("invalid log lines %d, it can't be negative", trigger.LogLines)
+	}
+	if !trigger.Wait && (trigger.Timeout > 0 || trigger.AllowFailure || trigger.LogLines > 0) {
+		return errors.New("timeout, allow failure and log lines require to wait for the job")
+	}
+	for i, parameter := range trigger.Parameters {
+		if err := validateTriggerParameter(&parameter); err != nil {
+			return errors.Errorf("resource parameter index: %d. err: %+v", i, err)
+		}
+	}
+	return nil
+}
+
+// validateJobTriggers makes sure that the outcome of the Job triggers, which is passed to the later triggers
+// keyed by the trigger name, doesn't hide the events of a dependency with the same name. The wait flag may be set
+// by a parameter, so the names of all the Job triggers are checked.
+func validateJobTriggers(s *v1alpha1.Sensor) error {
+	for _, trigger := range s.Spec.Triggers {
+		if trigger.Template == nil || trigger.Template.Job == nil {
+			continue
+		}
+		for _, dep := range s.Spec.Dependencies {
+			if dep.Name == trigger.Template.Name {
+				return errors.Errorf("job trigger %s has the same name as a dependency", trigger.Template.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// validateParallelTriggers makes sure that no trigger uses the outcome of a Job or Argo workflow trigger when the
+// triggers are executed in parallel, as the outcome is only passed to the triggers executed after them.
+func validateParallelTriggers(s *v1alpha1.Sensor) error {
+	if !s.Spec.ParallelTriggers {
+		return nil
+	}
+	outputTriggers := make(map[string]bool)
+	for _, trigger := range s.Spec.Triggers {
+		if trigger.Template != nil && (trigger.Template.Job != nil || trigger.Template.ArgoWorkflow != nil) {
+			outputTriggers[trigger.Template.Name] = true
+		}
+	}
+	if len(outputTriggers) == 0 {
+		return nil
+	}
+	for _, trigger := range s.Spec.Triggers {
+		names, err := referencedEventNames(&trigger)
+		if err != nil {
+			return err
+		}
+		for name := range outputTriggers {
+			if name == trigger.Template.Name {
+				continue
+			}
+			if names[name] || names[strings.ReplaceAll(name, "-", "_")] {
+				return errors.Errorf("trigger %s uses the outcome of trigger %s, which is not available when the triggers are executed in parallel", trigger.Template.Name, name)
+			}
+		}
+	}
+	return nil
+}
+
+// referencedEventNames returns the dependency names the parameters of the trigger refer to, and the variables of its
+// when expression. The parameters are looked up in the JSON form of the trigger, so that the parameters of all the
+// trigger types are covered.
+func referencedEventNames(trigger *v1alpha1.Trigger) (map[string]bool, error) {
+	names := make(map[string]bool)
+	data, err := json.Marshal(trigger)
+	if err != nil {
+		return nil, err
+	}
+	var obj interface{}
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return nil, err
+	}
+	var walk func(value interface{})
+	walk = func(value interface{}) {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			if name, ok := v["dependencyName"].(string); ok {
+				names[name] = true
+			}
+			for _, item := range v {
+				walk(item)
+			}
+		case []interface{}:
+			for _, item := range v {
+				walk(item)
+			}
+		}
+	}
+	walk(obj)
+
+	if trigger.When != "" {
+		tree, err := parser.Parse(trigger.When)
+		if err != nil {
+			return nil, errors.Wrapf(err, "when expression of trigger %s is invalid", trigger.Template.Name)
+		}
+		ast.Walk(&tree.Node, identifierCollector(names))
+	}
+	return names, nil
+}
+
+// identifierCollector collects the identifiers of an expression.
+type identifierCollector map[string]bool
+
+func (c identifierCollector) Enter(node *ast.Node) {
+	if identifier, ok := (*node).(*ast.IdentifierNode); ok {
+		c[identifier.Value] = true
+	}
+}
+
+func (c identifierCollector) Exit(node *ast.Node) {}
+
+// validateSlackTrigger validates the Slack trigger.
+func validateSlackTrigger(trigger *v1alpha1.SlackTrigger) error {
+	if trigger == nil {
+		return errors.New("trigger can't be nil")
+	}
+	if trigger.SlackToken == nil {
+		return errors.New("slack token can't be empty")
+	}
+	if trigger.Parameters != nil {
+		for i, parameter := range trigger.Parameters {
+			if err := validateTriggerParameter(&parameter); err != nil {
+				return errors.Errorf("resource parameter index: %d. err: %+v", i, err)
+			}
+		}
+	}
+	return nil
+}
+
+// validateEmailTrigger validates the Email trigger.
+func validateEmailTrigger(trigger *v1alpha1.EmailTrigger) error {
+	if trigger == nil {
+		return errors.New("trigger can't be nil")
+	}
+	if trigger.Host == "" {
+		return errors.New("smtp host can't be empty")
+	}
+	if trigger.Port < 0 {
+		return errors.New("smtp port can't be negative")
+	}
+	switch trigger.Security {
+	case "", v1alpha1.EmailSecurityStartTLS, v1alpha1.EmailSecurityTLS, v1alpha1.EmailSecurityNone:
+	default:
+		return errors.Errorf("unknown smtp security %s", trigger.Security)
+	}
+	if (trigger.Username == nil) != (trigger.Password == nil) {
+		return errors.New("both username and password must be specified for the smtp authentication")
+	}
+	if trigger.From == "" {
+		return errors.New("sender address can't be empty")
+	}
+	if len(trigger.To) == 0 && len(trigger.Cc) == 0 {
+		return errors.New("at least one recipient must be specified")
+	}
+	if trigger.Body == "" && trigger.HTML == "" && len(trigger.Parameters) == 0 {
+		return errors.New("either body or html must be specified")
+	}
+	if trigger.Parameters != nil {
+		for i, parameter := range trigger.Parameters {
+			if err := validateTriggerParameter(&parameter); err != nil {
+				return errors.Errorf("resource parameter index: %d. err: %+v", i, err)
+			}
+		}
+	}
+	return nil
+}
+
+// validateCustomTrigger validates the custom trigger.
+func validateCustomTrigger(trigger *v1alpha1.CustomTrigger) error {
+	if trigger == nil {
+		return errors.New("custom trigger for can't be nil")
+	}
+	if trigger.ServerURL == "" {
+		return errors.New("custom trigger gRPC server url is not defined")
+	}
+	if trigger.Spec == nil {
+		return errors.New("trigger body can't be empty")
+	}
+	if trigger.Secure {
+		if trigger.CertFilePath == "" {
+			return errors.New("cert file path can't be nil when the trigger server connection is secure")
+		}
+	}
+	if trigger.Parameters != nil {
+		for i, parameter := range trigger.Parameters {
+			if err := validateTriggerParameter(&parameter); err != nil {
+				return errors.Errorf("resource parameter index: %d. err: %+v", i, err)
+			}
+		}
+	}
+	return nil
+}
+
+// validateTriggerParameters validates resource and template parameters if any
+func validateTriggerTemplateParameters(trigger *v1alpha1.Trigger) error {
+	if trigger.Parameters != nil {
+		for i, parameter := range trigger.Parameters {
+			if err := validateTriggerParameter(&parameter); err != nil {
+				return errors.Errorf("template parameter index: %d. err: %+v", i, err)
+			}
+		}
+	}
+	return nil
+}
+
+// validateTriggerParameter validates a trigger parameter
+func validateTriggerParameter(parameter *v1alpha1.TriggerParameter) error {
+	if parameter.Src == nil {
+		return errors.Errorf("parameter source can't be empty")
+	}
+	if parameter.Src.DependencyName == "" {
+		return errors.Errorf("parameter dependency name can't be empty")
+	}
+	if parameter.Dest == "" {
+		return errors.Errorf("parameter destination can't be empty")
+	}
+
+	switch op := parameter.Operation; op {
+	case v1alpha1.TriggerParameterOpAppend:
+	case v1alpha1.TriggerParameterOpOverwrite:
+	case v1alpha1.TriggerParameterOpPrepend:
+	case v1alpha1.TriggerParameterOpNone:
+	default:
+		return errors.Errorf("parameter operation %+v is invalid", op)
+	}
+
+	return nil
+}
+
+// perform a check to see that each event dependency is in correct format and has valid filters set if any
+func validateDependencies(eventDependencies []v1alpha1.EventDependency) error {
+	if len(eventDependencies) < 1 {
+		return errors.New("no event dependencies found")
+	}
+	for _, dep := range eventDependencies {
+		if dep.Name == "" {
+			return errors.New("event dependency must define a name")
+		}
+		if dep.EventSourceName == "" {
+			return errors.New("event dependency must define the EventSource name")
+		}
+
+		if dep.EventName == "" {
+			return errors.New("event dependency must define the event name")
+		}
+
+		if err := validateEventFilter(dep.Filters); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateEventFilter for a sensor
+func validateEventFilter(filter *v1alpha1.EventDependencyFilter) error {
+	if filter == nil {
+		return nil
+	}
+	if filter.Time != nil {
+		if err := validateEventTimeFilter(filter.Time); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateEventTimeFilter validates time filter
+func validateEventTimeFilter(tFilter *v1alpha1.TimeFilter) error {
+	now := time.Now().UTC()
+	// Parse start and stop
+	startTime, err := common.ParseTime(tFilter.Start, now)
+	if err != nil {
+		return err
+	}
+	stopTime, err := common.ParseTime(tFilter.Stop, now)
+	if err != nil {
+		return err
+	}
+
+	if stopTime.Equal(startTime) {
+		return errors.Errorf("invalid event time filter: stop '%s' is equal to start '%s", tFilter.Stop, tFilter.Start)
+	}
+	return nil
+}
+
+// validateTriggerPolicy validates a trigger policy
+func validateTriggerPolicy(trigger *v1alpha1.Trigger) error {
+	if trigger.Policy == nil {
+		return nil
+	}
+	if trigger.Template.K8s != nil {
+		return validateK8sTriggerPolicy(trigger.Policy.K8s)
+	}
+	if trigger.Template.ArgoWorkflow != nil {
+		return validateK8sTriggerPolicy(trigger.Policy.K8s)
+	}
+	if trigger.Template.HTTP != nil {
+		return validateStatusPolicy(trigger.Policy.Status)
+	}
+	if trigger.Template.AWSLambda != nil {
+		return validateStatusPolicy(trigger.Policy.Status)
+	}
+	return nil
+}
+
+// validateK8sTriggerPolicy validates a k8s trigger policy
+func validateK8sTriggerPolicy(policy *v1alpha1.K8SResourcePolicy) error {
+	if policy == nil {
+		return nil
+	}
+	if policy.Labels == nil && policy.SuccessCondition == "" && policy.FailureCondition == "" {
+		return errors.New("neither resource labels nor conditions are specified")
+	}
+	if _, err := common.CompileCondition(policy.SuccessCondition); err != nil {
+		return errors.Wrap(err, "invalid success condition")
+	}
+	if _, err := common.CompileCondition(policy.FailureCondition); err != nil {
+		return errors.Wrap(err, "invalid failure condition")
+	}
+	if policy.Timeout < 0 {
+		return errors.New("timeout can't be negative")
+	}
+	if &policy.Backoff == nil {
+		return errors.New("backoff is not specified")
+	}
+	return nil
+}
+
+// validateRetryStrategy validates a trigger retry strategy
+func validateRetryStrategy(backoff *apicommon.Backoff) error {
+	if backoff == nil {
+		return nil
+	}
+	if backoff.Steps < 0 {
+		return errors.New("retry strategy steps can't be negative")
+	}
+	if backoff.Duration < 0 {
+		return errors.New("retry strategy duration can't be negative")
+	}
+	if len(backoff.Factor.Value) > 0 {
+		if factor, err := backoff.Factor.Float64(); err != nil || factor < 0 {
+			return errors.New("retry strategy factor must be a non-negative number")
+		}
+	}
+	return nil
+}
+
+// validateCircuitBreaker validates a trigger circuit breaker
+func validateCircuitBreaker(breaker *v1alpha1.CircuitBreaker) error {
+	if breaker == nil {
+		return nil
+	}
+	if breaker.FailureThreshold <= 0 {
+		return errors.New("failure threshold must be greater than 0")
+	}
+	if breaker.CoolOffSeconds < 0 {
+		return errors.New("cool-off seconds can't be negative")
+	}
+	if breaker.DeadLetterTrigger != nil {
+		if err := validateTriggerTemplate(breaker.DeadLetterTrigger); err != nil {
+			return errors.Wrap(err, "dead letter trigger is invalid")
+		}
+	}
+	return nil
+}
+
+// validateStatusPolicy validates a http trigger policy
+func validateStatusPolicy(policy *v1alpha1.StatusPolicy) error {
+	if policy == nil {
+		return nil
+	}
+	if policy.Allow == nil {
+		return errors.New("list of allowed response status is not specified")
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+	"io/ioutil"
+	"testing"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/ghodss/yaml"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateSensor(t *testing.T) {
+	dir := "../../../../examples/sensors"
+	files, err := ioutil.ReadDir(dir)
+	assert.Nil(t, err)
+	for _, file := range files {
+		content, err := ioutil.ReadFile(fmt.Sprintf("%s/%s", dir, file.Name()))
+		assert.Nil(t, err)
+		var sensor *v1alpha1.Sensor
+		err = yaml.Unmarshal(content, &sensor)
+		assert.Nil(t, err)
+		err = ValidateSensor(sensor)
+		assert.Nil(t, err)
+	}
+}
+
+func TestValidateJobTriggers(t *testing.T) {
+	sensor := &v1alpha1.Sensor{
+		Spec: v1alpha1.SensorSpec{
+			Dependencies: []v1alpha1.EventDependency{
+				{Name: "run-tests", EventSourceName: "webhook", EventName: "example"},
+			},
+			Triggers: []v1alpha1.Trigger{
+				{
+					Template: &v1alpha1.TriggerTemplate{
+						Name: "run-tests",
+						Job:  &v1alpha1.JobTrigger{},
+					},
+				},
+			},
+		},
+	}
+	assert.Error(t, validateJobTriggers(sensor))
+
+	sensor.Spec.Triggers[0].Template.Name = "run-tests-job"
+	assert.NoError(t, validateJobTriggers(sensor))
+}
+
+func TestValidateParallelTriggers(t *testing.T) {
+	sensor := &v1alpha1.Sensor{
+		Spec: v1alpha1.SensorSpec{
+			ParallelTriggers: true,
+			Triggers: []v1alpha1.Trigger{
+				{
+					Template: &v1alpha1.TriggerTemplate{
+						Name: "run-tests",
+						Job:  &v1alpha1.JobTrigger{Wait: true},
+					},
+				},
+				{
+					Template: &v1alpha1.TriggerTemplate{
+						Name: "notify",
+						Log:  &v1alpha1.LogTrigger{},
+					},
+				},
+			},
+		},
+	}
+	assert.NoError(t, validateParallelTriggers(sensor))
+
+	sensor.Spec.Triggers[1].When = `run_tests.data.status == "Failed"`
+	assert.Error(t, validateParallelTriggers(sensor))
+
+	sensor.Spec.Triggers[1].When = ""
+	sensor.Spec.Triggers[1].Parameters = []v1alpha1.TriggerParameter{
+		{Src: &v1alpha1.TriggerParameterSource{DependencyName: "run-tests", DataKey: "status"}, Dest: "log.format"},
+	}
+	assert.Error(t, validateParallelTriggers(sensor))
+
+	sensor.Spec.ParallelTriggers = false
+	assert.NoError(t, validateParallelTriggers(sensor))
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/argoproj/argo-events/common/logging"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+// defaultCircuitCoolOff is the cool-off period of an open circuit if not specified
+const defaultCircuitCoolOff = 60 * time.Second
+
+// circuitState is the state of a trigger circuit breaker
+type circuitState string
+
+// possible values of circuit states
+const (
+	circuitClosed   circuitState = "Closed"   // the trigger is executed
+	circuitOpen     circuitState = "Open"     // the trigger is skipped
+	circuitHalfOpen circuitState = "HalfOpen" // a single trial execution of the trigger is in progress
+)
+
+// circuitBreaker tracks the consecutive failures of a trigger
+type circuitBreaker struct {
+	threshold int
+	coolOff   time.Duration
+	state     circuitState
+	failures  int
+	openedAt  time.Time
+	now       func() time.Time
+	lock      sync.Mutex
+}
+
+func newCircuitBreaker(spec *v1alpha1.CircuitBreaker) *circuitBreaker {
+	coolOff := defaultCircuitCoolOff
+	if spec.CoolOffSeconds > 0 {
+		coolOff = time.Duration(spec.CoolOffSeconds) * time.Second
+	}
+	return &circuitBreaker{
+		threshold: int(spec.FailureThreshold),
+		coolOff:   coolOff,
+		state:     circuitClosed,
+		now:       time.Now,
+	}
+}
+
+// allow returns true if the trigger can be executed. Once the cool-off period of an open circuit is over,
+// the circuit half-opens and a single trial execution is allowed.
+// The second return value is true if the state of the circuit changed.
+func (cb *circuitBreaker) allow() (bool, bool) {
+	cb.lock.Lock()
+	defer cb.lock.Unlock()
+	switch cb.state {
+	case circuitOpen:
+		if cb.now().Sub(cb.openedAt) < cb.coolOff {
+			return false, false
+		}
+		cb.state = circuitHalfOpen
+		return true, true
+	case circuitHalfOpen:
+		return false, false
+	default:
+		return true, false
+	}
+}
+
+// record records the outcome of a trigger execution and returns true if the state of the circuit changed.
+func (cb *circuitBreaker) record(success bool) bool {
+	cb.lock.Lock()
+	defer cb.lock.Unlock()
+	previous := cb.state
+	if success {
+		cb.failures = 0
+		cb.state = circuitClosed
+		return previous != cb.state
+	}
+	cb.failures++
+	if cb.state == circuitHalfOpen || cb.failures >= cb.threshold {
+		cb.state = circuitOpen
+		cb.openedAt = cb.now()
+	}
+	return previous != cb.state
+}
+
+// getState returns the current state of the circuit
+func (cb *circuitBreaker) getState() circuitState {
+	cb.lock.Lock()
+	defer cb.lock.Unlock()
+	return cb.state
+}
+
+// getCircuitBreaker returns the circuit breaker of the trigger, or nil if the trigger doesn't define one.
+func (sensorCtx *SensorContext) getCircuitBreaker(trigger *v1alpha1.Trigger) *circuitBreaker {
+	if trigger.CircuitBreaker == nil {
+		return nil
+	}
+	sensorCtx.lock.Lock()
+	defer sensorCtx.lock.Unlock()
+	if sensorCtx.circuitBreakers == nil {
+		sensorCtx.circuitBreakers = make(map[string]*circuitBreaker)
+	}
+	cb, ok := sensorCtx.circuitBreakers[trigger.Template.Name]
+	if !ok {
+		cb = newCircuitBreaker(trigger.CircuitBreaker)
+		sensorCtx.circuitBreakers[trigger.Template.Name] = cb
+	}
+	return cb
+}
+
+// resetCircuitStatus closes the circuits of the given triggers in the sensor status, and removes the circuit
+// conditions of the triggers that no longer have a circuit breaker, e.g. because they were removed from the sensor.
+func (sensorCtx *SensorContext) resetCircuitStatus(ctx context.Context, triggerNames []string) {
+	var breakerTriggers []string
+	hasBreaker := make(map[string]bool)
+	for _, trigger := range sensorCtx.getSensor().Spec.Triggers {
+		if trigger.Template != nil && trigger.CircuitBreaker != nil {
+			breakerTriggers = append(breakerTriggers, trigger.Template.Name)
+			hasBreaker[trigger.Template.Name] = true
+		}
+	}
+	err := sensorCtx.updateSensorStatus(ctx, func(status *v1alpha1.SensorStatus) {
+		status.RemoveTriggerCircuits(breakerTriggers...)
+		for _, name := range triggerNames {
+			if hasBreaker[name] {
+				status.MarkTriggerCircuitClosed(name)
+			}
+		}
+	})
+	if err != nil {
+		logging.FromContext(ctx).Errorw("failed to reset the circuit breaker states in the sensor status", zap.Error(err))
+	}
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	fakesensor "github.com/argoproj/argo-events/pkg/client/sensor/clientset/versioned/fake"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	now := time.Now()
+	cb := newCircuitBreaker(&v1alpha1.CircuitBreaker{FailureThreshold: 2, CoolOffSeconds: 10})
+	cb.now = func() time.Time { return now }
+
+	allowed, changed := cb.allow()
+	assert.True(t, allowed)
+	assert.False(t, changed)
+	assert.False(t, cb.record(false))
+	assert.Equal(t, circuitClosed, cb.getState())
+
+	assert.True(t, cb.record(false))
+	assert.Equal(t, circuitOpen, cb.getState())
+	allowed, _ = cb.allow()
+	assert.False(t, allowed)
+
+	now = now.Add(10 * time.Second)
+	allowed, changed = cb.allow()
+	assert.True(t, allowed)
+	assert.True(t, changed)
+	assert.Equal(t, circuitHalfOpen, cb.getState())
+	allowed, _ = cb.allow()
+	assert.False(t, allowed, "only a single trial execution is allowed")
+
+	assert.True(t, cb.record(false))
+	assert.Equal(t, circuitOpen, cb.getState())
+
+	now = now.Add(10 * time.Second)
+	allowed, _ = cb.allow()
+	assert.True(t, allowed)
+	assert.True(t, cb.record(true))
+	assert.Equal(t, circuitClosed, cb.getState())
+}
+
+func TestGetCircuitBreaker(t *testing.T) {
+	sensorCtx := &SensorContext{
+		Sensor: sensorObj.DeepCopy(),
+	}
+	trigger := fakeTrigger.DeepCopy()
+	assert.Nil(t, sensorCtx.getCircuitBreaker(trigger))
+
+	trigger.CircuitBreaker = &v1alpha1.CircuitBreaker{FailureThreshold: 3}
+	cb := sensorCtx.getCircuitBreaker(trigger)
+	assert.NotNil(t, cb)
+	assert.Equal(t, defaultCircuitCoolOff, cb.coolOff)
+	assert.Equal(t, cb, sensorCtx.getCircuitBreaker(trigger))
+}
+
+func TestUpdateCircuitStatus(t *testing.T) {
+	obj := sensorObj.DeepCopy()
+	sensorCtx := &SensorContext{
+		Sensor:       obj,
+		SensorClient: fakesensor.NewSimpleClientset(obj),
+	}
+	triggerName := fakeTrigger.Template.Name
+
+	sensorCtx.updateCircuitStatus(context.Background(), triggerName, circuitOpen, nil)
+	sensor, err := sensorCtx.SensorClient.ArgoprojV1alpha1().Sensors(obj.Namespace).Get(obj.Name, metav1.GetOptions{})
+	assert.Nil(t, err)
+	condition := sensor.Status.GetCondition(v1alpha1.TriggerCircuitCondition(triggerName))
+	assert.NotNil(t, condition)
+	assert.Equal(t, corev1.ConditionFalse, condition.Status)
+	assert.Equal(t, "CircuitOpen", condition.Reason)
+
+	sensorCtx.updateCircuitStatus(context.Background(), triggerName, circuitClosed, nil)
+	sensor, err = sensorCtx.SensorClient.ArgoprojV1alpha1().Sensors(obj.Namespace).Get(obj.Name, metav1.GetOptions{})
+	assert.Nil(t, err)
+	assert.True(t, sensor.Status.GetCondition(v1alpha1.TriggerCircuitCondition(triggerName)).IsTrue())
+}
+
+func TestResetCircuitStatus(t *testing.T) {
+	obj := sensorObj.DeepCopy()
+	trigger := fakeTrigger.DeepCopy()
+	trigger.CircuitBreaker = &v1alpha1.CircuitBreaker{FailureThreshold: 3}
+	obj.Spec.Triggers = []v1alpha1.Trigger{*trigger}
+	obj.Status.MarkTriggerCircuitOpen(trigger.Template.Name, "Too many consecutive failures.")
+	obj.Status.MarkTriggerCircuitOpen("removed-trigger", "Too many consecutive failures.")
+	sensorCtx := &SensorContext{
+		Sensor:       obj,
+		SensorClient: fakesensor.NewSimpleClientset(obj),
+	}
+
+	sensorCtx.resetCircuitStatus(context.Background(), []string{trigger.Template.Name})
+	sensor, err := sensorCtx.SensorClient.ArgoprojV1alpha1().Sensors(obj.Namespace).Get(obj.Name, metav1.GetOptions{})
+	assert.Nil(t, err)
+	assert.True(t, sensor.Status.GetCondition(v1alpha1.TriggerCircuitCondition(trigger.Template.Name)).IsTrue())
+	assert.Nil(t, sensor.Status.GetCondition(v1alpha1.TriggerCircuitCondition("removed-trigger")))
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"net/http"
+	"sync"
+
+	"cloud.google.com/go/pubsub"
+	"github.com/Shopify/sarama"
+	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/sns/snsiface"
+	"github.com/aws/aws-sdk-go/service/sqs/sqsiface"
+	mqttlib "github.com/eclipse/paho.mqtt.golang"
+	"github.com/go-redis/redis"
+	"github.com/minio/minio-go"
+	natslib "github.com/nats-io/go-nats"
+	amqplib "github.com/streadway/amqp"
+	"google.golang.org/grpc"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sensors/triggers/mqtt"
+)
+
+// clientSet holds the clients of a trigger, or the client of an MQTT broker shared by the triggers publishing to it.
+// The maps are the ones the trigger constructors expect, each of them holds the clients of the set only.
+type clientSet struct {
+	// lock serializes the creation of the clients, so that connecting to a slow server doesn't block the other triggers
+	lock sync.Mutex
+	// inUse is held for reading by the executions using the clients, and for writing to close them
+	inUse sync.RWMutex
+
+	httpClients          map[string]*http.Client
+	customTriggerClients map[string]*grpc.ClientConn
+	kafkaProducers       map[string]sarama.AsyncProducer
+	kafkaSyncProducers   map[string]sarama.SyncProducer
+	natsConnections      map[string]*natslib.Conn
+	pubsubClients        map[string]*pubsub.Client
+	amqpConnections      map[string]*amqplib.Connection
+	mqttClients          map[string]mqttlib.Client
+	pulsarClients        map[string]pulsar.Client
+	pulsarProducers      map[string]pulsar.Producer
+	redisClients         map[string]*redis.Client
+	awsLambdaClients     map[string]*lambda.Lambda
+	awsSQSClients        map[string]sqsiface.SQSAPI
+	awsSNSClients        map[string]snsiface.SNSAPI
+	openwhiskClients     map[string]*whisk.Client
+	minioClients         map[string]*minio.Client
+}
+
+func newClientSet() *clientSet {
+	return &clientSet{
+		httpClients:          make(map[string]*http.Client),
+		customTriggerClients: make(map[string]*grpc.ClientConn),
+		kafkaProducers:       make(map[string]sarama.AsyncProducer),
+		kafkaSyncProducers:   make(map[string]sarama.SyncProducer),
+		natsConnections:      make(map[string]*natslib.Conn),
+		pubsubClients:        make(map[string]*pubsub.Client),
+		amqpConnections:      make(map[string]*amqplib.Connection),
+		mqttClients:          make(map[string]mqttlib.Client),
+		pulsarClients:        make(map[string]pulsar.Client),
+		pulsarProducers:      make(map[string]pulsar.Producer),
+		redisClients:         make(map[string]*redis.Client),
+		awsLambdaClients:     make(map[string]*lambda.Lambda),
+		awsSQSClients:        make(map[string]sqsiface.SQSAPI),
+		awsSNSClients:        make(map[string]snsiface.SNSAPI),
+		openwhiskClients:     make(map[string]*whisk.Client),
+		minioClients:         make(map[string]*minio.Client),
+	}
+}
+
+// release marks the end of an execution using the clients.
+func (c *clientSet) release() {
+	c.inUse.RUnlock()
+}
+
+// close waits for the executions using the clients to be done and closes the clients.
+func (c *clientSet) close() {
+	c.inUse.Lock()
+	defer c.inUse.Unlock()
+	for _, conn := range c.customTriggerClients {
+		_ = conn.Close()
+	}
+	for _, producer := range c.kafkaProducers {
+		_ = producer.Close()
+	}
+	for _, producer := range c.kafkaSyncProducers {
+		_ = producer.Close()
+	}
+	for _, conn := range c.natsConnections {
+		conn.Close()
+	}
+	for _, client := range c.pubsubClients {
+		_ = client.Close()
+	}
+	for _, conn := range c.amqpConnections {
+		_ = conn.Close()
+	}
+	for _, client := range c.mqttClients {
+		client.Disconnect(250)
+	}
+	for _, producer := range c.pulsarProducers {
+		producer.Close()
+	}
+	for _, client := range c.pulsarClients {
+		client.Close()
+	}
+	for _, client := range c.redisClients {
+		_ = client.Close()
+	}
+}
+
+// acquireClients returns the client set of the trigger, which is kept open until the returned set is released.
+// The MQTT triggers share the client set of their broker, the other triggers have their own.
+func (sensorCtx *SensorContext) acquireClients(trigger *v1alpha1.Trigger) *clientSet {
+	sensorCtx.clientsLock.Lock()
+	defer sensorCtx.clientsLock.Unlock()
+	if sensorCtx.triggerClients == nil {
+		sensorCtx.triggerClients = make(map[string]*clientSet)
+	}
+	if sensorCtx.mqttClients == nil {
+		sensorCtx.mqttClients = make(map[string]*clientSet)
+	}
+	sets, key := sensorCtx.triggerClients, trigger.Template.Name
+	if trigger.Template.MQTT != nil {
+		sets, key = sensorCtx.mqttClients, mqtt.ClientKey(trigger.Template.MQTT)
+	}
+	clients, ok := sets[key]
+	if !ok {
+		clients = newClientSet()
+		sets[key] = clients
+	}
+	// The sets are only closed once they are removed from the maps, so this never waits for a close.
+	clients.inUse.RLock()
+	return clients
+}
+
+// closeTriggerClients removes the client set of the trigger, so that the next executions create new clients, and
+// closes it in the background once the executions using it are done.
+func (sensorCtx *SensorContext) closeTriggerClients(triggerName string) {
+	sensorCtx.clientsLock.Lock()
+	clients, ok := sensorCtx.triggerClients[triggerName]
+	delete(sensorCtx.triggerClients, triggerName)
+	sensorCtx.clientsLock.Unlock()
+	if ok {
+		go clients.close()
+	}
+}
+
+// closeUnusedMQTTClients closes the MQTT clients that are no longer used by any trigger. The MQTT clients are
+// shared by the triggers publishing to the same broker, so they are not closed along with a changed trigger.
+func (sensorCtx *SensorContext) closeUnusedMQTTClients(triggers []v1alpha1.Trigger) {
+	used := make(map[string]bool)
+	for _, t := range triggers {
+		if t.Template != nil && t.Template.MQTT != nil {
+			used[mqtt.ClientKey(t.Template.MQTT)] = true
+		}
+	}
+
+	sensorCtx.clientsLock.Lock()
+	defer sensorCtx.clientsLock.Unlock()
+	for key, clients := range sensorCtx.mqttClients {
+		if !used[key] {
+			delete(sensorCtx.mqttClients, key)
+			go clients.close()
+		}
+	}
+}
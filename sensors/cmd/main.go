@@ -23,6 +23,7 @@ import (
 	"os"
 
 	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"sigs.k8s.io/controller-runtime/pkg/manager/signals"
@@ -31,6 +32,8 @@ import (
 	"github.com/argoproj/argo-events/common/logging"
 	eventbusv1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
 	v1alpha1 "github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	sensorclient "github.com/argoproj/argo-events/pkg/client/sensor/clientset/versioned"
+	sensorscheme "github.com/argoproj/argo-events/pkg/client/sensor/clientset/versioned/scheme"
 	"github.com/argoproj/argo-events/sensors"
 )
 
@@ -73,8 +76,18 @@ func main() {
 	}
 
 	dynamicClient := dynamic.NewForConfigOrDie(restConfig)
+	sensorClient := sensorclient.NewForConfigOrDie(restConfig)
+	// Start from the latest sensor object, the one in the environment is not updated on the changes applied in place.
+	latestSensor, err := sensorClient.ArgoprojV1alpha1().Sensors(sensor.Namespace).Get(sensor.Name, metav1.GetOptions{})
+	if err != nil {
+		logger.Desugar().Warn("failed to get the latest sensor object, starting from the sensor object in the environment", zap.Error(err))
+	} else {
+		sensor = latestSensor
+	}
+
+	recorder := common.NewEventRecorder(kubeClient, sensorscheme.Scheme, "sensor", logger)
 
-	sensorExecutionCtx := sensors.NewSensorContext(kubeClient, dynamicClient, sensor, busConfig, ebSubject)
+	sensorExecutionCtx := sensors.NewSensorContext(kubeClient, dynamicClient, sensorClient, recorder, sensor, busConfig, ebSubject)
 	logger = logger.With("sensorName", sensor.Name)
 	ctx := logging.WithLogger(context.Background(), logger)
 	stopCh := signals.SetupSignalHandler()
@@ -18,18 +18,17 @@ limitations under the License.
 
 import (
 	"net/http"
+	"sync"
 	"time"
 
-	"github.com/Shopify/sarama"
-	"github.com/apache/openwhisk-client-go/whisk"
-	"github.com/aws/aws-sdk-go/service/lambda"
-	natslib "github.com/nats-io/go-nats"
-	"google.golang.org/grpc"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/record"
 
+	eventbusdriver "github.com/argoproj/argo-events/eventbus/driver"
 	eventbusv1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
 	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	sensorclient "github.com/argoproj/argo-events/pkg/client/sensor/clientset/versioned"
 )
 
 // SensorContext contains execution context for Sensor
@@ -38,44 +37,84 @@ type SensorContext struct {
 	KubeClient kubernetes.Interface
 	// ClientPool manages a pool of dynamic clients.
 	DynamicClient dynamic.Interface
+	// SensorClient is the client to update the sensor status
+	SensorClient sensorclient.Interface
+	// Recorder records the Kubernetes events of the sensor
+	Recorder record.EventRecorder
 	// Sensor object
 	Sensor *v1alpha1.Sensor
 	// EventBus config
 	EventBusConfig *eventbusv1alpha1.BusConfig
 	// EventBus subject
 	EventBusSubject string
-	// httpClients holds the reference to HTTP clients for HTTP triggers.
-	httpClients map[string]*http.Client
-	// customTriggerClients holds the references to the gRPC clients for the custom trigger servers
-	customTriggerClients map[string]*grpc.ClientConn
+	// triggerClients holds the client sets of the triggers, keyed by trigger name.
+	triggerClients map[string]*clientSet
+	// mqttClients holds the client sets of the MQTT brokers, shared by the MQTT triggers publishing to the same broker.
+	mqttClients map[string]*clientSet
 	// http client to send slack messages.
 	slackHTTPClient *http.Client
-	// kafkaProducers holds references to the active kafka producers
-	kafkaProducers map[string]sarama.AsyncProducer
-	// natsConnections holds the references to the active nats connections.
-	natsConnections map[string]*natslib.Conn
-	// awsLambdaClients holds the references to active AWS Lambda clients.
-	awsLambdaClients map[string]*lambda.Lambda
-	// openwhiskClients holds the references to active OpenWhisk clients.
-	openwhiskClients map[string]*whisk.Client
+	// eventBusDriver is the EventBus driver used by the EventBus triggers.
+	eventBusDriver eventbusdriver.Driver
+	// eventBusConn is the EventBus connection shared by the EventBus triggers to publish the events.
+	eventBusConn eventbusdriver.Connection
+	// circuitBreakers holds the circuit breakers of the triggers, keyed by trigger name.
+	circuitBreakers map[string]*circuitBreaker
+	// lock guards the circuit breakers
+	lock sync.Mutex
+	// workers executes the trigger actions if the sensor limits the number of concurrent trigger executions
+	workers *triggerWorkerPool
+	// triggerHistory holds the trigger executions that are not yet written to the sensor status
+	triggerHistory triggerHistory
+	// dependencyGroups holds the running dependency groups, keyed by dependency expression
+	dependencyGroups map[string]*dependencyGroup
+	// sensorLock guards the sensor object and the worker pool, which are replaced when the sensor spec is reloaded
+	sensorLock sync.RWMutex
+	// clientsLock guards the client sets and the concurrency locks. It is not held while the clients connect.
+	clientsLock sync.Mutex
+	// eventBusLock guards the EventBus publisher of the EventBus triggers
+	eventBusLock sync.Mutex
+	// suspendBuffer holds the trigger executions resolved while the sensor is suspended
+	suspendBuffer suspendBuffer
+	// garbageNamespaces holds the namespaces of the resources created by the triggers with a garbage collection policy
+	garbageNamespaces garbageNamespaces
+	// statusForbidden warns once if the sensor is not allowed to update its status
+	statusForbidden sync.Once
+	// rolledOnChange is set if the sensor pod can't watch its sensor, in which case the spec changes are applied by
+	// rolling the sensor deployment
+	rolledOnChange bool
+	// concurrencyLocks serialize the concurrency policy of the K8s and Argo Workflow triggers, keyed by trigger name
+	concurrencyLocks map[string]*sync.Mutex
 }
 
 // NewSensorContext returns a new sensor execution context.
-func NewSensorContext(kubeClient kubernetes.Interface, dynamicClient dynamic.Interface, sensor *v1alpha1.Sensor, eventBusConfig *eventbusv1alpha1.BusConfig, eventBusSubject string) *SensorContext {
+func NewSensorContext(kubeClient kubernetes.Interface, dynamicClient dynamic.Interface, sensorClient sensorclient.Interface, recorder record.EventRecorder, sensor *v1alpha1.Sensor, eventBusConfig *eventbusv1alpha1.BusConfig, eventBusSubject string) *SensorContext {
 	return &SensorContext{
-		KubeClient:           kubeClient,
-		DynamicClient:        dynamicClient,
-		Sensor:               sensor,
-		EventBusConfig:       eventBusConfig,
-		EventBusSubject:      eventBusSubject,
-		httpClients:          make(map[string]*http.Client),
-		customTriggerClients: make(map[string]*grpc.ClientConn),
+		KubeClient:      kubeClient,
+		DynamicClient:   dynamicClient,
+		SensorClient:    sensorClient,
+		Recorder:        recorder,
+		Sensor:          sensor,
+		EventBusConfig:  eventBusConfig,
+		EventBusSubject: eventBusSubject,
+		triggerClients:  make(map[string]*clientSet),
+		mqttClients:     make(map[string]*clientSet),
 		slackHTTPClient: &http.Client{
 			Timeout: time.Minute * 5,
 		},
-		kafkaProducers:   make(map[string]sarama.AsyncProducer),
-		natsConnections:  make(map[string]*natslib.Conn),
-		awsLambdaClients: make(map[string]*lambda.Lambda),
-		openwhiskClients: make(map[string]*whisk.Client),
+		circuitBreakers: make(map[string]*circuitBreaker),
 	}
 }
+
+// getSensor returns the latest sensor object.
+func (sensorCtx *SensorContext) getSensor() *v1alpha1.Sensor {
+	sensorCtx.sensorLock.RLock()
+	defer sensorCtx.sensorLock.RUnlock()
+	return sensorCtx.Sensor
+}
+
+// setSensor replaces the sensor object.
+func (sensorCtx *SensorContext) setSensor(sensor *v1alpha1.Sensor) {
+	sensorCtx.sensorLock.Lock()
+	defer sensorCtx.sensorLock.Unlock()
+	sensorCtx.Sensor = sensor
+}
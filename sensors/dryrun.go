@@ -0,0 +1,119 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/common/logging"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	sensortriggers "github.com/argoproj/argo-events/sensors/triggers"
+	"github.com/argoproj/argo-events/sensors/triggers/amqp"
+	awssns "github.com/argoproj/argo-events/sensors/triggers/aws-sns"
+	awssqs "github.com/argoproj/argo-events/sensors/triggers/aws-sqs"
+	eventbustrigger "github.com/argoproj/argo-events/sensors/triggers/eventbus"
+	gcppubsub "github.com/argoproj/argo-events/sensors/triggers/gcp-pubsub"
+	"github.com/argoproj/argo-events/sensors/triggers/kafka"
+	"github.com/argoproj/argo-events/sensors/triggers/mqtt"
+	"github.com/argoproj/argo-events/sensors/triggers/nats"
+	objectstorage "github.com/argoproj/argo-events/sensors/triggers/object-storage"
+	"github.com/argoproj/argo-events/sensors/triggers/pulsar"
+	"github.com/argoproj/argo-events/sensors/triggers/redis"
+)
+
+// maxDryRunEventMessageLength is the maximum length of the rendered trigger in the message of a Kubernetes event.
+const maxDryRunEventMessageLength = 512
+
+// payloadTrigger is implemented by the trigger resources that send a payload built from the events.
+type payloadTrigger interface {
+	GetPayload() []v1alpha1.TriggerParameter
+}
+
+// isDryRun returns true if the trigger must be rendered instead of executed.
+func (sensorCtx *SensorContext) isDryRun(trigger *v1alpha1.Trigger) bool {
+	return sensorCtx.getSensor().Spec.DryRun || trigger.DryRun
+}
+
+// renderDryRun records the trigger resource rendered from the events, along with the payload the trigger would send.
+func (sensorCtx *SensorContext) renderDryRun(ctx context.Context, trigger *v1alpha1.Trigger, events map[string]*v1alpha1.Event, resource interface{}) error {
+	resourceBytes, err := json.Marshal(resource)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal the rendered trigger resource")
+	}
+
+	var payload []v1alpha1.TriggerParameter
+	if r, ok := resource.(payloadTrigger); ok {
+		payload = r.GetPayload()
+	}
+	var payloadBytes []byte
+	if payload != nil {
+		payloadBytes, err = sensortriggers.ConstructPayload(events, payload)
+		if err != nil {
+			return err
+		}
+	}
+
+	logging.FromContext(ctx).Infow("dry run, skipping the trigger execution", "triggerName", trigger.Template.Name,
+		"resource", string(resourceBytes), "payload", string(payloadBytes))
+
+	rendered := string(resourceBytes)
+	if payloadBytes != nil {
+		rendered = string(payloadBytes)
+	}
+	if len(rendered) > maxDryRunEventMessageLength {
+		rendered = rendered[:maxDryRunEventMessageLength] + "..."
+	}
+	sensorCtx.recordEvent(corev1.EventTypeNormal, common.EventReasonTriggerDryRun, "Dry run of trigger %s rendered %s", trigger.Template.Name, rendered)
+	return nil
+}
+
+// newDryRunTrigger returns the trigger to render in a dry run, without the clients connecting to the external systems,
+// as a dry run doesn't send anything. It returns nil for the triggers that don't connect when they are created.
+func newDryRunTrigger(sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, log *zap.Logger) Trigger {
+	template := trigger.Template
+	switch {
+	case template.AWSSQS != nil:
+		return &awssqs.AWSSQSTrigger{Sensor: sensor, Trigger: trigger, Logger: log}
+	case template.AWSSNS != nil:
+		return &awssns.AWSSNSTrigger{Sensor: sensor, Trigger: trigger, Logger: log}
+	case template.GCPPubSub != nil:
+		return &gcppubsub.GCPPubSubTrigger{Sensor: sensor, Trigger: trigger, Logger: log}
+	case template.Kafka != nil:
+		return &kafka.KafkaTrigger{Sensor: sensor, Trigger: trigger, Logger: log}
+	case template.NATS != nil:
+		return &nats.NATSTrigger{Sensor: sensor, Trigger: trigger, Logger: log}
+	case template.AMQP != nil:
+		return &amqp.AMQPTrigger{Sensor: sensor, Trigger: trigger, Logger: log}
+	case template.MQTT != nil:
+		return &mqtt.MQTTTrigger{Sensor: sensor, Trigger: trigger, Logger: log}
+	case template.Pulsar != nil:
+		return &pulsar.PulsarTrigger{Sensor: sensor, Trigger: trigger, Logger: log}
+	case template.Redis != nil:
+		return &redis.RedisTrigger{Sensor: sensor, Trigger: trigger, Logger: log}
+	case template.EventBus != nil:
+		return &eventbustrigger.EventBusTrigger{Sensor: sensor, Trigger: trigger, Logger: log}
+	case template.ObjectStorage != nil:
+		return &objectstorage.ObjectStorageTrigger{Sensor: sensor, Trigger: trigger, Logger: log}
+	}
+	return nil
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/argoproj/argo-events/common"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+func TestDryRun(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+	}))
+	defer server.Close()
+
+	trigger := &v1alpha1.Trigger{
+		Template: &v1alpha1.TriggerTemplate{
+			Name: "http-trigger",
+			HTTP: &v1alpha1.HTTPTrigger{
+				URL:    server.URL,
+				Method: http.MethodPost,
+				Payload: []v1alpha1.TriggerParameter{
+					{
+						Src: &v1alpha1.TriggerParameterSource{
+							DependencyName: "fake-dependency",
+							DataKey:        "firstName",
+						},
+						Dest: "name",
+					},
+				},
+			},
+		},
+		DryRun: true,
+	}
+	events := map[string]*v1alpha1.Event{
+		"fake-dependency": {
+			Context: &v1alpha1.EventContext{
+				ID:              "1",
+				DataContentType: common.MediaTypeJSON,
+			},
+			Data: []byte("{\"firstName\": \"fake\"}"),
+		},
+	}
+	recorder := record.NewFakeRecorder(1)
+	sensorCtx := &SensorContext{
+		Sensor:   sensorObj.DeepCopy(),
+		Recorder: recorder,
+	}
+
+	assert.True(t, sensorCtx.isDryRun(trigger))
+	err := sensorCtx.processTrigger(context.Background(), trigger, events)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&requests))
+	event := <-recorder.Events
+	assert.True(t, strings.HasPrefix(event, "Normal "+common.EventReasonTriggerDryRun))
+	assert.Contains(t, event, `{"name":"fake"}`)
+
+	trigger.DryRun = false
+	assert.False(t, sensorCtx.isDryRun(trigger))
+	err = sensorCtx.processTrigger(context.Background(), trigger, events)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+}
+
+func TestDryRunPayload(t *testing.T) {
+	payload := []v1alpha1.TriggerParameter{
+		{
+			Src: &v1alpha1.TriggerParameterSource{
+				DependencyName: "fake-dependency",
+				DataKey:        "firstName",
+			},
+			Dest: "name",
+		},
+	}
+	events := map[string]*v1alpha1.Event{
+		"fake-dependency": {
+			Context: &v1alpha1.EventContext{
+				ID:              "1",
+				DataContentType: common.MediaTypeJSON,
+			},
+			Data: []byte("{\"firstName\": \"fake\"}"),
+		},
+	}
+	templates := map[string]*v1alpha1.TriggerTemplate{
+		"sqs": {
+			AWSSQS: &v1alpha1.AWSSQSTrigger{Queue: "fake", Region: "us-east-1", Payload: payload},
+		},
+		"sns": {
+			AWSSNS: &v1alpha1.AWSSNSTrigger{TopicArn: "arn:aws:sns:us-east-1:123456789012:fake", Region: "us-east-1", Payload: payload},
+		},
+		"pubsub": {
+			GCPPubSub: &v1alpha1.GCPPubSubTrigger{ProjectID: "fake", Topic: "fake", Payload: payload},
+		},
+		"amqp": {
+			AMQP: &v1alpha1.AMQPTrigger{URL: "amqp://localhost:5672", ExchangeName: "fake", RoutingKey: "fake", Payload: payload},
+		},
+		"mqtt": {
+			MQTT: &v1alpha1.MQTTTrigger{URL: "tcp://localhost:1883", Topic: "fake", Payload: payload},
+		},
+		"pulsar": {
+			Pulsar: &v1alpha1.PulsarTrigger{URL: "pulsar://localhost:6650", Topic: "fake", Payload: payload},
+		},
+		"redis": {
+			Redis: &v1alpha1.RedisTrigger{HostAddress: "localhost:6379", Channel: "fake", Payload: payload},
+		},
+		"eventbus": {
+			EventBus: &v1alpha1.EventBusTrigger{Source: "fake", Subject: "fake", Payload: payload},
+		},
+		"log": {
+			Log: &v1alpha1.LogTrigger{Payload: payload},
+		},
+		"object-storage": {
+			ObjectStorage: &v1alpha1.ObjectStorageTrigger{
+				S3: &apicommon.S3Artifact{
+					Endpoint: "localhost:9000",
+					Bucket:   &apicommon.S3Bucket{Name: "fake", Key: "fake.json"},
+				},
+				Payload: payload,
+			},
+		},
+	}
+	for name, template := range templates {
+		t.Run(name, func(t *testing.T) {
+			template.Name = name
+			trigger := &v1alpha1.Trigger{Template: template, DryRun: true}
+			recorder := record.NewFakeRecorder(1)
+			sensorCtx := &SensorContext{
+				Sensor:   sensorObj.DeepCopy(),
+				Recorder: recorder,
+			}
+			err := sensorCtx.processTrigger(context.Background(), trigger, events)
+			assert.Nil(t, err)
+			event := <-recorder.Events
+			assert.True(t, strings.HasPrefix(event, "Normal "+common.EventReasonTriggerDryRun))
+			assert.Contains(t, event, `{"name":"fake"}`)
+		})
+	}
+}
@@ -0,0 +1,229 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/antonmedv/expr/vm"
+	"go.uber.org/zap"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/common/logging"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sensors/triggers"
+)
+
+// defaultGarbageCollectionInterval is the interval between two garbage collections of the resources created by the triggers.
+const defaultGarbageCollectionInterval = time.Minute
+
+// garbageNamespaces tracks the namespaces of the resources created by the triggers, keyed by trigger name.
+type garbageNamespaces struct {
+	namespaces map[string]map[string]bool
+	lock       sync.Mutex
+}
+
+// add records the namespace of a resource created by the trigger.
+func (g *garbageNamespaces) add(triggerName, namespace string) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	if g.namespaces == nil {
+		g.namespaces = make(map[string]map[string]bool)
+	}
+	if g.namespaces[triggerName] == nil {
+		g.namespaces[triggerName] = make(map[string]bool)
+	}
+	g.namespaces[triggerName][namespace] = true
+}
+
+// get returns the namespaces of the resources created by the trigger, along with the namespace of the sensor.
+func (g *garbageNamespaces) get(triggerName, sensorNamespace string) []string {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	namespaces := []string{sensorNamespace}
+	for namespace := range g.namespaces[triggerName] {
+		if namespace != sensorNamespace {
+			namespaces = append(namespaces, namespace)
+		}
+	}
+	sort.Strings(namespaces[1:])
+	return namespaces
+}
+
+// garbageCollectionTarget returns the garbage collection policy of the trigger, the resource it creates and
+// the condition identifying the completed resources.
+func garbageCollectionTarget(trigger *v1alpha1.Trigger) (*v1alpha1.GarbageCollection, schema.GroupVersionResource, string) {
+	switch {
+	case trigger.Template.K8s != nil && trigger.Template.K8s.GarbageCollection != nil:
+		gc := trigger.Template.K8s.GarbageCollection
+		gvr := trigger.Template.K8s.GroupVersionResource
+		return gc, schema.GroupVersionResource{Group: gvr.Group, Version: gvr.Version, Resource: gvr.Resource}, gc.CompletionCondition
+	case trigger.Template.ArgoWorkflow != nil && trigger.Template.ArgoWorkflow.GarbageCollection != nil:
+		gc := trigger.Template.ArgoWorkflow.GarbageCollection
+		gvr := trigger.Template.ArgoWorkflow.GroupVersionResource
+		condition := gc.CompletionCondition
+		if condition == "" {
+			condition = triggers.WorkflowCompletionCondition
+		}
+		return gc, schema.GroupVersionResource{Group: gvr.Group, Version: gvr.Version, Resource: gvr.Resource}, condition
+	default:
+		return nil, schema.GroupVersionResource{}, ""
+	}
+}
+
+// trackTemplateNamespace records the namespace of the resource template of the trigger, so that the garbage collection
+// finds the resources created before the sensor pod started. Only the inline and resource sources are looked at, the
+// other sources, as well as namespaces set by parameters, are only known once the trigger creates a resource.
+func (sensorCtx *SensorContext) trackTemplateNamespace(trigger *v1alpha1.Trigger) {
+	var source *v1alpha1.ArtifactLocation
+	switch {
+	case trigger.Template.K8s != nil:
+		source = trigger.Template.K8s.Source
+	case trigger.Template.ArgoWorkflow != nil:
+		source = trigger.Template.ArgoWorkflow.Source
+	}
+	if source == nil || (source.Inline == nil && source.Resource == nil) {
+		return
+	}
+	obj, err := triggers.FetchKubernetesResource(source)
+	if err != nil || obj.GetNamespace() == "" {
+		return
+	}
+	sensorCtx.garbageNamespaces.add(trigger.Template.Name, obj.GetNamespace())
+}
+
+// trackTriggerResource records the namespace of the resource created by the trigger, so that the garbage
+// collection looks for the resources of the trigger in it.
+func (sensorCtx *SensorContext) trackTriggerResource(trigger *v1alpha1.Trigger, resource interface{}) {
+	if gc, _, _ := garbageCollectionTarget(trigger); gc == nil {
+		return
+	}
+	if obj, ok := resource.(*unstructured.Unstructured); ok && obj.GetNamespace() != "" {
+		sensorCtx.garbageNamespaces.add(trigger.Template.Name, obj.GetNamespace())
+	}
+}
+
+// runGarbageCollection deletes the resources created by the triggers as per their garbage collection policy,
+// until the context is done.
+func (sensorCtx *SensorContext) runGarbageCollection(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			sensorCtx.collectGarbage(ctx)
+		}
+	}
+}
+
+// collectGarbage deletes the expired resources of the triggers with a garbage collection policy.
+func (sensorCtx *SensorContext) collectGarbage(ctx context.Context) {
+	log := logging.FromContext(ctx)
+	sensor := sensorCtx.getSensor()
+	for i := range sensor.Spec.Triggers {
+		trigger := &sensor.Spec.Triggers[i]
+		gc, gvr, condition := garbageCollectionTarget(trigger)
+		if gc == nil || (gc.TTLSecondsAfterCompletion == nil && gc.Retain <= 0) {
+			continue
+		}
+		completion, err := common.CompileCondition(condition)
+		if err != nil {
+			log.Errorw("failed to compile the completion condition", "triggerName", trigger.Template.Name, "error", err)
+			continue
+		}
+		sensorCtx.trackTemplateNamespace(trigger)
+		for _, namespace := range sensorCtx.garbageNamespaces.get(trigger.Template.Name, sensor.Namespace) {
+			if err := sensorCtx.collectTriggerGarbage(ctx, sensor, trigger.Template.Name, gc, gvr, namespace, completion); err != nil {
+				log.Errorw("failed to collect the garbage of the trigger", "triggerName", trigger.Template.Name, "namespace", namespace, "error", err)
+			}
+		}
+	}
+}
+
+// collectTriggerGarbage deletes the resources of the trigger in the namespace that are either older than the retained
+// resources, or completed for longer than the TTL. Resources are stamped with the time they are first seen completed.
+func (sensorCtx *SensorContext) collectTriggerGarbage(ctx context.Context, sensor *v1alpha1.Sensor, triggerName string, gc *v1alpha1.GarbageCollection, gvr schema.GroupVersionResource, namespace string, completion *vm.Program) error {
+	log := logging.FromContext(ctx).Desugar()
+	client := sensorCtx.DynamicClient.Resource(gvr).Namespace(namespace)
+	list, err := client.List(metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labels.Set{
+			triggers.LabelSensor:  sensor.Name,
+			triggers.LabelTrigger: triggerName,
+		}).String(),
+	})
+	if err != nil {
+		return err
+	}
+
+	items := list.Items
+	// newest first
+	sort.SliceStable(items, func(i, j int) bool {
+		ti, tj := items[i].GetCreationTimestamp(), items[j].GetCreationTimestamp()
+		if !ti.Equal(&tj) {
+			return tj.Before(&ti)
+		}
+		return items[i].GetLabels()[triggers.LabelActionTimestamp] > items[j].GetLabels()[triggers.LabelActionTimestamp]
+	})
+
+	now := time.Now()
+	for i := range items {
+		obj := &items[i]
+		completed := completion != nil && common.EvaluateCondition(completion, obj)
+		expired := false
+		if gc.Retain > 0 && i >= int(gc.Retain) && (completion == nil || completed) {
+			expired = true
+		}
+		if !expired && completed && gc.TTLSecondsAfterCompletion != nil {
+			completedAt, ok := obj.GetLabels()[triggers.LabelCompletedAt]
+			if !ok {
+				patch := fmt.Sprintf(`{"metadata":{"labels":{%q:%q}}}`, triggers.LabelCompletedAt, strconv.FormatInt(now.Unix(), 10))
+				if _, err := client.Patch(obj.GetName(), types.MergePatchType, []byte(patch), metav1.PatchOptions{}); err != nil && !apierrors.IsNotFound(err) {
+					return err
+				}
+				continue
+			}
+			seconds, err := strconv.ParseInt(completedAt, 10, 64)
+			if err != nil {
+				log.Warn("invalid completion time of the resource", zap.String("name", obj.GetName()), zap.String("completedAt", completedAt))
+				continue
+			}
+			expired = now.Sub(time.Unix(seconds, 0)) >= time.Duration(*gc.TTLSecondsAfterCompletion)*time.Second
+		}
+		if !expired {
+			continue
+		}
+		log.Info("deleting the resource created by the trigger", zap.String("triggerName", triggerName), zap.String("namespace", namespace), zap.String("name", obj.GetName()))
+		propagation := metav1.DeletePropagationBackground
+		if err := client.Delete(obj.GetName(), &metav1.DeleteOptions{PropagationPolicy: &propagation}); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
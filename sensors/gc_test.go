@@ -0,0 +1,158 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sensors/triggers"
+)
+
+func newTriggerResource(name, triggerName, phase string, created time.Time) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"status": map[string]interface{}{
+				"phase": phase,
+			},
+		},
+	}
+	obj.SetName(name)
+	obj.SetNamespace("fake")
+	obj.SetCreationTimestamp(metav1.NewTime(created))
+	obj.SetLabels(map[string]string{
+		triggers.LabelSensor:  "fake-sensor",
+		triggers.LabelTrigger: triggerName,
+	})
+	return obj
+}
+
+func listTriggerResources(t *testing.T, sensorCtx *SensorContext) []string {
+	list, err := sensorCtx.DynamicClient.Resource(schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}).Namespace("fake").List(metav1.ListOptions{})
+	assert.Nil(t, err)
+	var names []string
+	for _, item := range list.Items {
+		names = append(names, item.GetName())
+	}
+	return names
+}
+
+func TestCollectGarbage(t *testing.T) {
+	now := time.Now()
+
+	t.Run("retain the newest resources", func(t *testing.T) {
+		obj := sensorObj.DeepCopy()
+		obj.Spec.Triggers[0].Template.K8s.GarbageCollection = &v1alpha1.GarbageCollection{
+			Retain: 2,
+		}
+		sensorCtx := &SensorContext{
+			Sensor: obj,
+			DynamicClient: dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(),
+				newTriggerResource("oldest", "fake-trigger", "", now.Add(-3*time.Minute)),
+				newTriggerResource("older", "fake-trigger", "", now.Add(-2*time.Minute)),
+				newTriggerResource("newest", "fake-trigger", "", now.Add(-1*time.Minute)),
+				newTriggerResource("other", "other-trigger", "", now.Add(-4*time.Minute)),
+			),
+		}
+		sensorCtx.collectGarbage(context.Background())
+		assert.ElementsMatch(t, []string{"older", "newest", "other"}, listTriggerResources(t, sensorCtx))
+	})
+
+	t.Run("retain only deletes completed resources", func(t *testing.T) {
+		obj := sensorObj.DeepCopy()
+		obj.Spec.Triggers[0].Template.K8s.GarbageCollection = &v1alpha1.GarbageCollection{
+			Retain:              1,
+			CompletionCondition: `status.phase == "Succeeded"`,
+		}
+		sensorCtx := &SensorContext{
+			Sensor: obj,
+			DynamicClient: dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(),
+				newTriggerResource("running", "fake-trigger", "Running", now.Add(-3*time.Minute)),
+				newTriggerResource("succeeded", "fake-trigger", "Succeeded", now.Add(-2*time.Minute)),
+				newTriggerResource("newest", "fake-trigger", "Running", now.Add(-1*time.Minute)),
+			),
+		}
+		sensorCtx.collectGarbage(context.Background())
+		assert.ElementsMatch(t, []string{"running", "newest"}, listTriggerResources(t, sensorCtx))
+	})
+
+	t.Run("ttl after completion", func(t *testing.T) {
+		obj := sensorObj.DeepCopy()
+		ttl := int32(0)
+		obj.Spec.Triggers[0].Template.K8s.GarbageCollection = &v1alpha1.GarbageCollection{
+			TTLSecondsAfterCompletion: &ttl,
+			CompletionCondition:       `status.phase == "Succeeded"`,
+		}
+		sensorCtx := &SensorContext{
+			Sensor: obj,
+			DynamicClient: dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(),
+				newTriggerResource("running", "fake-trigger", "Running", now.Add(-2*time.Minute)),
+				newTriggerResource("succeeded", "fake-trigger", "Succeeded", now.Add(-1*time.Minute)),
+			),
+		}
+		// the first collection stamps the completion time
+		sensorCtx.collectGarbage(context.Background())
+		assert.ElementsMatch(t, []string{"running", "succeeded"}, listTriggerResources(t, sensorCtx))
+		stamped, err := sensorCtx.DynamicClient.Resource(schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}).Namespace("fake").Get("succeeded", metav1.GetOptions{})
+		assert.Nil(t, err)
+		assert.NotEmpty(t, stamped.GetLabels()[triggers.LabelCompletedAt])
+
+		sensorCtx.collectGarbage(context.Background())
+		assert.ElementsMatch(t, []string{"running"}, listTriggerResources(t, sensorCtx))
+	})
+
+	t.Run("tracked namespaces", func(t *testing.T) {
+		obj := sensorObj.DeepCopy()
+		obj.Spec.Triggers[0].Template.K8s.GarbageCollection = &v1alpha1.GarbageCollection{
+			Retain: 1,
+		}
+		sensorCtx := &SensorContext{
+			Sensor: obj,
+		}
+		resource := newTriggerResource("test", "fake-trigger", "", now)
+		resource.SetNamespace("other")
+		sensorCtx.trackTriggerResource(&obj.Spec.Triggers[0], resource)
+		sensorCtx.trackTriggerResource(fakeTrigger, newTriggerResource("test", "fake-trigger", "", now))
+		assert.Equal(t, []string{"fake", "other"}, sensorCtx.garbageNamespaces.get("fake-trigger", "fake"))
+	})
+
+	t.Run("namespace of the template", func(t *testing.T) {
+		obj := sensorObj.DeepCopy()
+		obj.Spec.Triggers[0].Template.K8s.GarbageCollection = &v1alpha1.GarbageCollection{
+			Retain: 1,
+		}
+		template := `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"generateName":"test-","namespace":"templated"}}`
+		obj.Spec.Triggers[0].Template.K8s.Source = &v1alpha1.ArtifactLocation{Inline: &template}
+		sensorCtx := &SensorContext{
+			Sensor:        obj,
+			DynamicClient: dynamicfake.NewSimpleDynamicClient(runtime.NewScheme()),
+		}
+		sensorCtx.collectGarbage(context.Background())
+		assert.Equal(t, []string{"fake", "templated"}, sensorCtx.garbageNamespaces.get("fake-trigger", "fake"))
+	})
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-events/common/logging"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sensors/policy"
+)
+
+// defaultTriggerHistoryFlushInterval is the minimum interval between two updates of the trigger history in the sensor status.
+const defaultTriggerHistoryFlushInterval = 10 * time.Second
+
+// triggerHistory accumulates the trigger executions that are not yet written to the sensor status.
+// The counters are kept as increments, so that the history survives restarts of the sensor pod.
+type triggerHistory struct {
+	pending map[string]v1alpha1.TriggerStatus
+	lock    sync.Mutex
+}
+
+// record adds a trigger execution to the pending history.
+func (h *triggerHistory) record(triggerName string, events map[string]*v1alpha1.Event, err error) {
+	eventIDs := make([]string, 0, len(events))
+	for _, event := range events {
+		if event != nil && event.Context != nil {
+			eventIDs = append(eventIDs, event.Context.ID)
+		}
+	}
+	sort.Strings(eventIDs)
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	if h.pending == nil {
+		h.pending = make(map[string]v1alpha1.TriggerStatus)
+	}
+	status := h.pending[triggerName]
+	status.Fired++
+	status.LastExecutionTime = metav1.Now()
+	status.LastEventIDs = eventIDs
+	switch err {
+	case nil:
+		status.Succeeded++
+	case policy.ErrTimedOut:
+		status.TimedOut++
+	default:
+		status.Failed++
+		status.LastError = err.Error()
+	}
+	h.pending[triggerName] = status
+}
+
+// take returns the pending history and resets it.
+func (h *triggerHistory) take() map[string]v1alpha1.TriggerStatus {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	pending := h.pending
+	h.pending = nil
+	return pending
+}
+
+// restore puts back the history that couldn't be written, so that it is retried on the next flush.
+func (h *triggerHistory) restore(history map[string]v1alpha1.TriggerStatus) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	if h.pending == nil {
+		h.pending = make(map[string]v1alpha1.TriggerStatus)
+	}
+	for name, older := range history {
+		newer, ok := h.pending[name]
+		if !ok {
+			h.pending[name] = older
+			continue
+		}
+		h.pending[name] = mergeTriggerStatus(older, newer)
+	}
+}
+
+// mergeTriggerStatus adds the counters of the update to the status and takes over its latest execution.
+func mergeTriggerStatus(status, update v1alpha1.TriggerStatus) v1alpha1.TriggerStatus {
+	status.Fired += update.Fired
+	status.Succeeded += update.Succeeded
+	status.Failed += update.Failed
+	status.TimedOut += update.TimedOut
+	if !update.LastExecutionTime.IsZero() {
+		status.LastExecutionTime = update.LastExecutionTime
+		status.LastEventIDs = update.LastEventIDs
+	}
+	if update.LastError != "" {
+		status.LastError = update.LastError
+	}
+	return status
+}
+
+// flushTriggerHistory writes the pending trigger history to the sensor status.
+func (sensorCtx *SensorContext) flushTriggerHistory(ctx context.Context) {
+	history := sensorCtx.triggerHistory.take()
+	if len(history) == 0 {
+		return
+	}
+	err := sensorCtx.updateSensorStatus(ctx, func(status *v1alpha1.SensorStatus) {
+		if status.TriggerStatuses == nil {
+			status.TriggerStatuses = make(map[string]v1alpha1.TriggerStatus)
+		}
+		for name, update := range history {
+			status.TriggerStatuses[name] = mergeTriggerStatus(status.TriggerStatuses[name], update)
+		}
+	})
+	if err != nil {
+		logging.FromContext(ctx).Errorw("failed to update the trigger history in the sensor status", zap.Error(err))
+		sensorCtx.triggerHistory.restore(history)
+	}
+}
+
+// syncTriggerHistory periodically writes the trigger history to the sensor status until the context is done.
+func (sensorCtx *SensorContext) syncTriggerHistory(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			sensorCtx.flushTriggerHistory(ctx)
+			return
+		case <-ticker.C:
+			sensorCtx.flushTriggerHistory(ctx)
+		}
+	}
+}
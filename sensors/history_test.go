@@ -0,0 +1,89 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	fakesensor "github.com/argoproj/argo-events/pkg/client/sensor/clientset/versioned/fake"
+	"github.com/argoproj/argo-events/sensors/policy"
+)
+
+func TestTriggerHistory(t *testing.T) {
+	obj := sensorObj.DeepCopy()
+	sensorCtx := &SensorContext{
+		Sensor:       obj,
+		SensorClient: fakesensor.NewSimpleClientset(obj),
+	}
+	triggerName := fakeTrigger.Template.Name
+	events := map[string]*v1alpha1.Event{
+		"dep-2": {Context: &v1alpha1.EventContext{ID: "2"}},
+		"dep-1": {Context: &v1alpha1.EventContext{ID: "1"}},
+	}
+
+	sensorCtx.triggerHistory.record(triggerName, events, nil)
+	sensorCtx.triggerHistory.record(triggerName, events, errors.New("fake error"))
+	sensorCtx.flushTriggerHistory(context.Background())
+	assert.Nil(t, sensorCtx.triggerHistory.take())
+
+	sensor, err := sensorCtx.SensorClient.ArgoprojV1alpha1().Sensors(obj.Namespace).Get(obj.Name, metav1.GetOptions{})
+	assert.Nil(t, err)
+	status, ok := sensor.Status.TriggerStatuses[triggerName]
+	assert.True(t, ok)
+	assert.Equal(t, int64(2), status.Fired)
+	assert.Equal(t, int64(1), status.Succeeded)
+	assert.Equal(t, int64(1), status.Failed)
+	assert.Equal(t, "fake error", status.LastError)
+	assert.Equal(t, []string{"1", "2"}, status.LastEventIDs)
+	assert.False(t, status.LastExecutionTime.IsZero())
+
+	sensorCtx.triggerHistory.record(triggerName, events, nil)
+	sensorCtx.triggerHistory.record(triggerName, events, policy.ErrTimedOut)
+	sensorCtx.flushTriggerHistory(context.Background())
+	sensor, err = sensorCtx.SensorClient.ArgoprojV1alpha1().Sensors(obj.Namespace).Get(obj.Name, metav1.GetOptions{})
+	assert.Nil(t, err)
+	status = sensor.Status.TriggerStatuses[triggerName]
+	assert.Equal(t, int64(4), status.Fired)
+	assert.Equal(t, int64(2), status.Succeeded)
+	assert.Equal(t, int64(1), status.Failed)
+	assert.Equal(t, int64(1), status.TimedOut)
+	assert.Equal(t, "fake error", status.LastError)
+}
+
+func TestTriggerHistoryRestore(t *testing.T) {
+	sensorCtx := &SensorContext{
+		Sensor:       sensorObj.DeepCopy(),
+		SensorClient: fakesensor.NewSimpleClientset(),
+	}
+	triggerName := fakeTrigger.Template.Name
+
+	sensorCtx.triggerHistory.record(triggerName, nil, nil)
+	// The sensor object doesn't exist, so the history must be kept for the next flush.
+	sensorCtx.flushTriggerHistory(context.Background())
+	sensorCtx.triggerHistory.record(triggerName, nil, errors.New("fake error"))
+
+	history := sensorCtx.triggerHistory.take()
+	assert.Equal(t, int64(2), history[triggerName].Fired)
+	assert.Equal(t, int64(1), history[triggerName].Succeeded)
+	assert.Equal(t, int64(1), history[triggerName].Failed)
+}
@@ -27,7 +27,10 @@ import (
 	cloudevents "github.com/cloudevents/sdk-go/v2"
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
 
 	"github.com/argoproj/argo-events/common"
 	"github.com/argoproj/argo-events/common/logging"
@@ -35,150 +38,265 @@ import (
 	eventbusdriver "github.com/argoproj/argo-events/eventbus/driver"
 	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
 	sensordependencies "github.com/argoproj/argo-events/sensors/dependencies"
+	"github.com/argoproj/argo-events/sensors/policy"
 	sensortriggers "github.com/argoproj/argo-events/sensors/triggers"
 )
 
 // ListenEvents watches and handles events received from the gateway.
 func (sensorCtx *SensorContext) ListenEvents(ctx context.Context, stopCh <-chan struct{}) error {
 	logger := logging.FromContext(ctx).Desugar()
-	sensor := sensorCtx.Sensor
-	// Get a mapping of dependencyExpression: []triggers
-	triggerMapping := make(map[string][]v1alpha1.Trigger)
+	sensor := sensorCtx.getSensor()
+	groups, err := sensorCtx.getDependencyGroups(ctx)
+	if err != nil {
+		return err
+	}
+
+	cctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	if sensor.Spec.MaxConcurrentTriggers > 0 {
+		logger.Info("limiting the number of concurrent trigger executions", zap.Int32("maxConcurrentTriggers", sensor.Spec.MaxConcurrentTriggers))
+		sensorCtx.setWorkers(newTriggerWorkerPool(cctx, int(sensor.Spec.MaxConcurrentTriggers)))
+	}
+	historyDone := make(chan struct{})
+	go func() {
+		defer close(historyDone)
+		sensorCtx.syncTriggerHistory(cctx, defaultTriggerHistoryFlushInterval)
+	}()
+
+	go sensorCtx.runGarbageCollection(cctx, defaultGarbageCollectionInterval)
+
+	watch := sensorCtx.SensorClient != nil && sensorCtx.canWatchSensor(cctx)
+	if sensorCtx.SensorClient != nil && !watch {
+		logger.Warn("the sensor is not allowed to watch sensors, the spec changes are applied by rolling the sensor deployment")
+		sensorCtx.rolledOnChange = true
+		if sensor.Spec.SuspendBufferSize > 0 {
+			logger.Warn("suspendBufferSize is ignored, the buffered trigger executions would be lost by the rollout resuming the sensor")
+		}
+	}
+	// The circuit breakers start closed, drop the states left by the previous pod.
+	var triggerNames []string
+	for _, trigger := range sensor.Spec.Triggers {
+		triggerNames = append(triggerNames, trigger.Template.Name)
+	}
+	sensorCtx.resetCircuitStatus(cctx, triggerNames)
+	sensorCtx.syncDependencyGroups(cctx, groups)
+	if watch {
+		go sensorCtx.watchSensor(cctx)
+	}
+	logger.Info("Sensor started.")
+	<-stopCh
+	logger.Info("Shutting down...")
+	cancel()
+	<-historyDone
+	return nil
+}
+
+// getDependencyGroups groups the triggers of the sensor by their dependency expression.
+func (sensorCtx *SensorContext) getDependencyGroups(ctx context.Context) (map[string]*dependencyGroup, error) {
+	logger := logging.FromContext(ctx).Desugar()
+	sensor := sensorCtx.getSensor()
+	depMapping := make(map[string]v1alpha1.EventDependency)
+	for _, d := range sensor.Spec.Dependencies {
+		depMapping[d.Name] = d
+	}
+
+	groups := make(map[string]*dependencyGroup)
 	for _, trigger := range sensor.Spec.Triggers {
 		depExpr, err := sensorCtx.getDependencyExpression(ctx, trigger)
 		if err != nil {
 			logger.Error("failed to get dependency expression", zap.Error(err))
-			return err
+			return nil, err
 		}
-		triggers, ok := triggerMapping[depExpr]
+		group, ok := groups[depExpr]
 		if !ok {
-			triggers = []v1alpha1.Trigger{}
+			group = &dependencyGroup{
+				depExpression: depExpr,
+				depMapping:    depMapping,
+			}
+			groups[depExpr] = group
 		}
-		triggers = append(triggers, trigger)
-		triggerMapping[depExpr] = triggers
+		group.triggers = append(group.triggers, trigger)
 	}
 
-	depMapping := make(map[string]v1alpha1.EventDependency)
-	for _, d := range sensor.Spec.Dependencies {
-		depMapping[d.Name] = d
+	for depExpr, group := range groups {
+		// Calculate dependencies of each group of triggers.
+		de := strings.ReplaceAll(depExpr, "-", "\\-")
+		expr, err := govaluate.NewEvaluableExpression(de)
+		if err != nil {
+			logger.Error("failed to get new evaluable expression", zap.Error(err))
+			delete(groups, depExpr)
+			continue
+		}
+		depNames := unique(expr.Vars())
+		for _, depName := range depNames {
+			dep, ok := depMapping[depName]
+			if !ok {
+				logger.Sugar().Errorf("Dependency expression and dependency list do not match, %s is not found", depName)
+				delete(groups, depExpr)
+				break
+			}
+			group.deps = append(group.deps, eventbusdriver.Dependency{
+				Name:            dep.Name,
+				EventSourceName: dep.EventSourceName,
+				EventName:       dep.EventName,
+			})
+		}
+	}
+	return groups, nil
+}
+
+// syncDependencyGroups updates the running dependency groups in place, and only restarts the subscriptions
+// of the groups whose dependencies changed. It must not be called concurrently.
+func (sensorCtx *SensorContext) syncDependencyGroups(ctx context.Context, groups map[string]*dependencyGroup) {
+	logger := logging.FromContext(ctx).Desugar()
+	if sensorCtx.dependencyGroups == nil {
+		sensorCtx.dependencyGroups = make(map[string]*dependencyGroup)
 	}
+	for depExpr, running := range sensorCtx.dependencyGroups {
+		group, ok := groups[depExpr]
+		if ok && equality.Semantic.DeepEqual(running.deps, group.deps) {
+			running.update(group.triggers, group.depMapping)
+			continue
+		}
+		logger.Info("stopping the subscription of the dependency group", zap.String("dependencyExpression", depExpr))
+		running.stop()
+		delete(sensorCtx.dependencyGroups, depExpr)
+	}
+	for depExpr, group := range groups {
+		if _, ok := sensorCtx.dependencyGroups[depExpr]; ok {
+			continue
+		}
+		sensorCtx.startDependencyGroup(ctx, group)
+		sensorCtx.dependencyGroups[depExpr] = group
+	}
+}
 
+// startDependencyGroup subscribes to the events of the dependency group and executes its triggers
+// until the group is stopped.
+func (sensorCtx *SensorContext) startDependencyGroup(ctx context.Context, group *dependencyGroup) {
+	logger := logging.FromContext(ctx).Desugar()
 	cctx, cancel := context.WithCancel(ctx)
-	defer cancel()
-	for k, v := range triggerMapping {
-		go func(depExpression string, triggers []v1alpha1.Trigger) {
-			// Calculate dependencies of each group of triggers.
-			de := strings.ReplaceAll(depExpression, "-", "\\-")
-			expr, err := govaluate.NewEvaluableExpression(de)
-			if err != nil {
-				logger.Error("failed to get new evaluable expression", zap.Error(err))
-				return
-			}
-			depNames := unique(expr.Vars())
-			deps := []eventbusdriver.Dependency{}
-			for _, depName := range depNames {
-				dep, ok := depMapping[depName]
-				if !ok {
-					logger.Sugar().Errorf("Dependency expression and dependency list do not match, %s is not found", depName)
-					return
-				}
-				d := eventbusdriver.Dependency{
-					Name:            dep.Name,
-					EventSourceName: dep.EventSourceName,
-					EventName:       dep.EventName,
-				}
-				deps = append(deps, d)
-			}
+	group.cancel = cancel
+	group.done = make(chan struct{})
+	depExpression := group.depExpression
+	deps := group.deps
 
-			// Generate clientID with hash code
-			hashKey := fmt.Sprintf("%s-%s", sensorCtx.Sensor.Name, depExpression)
-			clientID := fmt.Sprintf("client-%v", common.Hasher(hashKey))
-			ebDriver, err := eventbus.GetDriver(cctx, *sensorCtx.EventBusConfig, sensorCtx.EventBusSubject, clientID)
-			if err != nil {
-				logger.Error("failed to get event bus driver", zap.Error(err))
-				return
+	go func() {
+		defer close(group.done)
+
+		// Generate clientID with hash code
+		hashKey := fmt.Sprintf("%s-%s", sensorCtx.getSensor().Name, depExpression)
+		clientID := fmt.Sprintf("client-%v", common.Hasher(hashKey))
+		ebDriver, err := eventbus.GetDriver(cctx, *sensorCtx.EventBusConfig, sensorCtx.EventBusSubject, clientID)
+		if err != nil {
+			logger.Error("failed to get event bus driver", zap.Error(err))
+			return
+		}
+		triggerNames := []string{}
+		for _, t := range group.getTriggers() {
+			triggerNames = append(triggerNames, t.Template.Name)
+		}
+		conn, err := ebDriver.Connect()
+		if err != nil {
+			logger.Error("failed to connect to event bus", zap.Error(err))
+			return
+		}
+		defer conn.Close()
+
+		filterFunc := func(depName string, event cloudevents.Event) bool {
+			dep, ok := group.getDependency(depName)
+			if !ok {
+				return false
 			}
-			triggerNames := []string{}
-			for _, t := range triggers {
-				triggerNames = append(triggerNames, t.Template.Name)
+			if dep.Filters == nil {
+				return true
 			}
-			conn, err := ebDriver.Connect()
+			e := convertEvent(event)
+			result, err := sensordependencies.Filter(e, dep.Filters)
 			if err != nil {
-				logger.Error("failed to connect to event bus", zap.Error(err))
-				return
+				logger.Error("failed to apply filters", zap.Error(err))
+				return false
 			}
-			defer conn.Close()
+			return result
+		}
 
-			filterFunc := func(depName string, event cloudevents.Event) bool {
-				dep, ok := depMapping[depName]
-				if !ok {
-					return false
-				}
-				if dep.Filters == nil {
-					return true
+		actionFunc := func(events map[string]cloudevents.Event) {
+			triggers := group.getTriggers()
+			batches := [][]v1alpha1.Trigger{triggers}
+			if sensorCtx.getSensor().Spec.ParallelTriggers {
+				batches = make([][]v1alpha1.Trigger, 0, len(triggers))
+				for _, t := range triggers {
+					batches = append(batches, []v1alpha1.Trigger{t})
 				}
-				e := convertEvent(event)
-				result, err := sensordependencies.Filter(e, dep.Filters)
-				if err != nil {
-					logger.Error("failed to apply filters", zap.Error(err))
-					return false
+			}
+			jobs := make([]func(), 0, len(batches))
+			for _, batch := range batches {
+				batch := batch
+				jobs = append(jobs, func() {
+					if err := sensorCtx.triggerActions(cctx, events, batch); err != nil {
+						logger.Error("failed to trigger actions", zap.Error(err))
+					}
+				})
+			}
+			if sensorCtx.suspend(cctx, jobs) {
+				return
+			}
+			for _, job := range jobs {
+				if !sensorCtx.dispatch(cctx, job) {
+					logger.Warn("sensor is shutting down, discarding the trigger actions")
+					return
 				}
-				return result
 			}
+		}
 
-			actionFunc := func(events map[string]cloudevents.Event) {
-				err := sensorCtx.triggerActions(cctx, events, triggers)
-				if err != nil {
-					logger.Error("failed to trigger actions", zap.Error(err))
-				}
+		closeSubCh := make(chan struct{})
+		go func() {
+			logger.Sugar().Infof("started to subscribe events for triggers %s with client %s", fmt.Sprintf("[%s]", strings.Join(triggerNames, " ")), clientID)
+			err = ebDriver.SubscribeEventSources(cctx, conn, closeSubCh, depExpression, deps, filterFunc, actionFunc)
+			if err != nil {
+				logger.Error("failed to subscribe to event bus", zap.Any("clientID", clientID), zap.Error(err))
+				return
 			}
+		}()
 
-			closeSubCh := make(chan struct{})
-			go func() {
-				logger.Sugar().Infof("started to subscribe events for triggers %s with client %s", fmt.Sprintf("[%s]", strings.Join(triggerNames, " ")), clientID)
-				err = ebDriver.SubscribeEventSources(cctx, conn, closeSubCh, depExpression, deps, filterFunc, actionFunc)
-				if err != nil {
-					logger.Error("failed to subscribe to event bus", zap.Any("clientID", clientID), zap.Error(err))
-					return
-				}
-			}()
-
-			logger.Sugar().Infof("starting eventbus connection daemon for client %s...", clientID)
-			ticker := time.NewTicker(5 * time.Second)
-			for {
-				select {
-				case <-cctx.Done():
-					logger.Sugar().Infof("exiting eventbus connection daemon for client %s...", clientID)
-					ticker.Stop()
-					return
-				case <-ticker.C:
-					if conn == nil || conn.IsClosed() {
-						logger.Info("NATS connection lost, reconnecting...")
-						conn, err = ebDriver.Connect()
+		logger.Sugar().Infof("starting eventbus connection daemon for client %s...", clientID)
+		ticker := time.NewTicker(5 * time.Second)
+		disconnected := false
+		for {
+			select {
+			case <-cctx.Done():
+				logger.Sugar().Infof("exiting eventbus connection daemon for client %s...", clientID)
+				ticker.Stop()
+				return
+			case <-ticker.C:
+				if conn == nil || conn.IsClosed() {
+					logger.Info("NATS connection lost, reconnecting...")
+					if !disconnected {
+						disconnected = true
+						sensorCtx.recordEvent(corev1.EventTypeWarning, common.EventReasonEventBusDisconnected, "Lost the eventbus connection of client %s", clientID)
+					}
+					conn, err = ebDriver.Connect()
+					if err != nil {
+						logger.Error("failed to reconnect to eventbus", zap.Any("clientID", clientID), zap.Error(err))
+						continue
+					}
+					logger.Info("reconnected to NATS streaming server.", zap.Any("clientID", clientID))
+					disconnected = false
+					sensorCtx.recordEvent(corev1.EventTypeNormal, common.EventReasonEventBusReconnected, "Reconnected the eventbus client %s", clientID)
+					closeSubCh <- struct{}{}
+					time.Sleep(2 * time.Second)
+					go func() {
+						logger.Sugar().Infof("started to re-subscribe events for triggers %s with client %s", fmt.Sprintf("[%s]", strings.Join(triggerNames, " ")), clientID)
+						err = ebDriver.SubscribeEventSources(cctx, conn, closeSubCh, depExpression, deps, filterFunc, actionFunc)
 						if err != nil {
-							logger.Error("failed to reconnect to eventbus", zap.Any("clientID", clientID), zap.Error(err))
-							continue
+							logger.Error("failed to re-subscribe to eventbus", zap.Any("clientID", clientID), zap.Error(err))
+							return
 						}
-						logger.Info("reconnected to NATS streaming server.", zap.Any("clientID", clientID))
-						closeSubCh <- struct{}{}
-						time.Sleep(2 * time.Second)
-						go func() {
-							logger.Sugar().Infof("started to re-subscribe events for triggers %s with client %s", fmt.Sprintf("[%s]", strings.Join(triggerNames, " ")), clientID)
-							err = ebDriver.SubscribeEventSources(cctx, conn, closeSubCh, depExpression, deps, filterFunc, actionFunc)
-							if err != nil {
-								logger.Error("failed to re-subscribe to eventbus", zap.Any("clientID", clientID), zap.Error(err))
-								return
-							}
-						}()
-					}
+					}()
 				}
 			}
-		}(k, v)
-	}
-	logger.Info("Sensor started.")
-	<-stopCh
-	logger.Info("Shutting down...")
-	return nil
+		}
+	}()
 }
 
 func (sensorCtx *SensorContext) triggerActions(ctx context.Context, events map[string]cloudevents.Event, triggers []v1alpha1.Trigger) error {
@@ -188,53 +306,206 @@ func (sensorCtx *SensorContext) triggerActions(ctx context.Context, events map[s
 		eventsMapping[k] = convertEvent(v)
 	}
 	for _, trigger := range triggers {
-		if err := sensortriggers.ApplyTemplateParameters(eventsMapping, &trigger); err != nil {
-			log.Errorf("failed to apply template parameters, %v", err)
+		ok, err := sensortriggers.EvaluateWhen(trigger.When, eventsMapping)
+		if err != nil {
+			log.Errorw("failed to evaluate the trigger when expression", "triggerName", trigger.Template.Name, zap.Error(err))
 			return err
 		}
+		if !ok {
+			log.Infow("trigger when expression evaluated to false, skipping the trigger", "triggerName", trigger.Template.Name)
+			continue
+		}
 
-		log.Debugw("resolving the trigger implementation", "triggerName", trigger.Template.Name)
-		triggerImpl := sensorCtx.GetTrigger(ctx, &trigger)
-		if triggerImpl == nil {
-			log.Errorw("failed to get the specific trigger implementation. continuing to next trigger if any", "triggerName", trigger.Template.Name)
+		if sensorCtx.isDryRun(&trigger) {
+			if err := sensorCtx.processTrigger(ctx, &trigger, eventsMapping); err != nil {
+				return err
+			}
 			continue
 		}
 
-		log.Debugw("fetching trigger resource if any", "triggerName", trigger.Template.Name)
-		obj, err := triggerImpl.FetchResource()
-		if err != nil {
-			return err
+		breaker := sensorCtx.getCircuitBreaker(&trigger)
+		if breaker == nil {
+			err := sensorCtx.processTrigger(ctx, &trigger, eventsMapping)
+			sensorCtx.recordTriggerExecution(trigger.Template.Name, eventsMapping, err)
+			if executionFailed(err) {
+				return err
+			}
+			continue
+		}
+
+		allowed, changed := breaker.allow()
+		if changed {
+			sensorCtx.updateCircuitStatus(ctx, trigger.Template.Name, breaker.getState(), nil)
 		}
-		if obj == nil {
-			log.Debugw("trigger resource is empty", "triggerName", trigger.Template.Name)
+		if !allowed {
+			log.Warnw("circuit breaker is open, skipping the trigger", "triggerName", trigger.Template.Name)
+			if err := sensorCtx.processDeadLetterTrigger(ctx, &trigger, eventsMapping); err != nil {
+				log.Errorw("failed to process the dead letter trigger", "triggerName", trigger.Template.Name, zap.Error(err))
+			}
 			continue
 		}
 
-		log.Debugw("applying resource parameters if any", "triggerName", trigger.Template.Name)
-		updatedObj, err := triggerImpl.ApplyResourceParameters(eventsMapping, obj)
-		if err != nil {
+		err = sensorCtx.processTrigger(ctx, &trigger, eventsMapping)
+		sensorCtx.recordTriggerExecution(trigger.Template.Name, eventsMapping, err)
+		if breaker.record(!executionFailed(err)) {
+			sensorCtx.updateCircuitStatus(ctx, trigger.Template.Name, breaker.getState(), err)
+		}
+		if executionFailed(err) {
 			return err
 		}
+	}
+	return nil
+}
 
-		log.Debugw("executing the trigger resource", "triggerName", trigger.Template.Name)
-		newObj, err := triggerImpl.Execute(eventsMapping, updatedObj)
-		if err != nil {
-			return err
+// executionFailed tells whether the trigger execution failed. A trigger whose policy timed out without failing the
+// trigger is not considered failed, the later triggers are executed.
+func executionFailed(err error) bool {
+	return err != nil && err != policy.ErrTimedOut
+}
+
+// processTrigger resolves, executes and applies the policy of a single trigger. It returns policy.ErrTimedOut if the
+// policy timed out without failing the trigger.
+func (sensorCtx *SensorContext) processTrigger(ctx context.Context, trigger *v1alpha1.Trigger, eventsMapping map[string]*v1alpha1.Event) error {
+	log := logging.FromContext(ctx)
+	if err := sensortriggers.ApplyTemplateParameters(eventsMapping, trigger); err != nil {
+		log.Errorf("failed to apply template parameters, %v", err)
+		return err
+	}
+
+	log.Debugw("resolving the trigger implementation", "triggerName", trigger.Template.Name)
+	triggerImpl, release := sensorCtx.GetTrigger(ctx, trigger)
+	defer release()
+	if triggerImpl == nil {
+		log.Errorw("failed to get the specific trigger implementation. continuing to next trigger if any", "triggerName", trigger.Template.Name)
+		return nil
+	}
+
+	log.Debugw("fetching trigger resource if any", "triggerName", trigger.Template.Name)
+	obj, err := triggerImpl.FetchResource()
+	if err != nil {
+		return err
+	}
+	if obj == nil {
+		log.Debugw("trigger resource is empty", "triggerName", trigger.Template.Name)
+		return nil
+	}
+
+	log.Debugw("applying resource parameters if any", "triggerName", trigger.Template.Name)
+	updatedObj, err := triggerImpl.ApplyResourceParameters(eventsMapping, obj)
+	if err != nil {
+		return err
+	}
+
+	if sensorCtx.isDryRun(trigger) {
+		return sensorCtx.renderDryRun(ctx, trigger, eventsMapping, updatedObj)
+	}
+
+	log.Debugw("executing the trigger resource", "triggerName", trigger.Template.Name)
+	newObj, err := sensorCtx.executeTrigger(ctx, triggerImpl, trigger, eventsMapping, updatedObj)
+	if err != nil {
+		return err
+	}
+	log.Debugw("trigger resource successfully executed", "triggerName", trigger.Template.Name)
+	sensorCtx.trackTriggerResource(trigger, newObj)
+
+	log.Debugw("applying trigger policy", "triggerName", trigger.Template.Name)
+	err = triggerImpl.ApplyPolicy(newObj)
+	if executionFailed(err) {
+		return err
+	}
+	addTriggerOutput(triggerImpl, trigger, eventsMapping)
+	if err == policy.ErrTimedOut {
+		log.Warnw("trigger policy timed out, the trigger is not considered failed", "triggerName", trigger.Template.Name)
+		return err
+	}
+	log.Infow("successfully processed the trigger", "triggerName", trigger.Template.Name)
+	return nil
+}
+
+// addTriggerOutput passes the outcome of the trigger, if any, to the later triggers as an event keyed by the
+// trigger name.
+func addTriggerOutput(triggerImpl Trigger, trigger *v1alpha1.Trigger, eventsMapping map[string]*v1alpha1.Event) {
+	outputTrigger, ok := triggerImpl.(OutputTrigger)
+	if !ok {
+		return
+	}
+	if output := outputTrigger.Output(); output != nil {
+		eventsMapping[trigger.Template.Name] = output
+	}
+}
+
+// processDeadLetterTrigger executes the dead letter trigger of a trigger whose circuit is open.
+// The events are dropped if the circuit breaker doesn't define a dead letter trigger.
+func (sensorCtx *SensorContext) processDeadLetterTrigger(ctx context.Context, trigger *v1alpha1.Trigger, eventsMapping map[string]*v1alpha1.Event) error {
+	if trigger.CircuitBreaker.DeadLetterTrigger == nil {
+		logging.FromContext(ctx).Infow("dropping the events for the trigger", "triggerName", trigger.Template.Name)
+		return nil
+	}
+	err := sensorCtx.processTrigger(ctx, &v1alpha1.Trigger{
+		Template: trigger.CircuitBreaker.DeadLetterTrigger.DeepCopy(),
+	}, eventsMapping)
+	if executionFailed(err) {
+		return err
+	}
+	return nil
+}
+
+// updateCircuitStatus reflects the state of the trigger circuit breaker in the sensor status.
+func (sensorCtx *SensorContext) updateCircuitStatus(ctx context.Context, triggerName string, state circuitState, triggerErr error) {
+	log := logging.FromContext(ctx)
+	log.Infow("trigger circuit breaker state changed", "triggerName", triggerName, "state", state)
+	err := sensorCtx.updateSensorStatus(ctx, func(status *v1alpha1.SensorStatus) {
+		switch state {
+		case circuitOpen:
+			message := "Too many consecutive failures."
+			if triggerErr != nil {
+				message = fmt.Sprintf("Too many consecutive failures, last error: %v", triggerErr)
+			}
+			status.MarkTriggerCircuitOpen(triggerName, message)
+		case circuitHalfOpen:
+			status.MarkTriggerCircuitHalfOpen(triggerName)
+		default:
+			status.MarkTriggerCircuitClosed(triggerName)
 		}
-		log.Debugw("trigger resource successfully executed", "triggerName", trigger.Template.Name)
+	})
+	if err != nil {
+		log.Errorw("failed to update the circuit breaker state in the sensor status", "triggerName", triggerName, zap.Error(err))
+	}
+}
 
-		log.Debugw("applying trigger policy", "triggerName", trigger.Template.Name)
-		if err := triggerImpl.ApplyPolicy(newObj); err != nil {
-			return err
+// executeTrigger executes the trigger resource, retrying transient failures as per the trigger retry strategy.
+func (sensorCtx *SensorContext) executeTrigger(ctx context.Context, triggerImpl Trigger, trigger *v1alpha1.Trigger, events map[string]*v1alpha1.Event, resource interface{}) (interface{}, error) {
+	if trigger.RetryStrategy == nil {
+		return triggerImpl.Execute(events, resource)
+	}
+	log := logging.FromContext(ctx)
+	var result interface{}
+	var lastErr error
+	attempt := 0
+	err := wait.ExponentialBackoff(*common.GetConnectionBackoff(trigger.RetryStrategy), func() (bool, error) {
+		attempt++
+		result, lastErr = triggerImpl.Execute(events, resource)
+		if lastErr == nil {
+			return true, nil
+		}
+		if !sensortriggers.IsRetryableError(lastErr) {
+			return false, lastErr
 		}
-		log.Infow("successfully processed the trigger", "triggerName", trigger.Template.Name)
+		log.Warnw("trigger execution failed with a retryable error", "triggerName", trigger.Template.Name, "attempt", attempt, zap.Error(lastErr))
+		return false, nil
+	})
+	if err == wait.ErrWaitTimeout {
+		return nil, errors.Wrapf(lastErr, "failed to execute the trigger after %d attempts", attempt)
 	}
-	return nil
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
 }
 
 func (sensorCtx *SensorContext) getDependencyExpression(ctx context.Context, trigger v1alpha1.Trigger) (string, error) {
 	logger := logging.FromContext(ctx).Desugar()
-	sensor := sensorCtx.Sensor
+	sensor := sensorCtx.getSensor()
 	var depExpression string
 	if len(sensor.Spec.DependencyGroups) > 0 && sensor.Spec.Circuit != "" && trigger.Template.Switch != nil {
 		temp := ""
@@ -20,10 +20,13 @@ import (
 	"context"
 	"testing"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
 	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	sensortriggers "github.com/argoproj/argo-events/sensors/triggers"
 )
 
 var (
@@ -129,3 +132,93 @@ func TestGetDependencyExpression(t *testing.T) {
 		assert.Equal(t, "dep1 && dep1a", expr)
 	})
 }
+
+// fakeTriggerImpl fails the execution with the given errors before succeeding
+type fakeTriggerImpl struct {
+	errs     []error
+	attempts int
+}
+
+func (f *fakeTriggerImpl) FetchResource() (interface{}, error) {
+	return nil, nil
+}
+
+func (f *fakeTriggerImpl) ApplyResourceParameters(events map[string]*v1alpha1.Event, resource interface{}) (interface{}, error) {
+	return resource, nil
+}
+
+func (f *fakeTriggerImpl) Execute(events map[string]*v1alpha1.Event, resource interface{}) (interface{}, error) {
+	f.attempts++
+	if f.attempts <= len(f.errs) {
+		return nil, f.errs[f.attempts-1]
+	}
+	return resource, nil
+}
+
+func (f *fakeTriggerImpl) ApplyPolicy(resource interface{}) error {
+	return nil
+}
+
+type fakeOutputTriggerImpl struct {
+	fakeTriggerImpl
+	output *v1alpha1.Event
+}
+
+func (f *fakeOutputTriggerImpl) Output() *v1alpha1.Event {
+	return f.output
+}
+
+func TestAddTriggerOutput(t *testing.T) {
+	eventsMapping := map[string]*v1alpha1.Event{"dep1": {}}
+
+	addTriggerOutput(&fakeTriggerImpl{}, fakeTrigger, eventsMapping)
+	addTriggerOutput(&fakeOutputTriggerImpl{}, fakeTrigger, eventsMapping)
+	assert.Equal(t, 1, len(eventsMapping))
+
+	output := &v1alpha1.Event{Data: []byte(`{"status":"Succeeded"}`)}
+	addTriggerOutput(&fakeOutputTriggerImpl{output: output}, fakeTrigger, eventsMapping)
+	assert.Equal(t, 2, len(eventsMapping))
+	assert.Equal(t, output, eventsMapping["fake-trigger"])
+}
+
+func TestExecuteTrigger(t *testing.T) {
+	sensorCtx := &SensorContext{
+		Sensor: sensorObj.DeepCopy(),
+	}
+	transientErr := sensortriggers.NewRetryableError(errors.New("connection refused"))
+
+	t.Run("no retry strategy", func(t *testing.T) {
+		impl := &fakeTriggerImpl{errs: []error{transientErr}}
+		_, err := sensorCtx.executeTrigger(context.Background(), impl, fakeTrigger.DeepCopy(), nil, "resource")
+		assert.Error(t, err)
+		assert.Equal(t, 1, impl.attempts)
+	})
+
+	t.Run("retry transient errors", func(t *testing.T) {
+		trig := fakeTrigger.DeepCopy()
+		trig.RetryStrategy = &apicommon.Backoff{Steps: 3}
+		impl := &fakeTriggerImpl{errs: []error{transientErr, transientErr}}
+		result, err := sensorCtx.executeTrigger(context.Background(), impl, trig, nil, "resource")
+		assert.NoError(t, err)
+		assert.Equal(t, "resource", result)
+		assert.Equal(t, 3, impl.attempts)
+	})
+
+	t.Run("retries exhausted", func(t *testing.T) {
+		trig := fakeTrigger.DeepCopy()
+		trig.RetryStrategy = &apicommon.Backoff{Steps: 2}
+		impl := &fakeTriggerImpl{errs: []error{transientErr, transientErr, transientErr}}
+		_, err := sensorCtx.executeTrigger(context.Background(), impl, trig, nil, "resource")
+		assert.Error(t, err)
+		assert.Equal(t, 2, impl.attempts)
+	})
+
+	t.Run("non-retryable error", func(t *testing.T) {
+		trig := fakeTrigger.DeepCopy()
+		trig.RetryStrategy = &apicommon.Backoff{Steps: 3}
+		impl := &fakeTriggerImpl{errs: []error{errors.New("invalid resource")}}
+		_, err := sensorCtx.executeTrigger(context.Background(), impl, trig, nil, "resource")
+		assert.Error(t, err)
+		assert.Equal(t, 1, impl.attempts)
+	})
+}
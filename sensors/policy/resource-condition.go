@@ -0,0 +1,127 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policy
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
+	watchtools "k8s.io/client-go/tools/watch"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+// defaultConditionTimeout is the time to wait for the resource conditions, if the policy doesn't define it
+const defaultConditionTimeout = 300 * time.Second
+
+// ErrTimedOut is returned by the triggers whose policy timed out without failing the trigger, i.e. the
+// errorOnBackoffTimeout flag of the policy is not set. The trigger execution is not considered failed.
+var ErrTimedOut = errors.New("timed out waiting for the trigger policy")
+
+// ResourceCondition implements trigger policy based on the success and the failure conditions of the resource.
+// The resource is watched until either of the conditions holds.
+type ResourceCondition struct {
+	Trigger *v1alpha1.Trigger
+	Client  dynamic.NamespaceableResourceInterface
+	Obj     *unstructured.Unstructured
+}
+
+func (rc *ResourceCondition) ApplyPolicy() error {
+	k8sPolicy := rc.Trigger.Policy.K8s
+	if k8sPolicy == nil || (k8sPolicy.SuccessCondition == "" && k8sPolicy.FailureCondition == "") {
+		return nil
+	}
+	success, err := common.CompileCondition(k8sPolicy.SuccessCondition)
+	if err != nil {
+		return errors.Wrap(err, "failed to compile the success condition")
+	}
+	failure, err := common.CompileCondition(k8sPolicy.FailureCondition)
+	if err != nil {
+		return errors.Wrap(err, "failed to compile the failure condition")
+	}
+
+	timeout := defaultConditionTimeout
+	if k8sPolicy.Timeout > 0 {
+		timeout = time.Duration(k8sPolicy.Timeout) * time.Second
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	name := rc.Obj.GetName()
+	client := rc.Client.Namespace(rc.Obj.GetNamespace())
+
+	check := func(obj *unstructured.Unstructured) (bool, error) {
+		if failure != nil && common.EvaluateCondition(failure, obj) {
+			return false, errors.Errorf("resource %s met the failure condition %s", name, k8sPolicy.FailureCondition)
+		}
+		if success != nil {
+			return common.EvaluateCondition(success, obj), nil
+		}
+		return false, nil
+	}
+
+	for {
+		obj, err := client.Get(name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if done, err := check(obj); done || err != nil {
+			return err
+		}
+
+		w, err := client.Watch(metav1.ListOptions{
+			FieldSelector:   fields.OneTermEqualSelector("metadata.name", name).String(),
+			ResourceVersion: obj.GetResourceVersion(),
+		})
+		if err != nil {
+			return err
+		}
+		_, err = watchtools.UntilWithoutRetry(ctx, w, func(event watch.Event) (bool, error) {
+			obj, ok := event.Object.(*unstructured.Unstructured)
+			if !ok || obj.GetName() != name {
+				return false, nil
+			}
+			switch event.Type {
+			case watch.Deleted:
+				return false, errors.Errorf("resource %s was deleted before meeting the conditions", name)
+			case watch.Added, watch.Modified:
+				return check(obj)
+			}
+			return false, nil
+		})
+		// the watch is closed by the server, watch the resource again
+		if err == watchtools.ErrWatchClosed {
+			continue
+		}
+		return err
+	}
+}
+
+func NewResourceCondition(trigger *v1alpha1.Trigger, client dynamic.NamespaceableResourceInterface, obj *unstructured.Unstructured) *ResourceCondition {
+	return &ResourceCondition{
+		Trigger: trigger,
+		Client:  client,
+		Obj:     obj,
+	}
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/dynamic/fake"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+func TestResourceCondition_ApplyPolicy(t *testing.T) {
+	gvr := schema.GroupVersionResource{Group: "argoproj.io", Version: "v1alpha1", Resource: "workflows"}
+	trigger := &v1alpha1.Trigger{
+		Template: &v1alpha1.TriggerTemplate{
+			Name: "fake-trigger",
+		},
+		Policy: &v1alpha1.TriggerPolicy{
+			K8s: &v1alpha1.K8SResourcePolicy{
+				SuccessCondition: `status.phase == "Succeeded"`,
+				FailureCondition: `status.phase in ["Failed", "Error"]`,
+				Timeout:          5,
+			},
+		},
+	}
+
+	setPhase := func(t *testing.T, client *fake.FakeDynamicClient, phase string) {
+		obj, err := client.Resource(gvr).Namespace("fake").Get("test", metav1.GetOptions{})
+		assert.Nil(t, err)
+		err = unstructured.SetNestedField(obj.Object, phase, "status", "phase")
+		assert.Nil(t, err)
+		_, err = client.Resource(gvr).Namespace("fake").Update(obj, metav1.UpdateOptions{})
+		assert.Nil(t, err)
+	}
+
+	tests := []struct {
+		name     string
+		phase    string
+		testFunc func(t *testing.T, err error)
+	}{
+		{
+			name:  "success",
+			phase: "Succeeded",
+			testFunc: func(t *testing.T, err error) {
+				assert.Nil(t, err)
+			},
+		},
+		{
+			name:  "failure",
+			phase: "Failed",
+			testFunc: func(t *testing.T, err error) {
+				assert.NotNil(t, err)
+				assert.Contains(t, err.Error(), "failure condition")
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			uObj := newUnstructured("argoproj.io/v1alpha1", "Workflow", "fake", "test")
+			client := fake.NewSimpleDynamicClient(runtime.NewScheme(), uObj)
+			resourceConditionPolicy := NewResourceCondition(trigger, client.Resource(gvr), uObj)
+
+			errCh := make(chan error)
+			go func() {
+				errCh <- resourceConditionPolicy.ApplyPolicy()
+			}()
+			time.Sleep(100 * time.Millisecond)
+			setPhase(t, client, "Running")
+			setPhase(t, client, test.phase)
+
+			select {
+			case err := <-errCh:
+				test.testFunc(t, err)
+			case <-time.After(3 * time.Second):
+				t.Fatal("policy didn't return after the resource change")
+			}
+		})
+	}
+
+	t.Run("condition already met", func(t *testing.T) {
+		uObj := newUnstructured("argoproj.io/v1alpha1", "Workflow", "fake", "test")
+		uObj.Object["status"] = map[string]interface{}{"phase": "Succeeded"}
+		client := fake.NewSimpleDynamicClient(runtime.NewScheme(), uObj)
+		err := NewResourceCondition(trigger, client.Resource(gvr), uObj).ApplyPolicy()
+		assert.Nil(t, err)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		timeoutTrigger := trigger.DeepCopy()
+		timeoutTrigger.Policy.K8s.Timeout = 1
+		uObj := newUnstructured("argoproj.io/v1alpha1", "Workflow", "fake", "test")
+		client := fake.NewSimpleDynamicClient(runtime.NewScheme(), uObj)
+		err := NewResourceCondition(timeoutTrigger, client.Resource(gvr), uObj).ApplyPolicy()
+		assert.Equal(t, wait.ErrWaitTimeout, err)
+	})
+}
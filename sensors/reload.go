@@ -0,0 +1,220 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"context"
+	"sync"
+
+	"go.uber.org/zap"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/common/logging"
+	eventbusdriver "github.com/argoproj/argo-events/eventbus/driver"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/validation"
+	sensorinformers "github.com/argoproj/argo-events/pkg/client/sensor/informers/externalversions"
+)
+
+// dependencyGroup is a group of triggers that share a dependency expression, and hence an eventbus subscription.
+type dependencyGroup struct {
+	depExpression string
+	// deps are the dependencies the group subscribes to
+	deps []eventbusdriver.Dependency
+	// cancel stops the subscription of the group
+	cancel context.CancelFunc
+	// done is closed once the subscription of the group is stopped
+	done chan struct{}
+
+	// lock guards the triggers and the dependencies, which are updated in place when the sensor spec is reloaded
+	lock       sync.RWMutex
+	triggers   []v1alpha1.Trigger
+	depMapping map[string]v1alpha1.EventDependency
+}
+
+// getTriggers returns the triggers of the group.
+func (g *dependencyGroup) getTriggers() []v1alpha1.Trigger {
+	g.lock.RLock()
+	defer g.lock.RUnlock()
+	return g.triggers
+}
+
+// getDependency returns the dependency with the given name.
+func (g *dependencyGroup) getDependency(name string) (v1alpha1.EventDependency, bool) {
+	g.lock.RLock()
+	defer g.lock.RUnlock()
+	dep, ok := g.depMapping[name]
+	return dep, ok
+}
+
+// update replaces the triggers and the dependencies of the group without restarting its subscription.
+func (g *dependencyGroup) update(triggers []v1alpha1.Trigger, depMapping map[string]v1alpha1.EventDependency) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	g.triggers = triggers
+	g.depMapping = depMapping
+}
+
+// stop stops the subscription of the group and waits for it to exit.
+func (g *dependencyGroup) stop() {
+	if g.cancel == nil {
+		return
+	}
+	g.cancel()
+	<-g.done
+}
+
+// canWatchSensor checks whether the sensor pod is allowed to get, list and watch sensors. The controller rolls the
+// sensor deployment on spec changes if it isn't.
+func (sensorCtx *SensorContext) canWatchSensor(ctx context.Context) bool {
+	logger := logging.FromContext(ctx).Desugar()
+	sensor := sensorCtx.getSensor()
+	for _, verb := range []string{"get", "list", "watch"} {
+		review, err := sensorCtx.KubeClient.AuthorizationV1().SelfSubjectAccessReviews().Create(&authorizationv1.SelfSubjectAccessReview{
+			Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+				ResourceAttributes: &authorizationv1.ResourceAttributes{
+					Namespace: sensor.Namespace,
+					Verb:      verb,
+					Group:     v1alpha1.SchemeGroupVersion.Group,
+					Resource:  "sensors",
+				},
+			},
+		})
+		if err != nil {
+			logger.Warn("failed to review the sensor permissions", zap.Error(err))
+			return false
+		}
+		if !review.Status.Allowed {
+			return false
+		}
+	}
+	return true
+}
+
+// watchSensor watches the sensor object and reloads the spec changes until the context is done.
+func (sensorCtx *SensorContext) watchSensor(ctx context.Context) {
+	logger := logging.FromContext(ctx).Desugar()
+	sensor := sensorCtx.getSensor()
+	factory := sensorinformers.NewSharedInformerFactoryWithOptions(sensorCtx.SensorClient, 0,
+		sensorinformers.WithNamespace(sensor.Namespace),
+		sensorinformers.WithTweakListOptions(func(options *metav1.ListOptions) {
+			options.FieldSelector = fields.OneTermEqualSelector("metadata.name", sensor.Name).String()
+		}))
+	informer := factory.Argoproj().V1alpha1().Sensors().Informer()
+
+	// Only the latest version of the sensor matters, so pending updates are replaced by newer ones.
+	updates := make(chan *v1alpha1.Sensor, 1)
+	notify := func(obj interface{}) {
+		s, ok := obj.(*v1alpha1.Sensor)
+		if !ok {
+			return
+		}
+		select {
+		case <-updates:
+		default:
+		}
+		updates <- s
+	}
+	informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: notify,
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			notify(newObj)
+		},
+	})
+	logger.Info("watching the sensor for spec changes")
+	go informer.Run(ctx.Done())
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case s := <-updates:
+			sensorCtx.reloadSensor(ctx, s.DeepCopy())
+		}
+	}
+}
+
+// reloadSensor applies the spec of the sensor object in place. Only the dependency groups whose dependencies
+// changed resubscribe to the eventbus, the other groups keep their state.
+func (sensorCtx *SensorContext) reloadSensor(ctx context.Context, sensor *v1alpha1.Sensor) {
+	logger := logging.FromContext(ctx).Desugar()
+	current := sensorCtx.getSensor()
+	if equality.Semantic.DeepEqual(current.Spec, sensor.Spec) {
+		return
+	}
+	if err := validation.ValidateSensor(sensor.DeepCopy()); err != nil {
+		logger.Error("the updated sensor spec is invalid, keeping the current spec", zap.Error(err))
+		sensorCtx.recordEvent(corev1.EventTypeWarning, common.EventReasonSensorReloadFailed, "Failed to reload the sensor spec: %v", err)
+		return
+	}
+
+	logger.Info("reloading the sensor spec")
+	sensorCtx.setSensor(sensor)
+	groups, err := sensorCtx.getDependencyGroups(ctx)
+	if err != nil {
+		logger.Error("failed to reload the sensor spec, keeping the current spec", zap.Error(err))
+		sensorCtx.recordEvent(corev1.EventTypeWarning, common.EventReasonSensorReloadFailed, "Failed to reload the sensor spec: %v", err)
+		sensorCtx.setSensor(current)
+		return
+	}
+
+	sensorCtx.resetChangedTriggers(ctx, current.Spec.Triggers, sensor.Spec.Triggers)
+	if current.Spec.MaxConcurrentTriggers != sensor.Spec.MaxConcurrentTriggers {
+		var workers *triggerWorkerPool
+		if sensor.Spec.MaxConcurrentTriggers > 0 {
+			workers = newTriggerWorkerPool(ctx, int(sensor.Spec.MaxConcurrentTriggers))
+		}
+		sensorCtx.setWorkers(workers)
+	}
+	sensorCtx.syncDependencyGroups(ctx, groups)
+	if current.Spec.Suspend && !sensor.Spec.Suspend {
+		sensorCtx.resume(ctx)
+	}
+	logger.Info("successfully reloaded the sensor spec")
+	sensorCtx.recordEvent(corev1.EventTypeNormal, common.EventReasonSensorReloaded, "Reloaded the sensor spec")
+}
+
+// resetChangedTriggers closes the clients and resets the circuit breakers of the triggers that were updated or removed,
+// so that they are created again from the new spec. The clients are closed once the in-flight executions are done.
+func (sensorCtx *SensorContext) resetChangedTriggers(ctx context.Context, previous, triggers []v1alpha1.Trigger) {
+	updated := make(map[string]v1alpha1.Trigger)
+	for _, t := range triggers {
+		updated[t.Template.Name] = t
+	}
+	var changed []string
+	for _, t := range previous {
+		name := t.Template.Name
+		if u, ok := updated[name]; ok && equality.Semantic.DeepEqual(t, u) {
+			continue
+		}
+		changed = append(changed, name)
+
+		sensorCtx.closeTriggerClients(name)
+
+		sensorCtx.lock.Lock()
+		delete(sensorCtx.circuitBreakers, name)
+		sensorCtx.lock.Unlock()
+	}
+	sensorCtx.closeUnusedMQTTClients(triggers)
+	sensorCtx.resetCircuitStatus(ctx, changed)
+}
@@ -0,0 +1,171 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	mqttlib "github.com/eclipse/paho.mqtt.golang"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	eventbusv1alpha1 "github.com/argoproj/argo-events/pkg/apis/eventbus/v1alpha1"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sensors/triggers/mqtt"
+)
+
+func newReloadTestSensor() *v1alpha1.Sensor {
+	httpTrigger := func(name, url string) v1alpha1.Trigger {
+		return v1alpha1.Trigger{
+			Template: &v1alpha1.TriggerTemplate{
+				Name: name,
+				HTTP: &v1alpha1.HTTPTrigger{
+					URL:    url,
+					Method: http.MethodPost,
+				},
+			},
+		}
+	}
+	trigger1 := httpTrigger("trigger-1", "http://fake-1")
+	trigger1.Template.Switch = &v1alpha1.TriggerSwitch{Any: []string{"group-1"}}
+	trigger2 := httpTrigger("trigger-2", "http://fake-2")
+	trigger2.Template.Switch = &v1alpha1.TriggerSwitch{Any: []string{"group-2"}}
+	return &v1alpha1.Sensor{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "fake-sensor",
+			Namespace: "fake",
+		},
+		Spec: v1alpha1.SensorSpec{
+			Dependencies: []v1alpha1.EventDependency{
+				{Name: "dep1", EventSourceName: "webhook", EventName: "example-1"},
+				{Name: "dep2", EventSourceName: "webhook", EventName: "example-2"},
+			},
+			DependencyGroups: []v1alpha1.DependencyGroup{
+				{Name: "group-1", Dependencies: []string{"dep1"}},
+				{Name: "group-2", Dependencies: []string{"dep2"}},
+			},
+			Circuit:  "group-1 || group-2",
+			Triggers: []v1alpha1.Trigger{trigger1, trigger2},
+		},
+	}
+}
+
+func TestReloadSensor(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sensorCtx := &SensorContext{
+		Sensor:         newReloadTestSensor(),
+		EventBusConfig: &eventbusv1alpha1.BusConfig{},
+	}
+	groups, err := sensorCtx.getDependencyGroups(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(groups))
+	sensorCtx.syncDependencyGroups(ctx, groups)
+	group1 := sensorCtx.dependencyGroups["dep1"]
+	group2 := sensorCtx.dependencyGroups["dep2"]
+	for _, trigger := range sensorCtx.getSensor().Spec.Triggers {
+		sensorCtx.acquireClients(&trigger).release()
+	}
+
+	t.Run("update triggers in place", func(t *testing.T) {
+		updated := newReloadTestSensor()
+		updated.Spec.Triggers[0].Template.HTTP.URL = "http://fake-updated"
+		sensorCtx.reloadSensor(ctx, updated)
+		assert.Equal(t, updated, sensorCtx.getSensor())
+		assert.True(t, group1 == sensorCtx.dependencyGroups["dep1"])
+		assert.True(t, group2 == sensorCtx.dependencyGroups["dep2"])
+		assert.Equal(t, "http://fake-updated", group1.getTriggers()[0].Template.HTTP.URL)
+		_, ok := sensorCtx.triggerClients["trigger-1"]
+		assert.False(t, ok)
+		_, ok = sensorCtx.triggerClients["trigger-2"]
+		assert.True(t, ok)
+	})
+
+	t.Run("resubscribe changed dependencies", func(t *testing.T) {
+		updated := sensorCtx.getSensor().DeepCopy()
+		updated.Spec.Dependencies[1].EventName = "example-updated"
+		sensorCtx.reloadSensor(ctx, updated)
+		assert.True(t, group1 == sensorCtx.dependencyGroups["dep1"])
+		assert.False(t, group2 == sensorCtx.dependencyGroups["dep2"])
+		assert.Equal(t, "example-updated", sensorCtx.dependencyGroups["dep2"].deps[0].EventName)
+	})
+
+	t.Run("keep the current spec if the update is invalid", func(t *testing.T) {
+		current := sensorCtx.getSensor()
+		updated := current.DeepCopy()
+		updated.Spec.Triggers[0].Template.HTTP.URL = ""
+		sensorCtx.reloadSensor(ctx, updated)
+		assert.Equal(t, current, sensorCtx.getSensor())
+	})
+}
+
+type fakeMQTTClient struct {
+	mqttlib.Client
+	disconnected chan struct{}
+}
+
+func newFakeMQTTClient() *fakeMQTTClient {
+	return &fakeMQTTClient{disconnected: make(chan struct{})}
+}
+
+func (c *fakeMQTTClient) Disconnect(quiesce uint) {
+	close(c.disconnected)
+}
+
+func isDisconnected(client *fakeMQTTClient) bool {
+	select {
+	case <-client.disconnected:
+		return true
+	case <-time.After(100 * time.Millisecond):
+		return false
+	}
+}
+
+func TestCloseUnusedMQTTClients(t *testing.T) {
+	mqttTrigger := func(name, url string) v1alpha1.Trigger {
+		return v1alpha1.Trigger{
+			Template: &v1alpha1.TriggerTemplate{
+				Name: name,
+				MQTT: &v1alpha1.MQTTTrigger{URL: url, Topic: "fake-topic"},
+			},
+		}
+	}
+	triggers := []v1alpha1.Trigger{
+		mqttTrigger("trigger-1", "tcp://fake-1:1883"),
+		mqttTrigger("trigger-2", "tcp://fake-1:1883"),
+		mqttTrigger("trigger-3", "tcp://fake-2:1883"),
+	}
+	client1 := newFakeMQTTClient()
+	client2 := newFakeMQTTClient()
+	sensorCtx := &SensorContext{}
+	clients1 := sensorCtx.acquireClients(&triggers[0])
+	clients1.mqttClients[mqtt.ClientKey(triggers[0].Template.MQTT)] = client1
+	clients1.release()
+	clients2 := sensorCtx.acquireClients(&triggers[2])
+	clients2.mqttClients[mqtt.ClientKey(triggers[2].Template.MQTT)] = client2
+
+	sensorCtx.closeUnusedMQTTClients(triggers[1:2])
+	assert.Equal(t, 1, len(sensorCtx.mqttClients))
+	assert.False(t, isDisconnected(client1))
+	// The client of the removed broker is still used by an execution.
+	assert.False(t, isDisconnected(client2))
+	clients2.release()
+	assert.True(t, isDisconnected(client2))
+}
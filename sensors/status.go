@@ -0,0 +1,78 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/common/logging"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sensors/policy"
+)
+
+// updateSensorStatus applies the update to the status of the latest sensor object and persists it.
+// If the service account is not allowed to update the sensor, a warning is logged once and the update is dropped.
+func (sensorCtx *SensorContext) updateSensorStatus(ctx context.Context, update func(status *v1alpha1.SensorStatus)) error {
+	if sensorCtx.SensorClient == nil {
+		return nil
+	}
+	obj := sensorCtx.getSensor()
+	sensors := sensorCtx.SensorClient.ArgoprojV1alpha1().Sensors(obj.Namespace)
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		sensor, err := sensors.Get(obj.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		update(&sensor.Status)
+		_, err = sensors.Update(sensor)
+		return err
+	})
+	if apierrors.IsForbidden(err) {
+		sensorCtx.statusForbidden.Do(func() {
+			logging.FromContext(ctx).Warnw("not allowed to update the sensor status, grant the service account the permission to get and update sensors", "error", err)
+		})
+		return nil
+	}
+	return err
+}
+
+// recordEvent records a Kubernetes event against the sensor.
+func (sensorCtx *SensorContext) recordEvent(eventType, reason, messageFmt string, args ...interface{}) {
+	if sensorCtx.Recorder == nil {
+		return
+	}
+	sensorCtx.Recorder.Eventf(sensorCtx.getSensor(), eventType, reason, messageFmt, args...)
+}
+
+// recordTriggerExecution records the outcome of a trigger execution in the trigger history and as a Kubernetes event.
+func (sensorCtx *SensorContext) recordTriggerExecution(triggerName string, events map[string]*v1alpha1.Event, err error) {
+	sensorCtx.triggerHistory.record(triggerName, events, err)
+	switch err {
+	case nil:
+		sensorCtx.recordEvent(corev1.EventTypeNormal, common.EventReasonTriggerSucceeded, "Successfully executed trigger %s", triggerName)
+	case policy.ErrTimedOut:
+		sensorCtx.recordEvent(corev1.EventTypeWarning, common.EventReasonTriggerTimedOut, "Executed trigger %s, but its policy timed out", triggerName)
+	default:
+		sensorCtx.recordEvent(corev1.EventTypeWarning, common.EventReasonTriggerFailed, "Failed to execute trigger %s: %v", triggerName, err)
+	}
+}
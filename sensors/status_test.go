@@ -0,0 +1,86 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/common/logging"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	sensorfake "github.com/argoproj/argo-events/pkg/client/sensor/clientset/versioned/fake"
+	"github.com/argoproj/argo-events/sensors/policy"
+)
+
+func TestRecordTriggerExecution(t *testing.T) {
+	recorder := record.NewFakeRecorder(3)
+	sensorCtx := &SensorContext{
+		Sensor:   sensorObj.DeepCopy(),
+		Recorder: recorder,
+	}
+	triggerName := fakeTrigger.Template.Name
+
+	sensorCtx.recordTriggerExecution(triggerName, nil, nil)
+	event := <-recorder.Events
+	assert.True(t, strings.HasPrefix(event, "Normal "+common.EventReasonTriggerSucceeded))
+	assert.Contains(t, event, triggerName)
+
+	sensorCtx.recordTriggerExecution(triggerName, nil, errors.New("fake error"))
+	event = <-recorder.Events
+	assert.True(t, strings.HasPrefix(event, "Warning "+common.EventReasonTriggerFailed))
+	assert.Contains(t, event, "fake error")
+
+	sensorCtx.recordTriggerExecution(triggerName, nil, policy.ErrTimedOut)
+	event = <-recorder.Events
+	assert.True(t, strings.HasPrefix(event, "Warning "+common.EventReasonTriggerTimedOut))
+
+	history := sensorCtx.triggerHistory.take()
+	assert.Equal(t, int64(3), history[triggerName].Fired)
+	assert.Equal(t, int64(1), history[triggerName].TimedOut)
+}
+
+func TestUpdateSensorStatusForbidden(t *testing.T) {
+	client := sensorfake.NewSimpleClientset(sensorObj.DeepCopy())
+	client.PrependReactor("update", "sensors", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, apierrors.NewForbidden(schema.GroupResource{Resource: "sensors"}, sensorObj.Name, errors.New("fake"))
+	})
+	sensorCtx := &SensorContext{
+		Sensor:       sensorObj.DeepCopy(),
+		SensorClient: client,
+	}
+	core, logs := observer.New(zap.WarnLevel)
+	ctx := logging.WithLogger(context.Background(), zap.New(core).Sugar())
+	for i := 0; i < 2; i++ {
+		err := sensorCtx.updateSensorStatus(ctx, func(status *v1alpha1.SensorStatus) {
+			status.MarkTriggerCircuitClosed(fakeTrigger.Template.Name)
+		})
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, 1, logs.Len())
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"context"
+	"sync"
+
+	"github.com/argoproj/argo-events/common/logging"
+)
+
+// suspendBuffer holds the trigger executions resolved while the sensor is suspended.
+type suspendBuffer struct {
+	jobs []func()
+	lock sync.Mutex
+}
+
+// suspend buffers the jobs if the sensor is suspended, discarding the oldest jobs once the buffer is full.
+// It returns false if the sensor is not suspended, in which case the jobs must be executed by the caller.
+func (sensorCtx *SensorContext) suspend(ctx context.Context, jobs []func()) bool {
+	buffer := &sensorCtx.suspendBuffer
+	buffer.lock.Lock()
+	defer buffer.lock.Unlock()
+	spec := sensorCtx.getSensor().Spec
+	if !spec.Suspend {
+		return false
+	}
+	size := int(spec.SuspendBufferSize)
+	if sensorCtx.rolledOnChange {
+		// The sensor is resumed by a rollout, which restarts the pod and loses the buffer.
+		size = 0
+	}
+	buffer.jobs = append(buffer.jobs, jobs...)
+	if discarded := len(buffer.jobs) - size; discarded > 0 {
+		buffer.jobs = buffer.jobs[discarded:]
+		logging.FromContext(ctx).Infow("sensor is suspended, discarding trigger executions", "discarded", discarded)
+	} else {
+		logging.FromContext(ctx).Infow("sensor is suspended, buffering trigger executions", "buffered", len(buffer.jobs))
+	}
+	return true
+}
+
+// resume executes the trigger executions buffered while the sensor was suspended.
+func (sensorCtx *SensorContext) resume(ctx context.Context) {
+	buffer := &sensorCtx.suspendBuffer
+	buffer.lock.Lock()
+	jobs := buffer.jobs
+	buffer.jobs = nil
+	buffer.lock.Unlock()
+	if len(jobs) == 0 {
+		return
+	}
+	logging.FromContext(ctx).Infow("sensor is resumed, executing the buffered trigger executions", "buffered", len(jobs))
+	for _, job := range jobs {
+		if !sensorCtx.dispatch(ctx, job) {
+			logging.FromContext(ctx).Warn("sensor is shutting down, discarding the buffered trigger executions")
+			return
+		}
+	}
+}
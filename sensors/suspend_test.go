@@ -0,0 +1,83 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSuspend(t *testing.T) {
+	ctx := context.Background()
+	obj := sensorObj.DeepCopy()
+	sensorCtx := &SensorContext{
+		Sensor: obj,
+	}
+	assert.False(t, sensorCtx.suspend(ctx, []func(){func() {}}))
+
+	var lock sync.Mutex
+	var executed []int
+	var wg sync.WaitGroup
+	job := func(i int) func() {
+		return func() {
+			defer wg.Done()
+			lock.Lock()
+			defer lock.Unlock()
+			executed = append(executed, i)
+		}
+	}
+
+	suspended := obj.DeepCopy()
+	suspended.Spec.Suspend = true
+	suspended.Spec.SuspendBufferSize = 2
+	sensorCtx.setSensor(suspended)
+	for i := 0; i < 3; i++ {
+		assert.True(t, sensorCtx.suspend(ctx, []func(){job(i)}))
+	}
+	assert.Equal(t, 2, len(sensorCtx.suspendBuffer.jobs))
+
+	sensorCtx.setSensor(obj)
+	wg.Add(2)
+	sensorCtx.resume(ctx)
+	wg.Wait()
+	assert.ElementsMatch(t, []int{1, 2}, executed)
+	assert.Equal(t, 0, len(sensorCtx.suspendBuffer.jobs))
+
+	t.Run("discard without buffer", func(t *testing.T) {
+		suspended := obj.DeepCopy()
+		suspended.Spec.Suspend = true
+		sensorCtx.setSensor(suspended)
+		assert.True(t, sensorCtx.suspend(ctx, []func(){func() {}}))
+		assert.Equal(t, 0, len(sensorCtx.suspendBuffer.jobs))
+	})
+
+	t.Run("discard if the sensor is resumed by a rollout", func(t *testing.T) {
+		sensorCtx := &SensorContext{
+			Sensor:         obj.DeepCopy(),
+			rolledOnChange: true,
+		}
+		suspended := obj.DeepCopy()
+		suspended.Spec.Suspend = true
+		suspended.Spec.SuspendBufferSize = 2
+		sensorCtx.setSensor(suspended)
+		assert.True(t, sensorCtx.suspend(ctx, []func(){func() {}}))
+		assert.Equal(t, 0, len(sensorCtx.suspendBuffer.jobs))
+	})
+}
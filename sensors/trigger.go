@@ -17,16 +17,33 @@ package sensors
 
 import (
 	"context"
+	"fmt"
+	"sync"
 
+	"github.com/argoproj/argo-events/common"
 	"github.com/argoproj/argo-events/common/logging"
+	"github.com/argoproj/argo-events/eventbus"
+	eventbusdriver "github.com/argoproj/argo-events/eventbus/driver"
 	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sensors/triggers/amqp"
 	openwhisk "github.com/argoproj/argo-events/sensors/triggers/apache-openwhisk"
 	argoworkflow "github.com/argoproj/argo-events/sensors/triggers/argo-workflow"
 	awslambda "github.com/argoproj/argo-events/sensors/triggers/aws-lambda"
+	awssns "github.com/argoproj/argo-events/sensors/triggers/aws-sns"
+	awssqs "github.com/argoproj/argo-events/sensors/triggers/aws-sqs"
 	customtrigger "github.com/argoproj/argo-events/sensors/triggers/custom-trigger"
+	"github.com/argoproj/argo-events/sensors/triggers/email"
+	eventbustrigger "github.com/argoproj/argo-events/sensors/triggers/eventbus"
+	gcppubsub "github.com/argoproj/argo-events/sensors/triggers/gcp-pubsub"
 	"github.com/argoproj/argo-events/sensors/triggers/http"
+	"github.com/argoproj/argo-events/sensors/triggers/job"
 	"github.com/argoproj/argo-events/sensors/triggers/kafka"
+	logtrigger "github.com/argoproj/argo-events/sensors/triggers/log"
+	"github.com/argoproj/argo-events/sensors/triggers/mqtt"
 	"github.com/argoproj/argo-events/sensors/triggers/nats"
+	objectstorage "github.com/argoproj/argo-events/sensors/triggers/object-storage"
+	"github.com/argoproj/argo-events/sensors/triggers/pulsar"
+	"github.com/argoproj/argo-events/sensors/triggers/redis"
 	"github.com/argoproj/argo-events/sensors/triggers/slack"
 	standardk8s "github.com/argoproj/argo-events/sensors/triggers/standard-k8s"
 	"go.uber.org/zap"
@@ -40,23 +57,50 @@ type Trigger interface {
 	ApplyResourceParameters(events map[string]*v1alpha1.Event, resource interface{}) (interface{}, error)
 	// Execute executes the trigger
 	Execute(events map[string]*v1alpha1.Event, resource interface{}) (interface{}, error)
-	// ApplyPolicy applies the policy on the trigger
+	// ApplyPolicy applies the policy on the trigger. It returns policy.ErrTimedOut if the policy timed out without
+	// failing the trigger.
 	ApplyPolicy(resource interface{}) error
 }
 
-// GetTrigger returns a trigger
-func (sensorCtx *SensorContext) GetTrigger(ctx context.Context, trigger *v1alpha1.Trigger) Trigger {
+// OutputTrigger is implemented by the triggers whose outcome is passed to the later triggers of the sensor
+type OutputTrigger interface {
+	// Output returns the outcome of the trigger as an event, or nil if there is none
+	Output() *v1alpha1.Event
+}
+
+// GetTrigger returns a trigger, and a function to call once the trigger is executed, which releases its clients
+func (sensorCtx *SensorContext) GetTrigger(ctx context.Context, trigger *v1alpha1.Trigger) (Trigger, func()) {
+	clients := sensorCtx.acquireClients(trigger)
+	clients.lock.Lock()
+	defer clients.lock.Unlock()
+	return sensorCtx.newTrigger(ctx, trigger, clients), clients.release
+}
+
+// newTrigger returns a trigger, creating its clients in the client set if they don't exist yet. The caller must hold
+// the lock of the client set.
+func (sensorCtx *SensorContext) newTrigger(ctx context.Context, trigger *v1alpha1.Trigger, clients *clientSet) Trigger {
 	log := logging.FromContext(ctx).Desugar()
+	sensor := sensorCtx.getSensor()
+	if sensorCtx.isDryRun(trigger) {
+		if result := newDryRunTrigger(sensor, trigger, log); result != nil {
+			return result
+		}
+	}
+
 	if trigger.Template.K8s != nil {
-		return standardk8s.NewStandardK8sTrigger(sensorCtx.KubeClient, sensorCtx.DynamicClient, sensorCtx.Sensor, trigger, log)
+		result := standardk8s.NewStandardK8sTrigger(sensorCtx.KubeClient, sensorCtx.DynamicClient, sensor, trigger, log)
+		result.ConcurrencyLock = sensorCtx.getConcurrencyLock(trigger.Template.Name)
+		return result
 	}
 
 	if trigger.Template.ArgoWorkflow != nil {
-		return argoworkflow.NewArgoWorkflowTrigger(sensorCtx.KubeClient, sensorCtx.DynamicClient, sensorCtx.Sensor, trigger, log)
+		result := argoworkflow.NewArgoWorkflowTrigger(sensorCtx.KubeClient, sensorCtx.DynamicClient, sensor, trigger, log)
+		result.ConcurrencyLock = sensorCtx.getConcurrencyLock(trigger.Template.Name)
+		return result
 	}
 
 	if trigger.Template.HTTP != nil {
-		result, err := http.NewHTTPTrigger(sensorCtx.httpClients, sensorCtx.Sensor, trigger, log)
+		result, err := http.NewHTTPTrigger(clients.httpClients, sensor, trigger, log)
 		if err != nil {
 			log.Error("failed to invoke the trigger", zap.Any("trigger", trigger.Template.Name), zap.Error(err))
 			return nil
@@ -65,7 +109,34 @@ func (sensorCtx *SensorContext) GetTrigger(ctx context.Context, trigger *v1alpha
 	}
 
 	if trigger.Template.AWSLambda != nil {
-		result, err := awslambda.NewAWSLambdaTrigger(sensorCtx.awsLambdaClients, sensorCtx.Sensor, trigger, log)
+		result, err := awslambda.NewAWSLambdaTrigger(clients.awsLambdaClients, sensor, trigger, log)
+		if err != nil {
+			log.Error("failed to invoke the trigger", zap.Any("trigger", trigger.Template.Name), zap.Error(err))
+			return nil
+		}
+		return result
+	}
+
+	if trigger.Template.AWSSQS != nil {
+		result, err := awssqs.NewAWSSQSTrigger(clients.awsSQSClients, sensor, trigger, log)
+		if err != nil {
+			log.Error("failed to invoke the trigger", zap.Any("trigger", trigger.Template.Name), zap.Error(err))
+			return nil
+		}
+		return result
+	}
+
+	if trigger.Template.AWSSNS != nil {
+		result, err := awssns.NewAWSSNSTrigger(clients.awsSNSClients, sensor, trigger, log)
+		if err != nil {
+			log.Error("failed to invoke the trigger", zap.Any("trigger", trigger.Template.Name), zap.Error(err))
+			return nil
+		}
+		return result
+	}
+
+	if trigger.Template.GCPPubSub != nil {
+		result, err := gcppubsub.NewGCPPubSubTrigger(clients.pubsubClients, sensor, trigger, log)
 		if err != nil {
 			log.Error("failed to invoke the trigger", zap.Any("trigger", trigger.Template.Name), zap.Error(err))
 			return nil
@@ -74,7 +145,7 @@ func (sensorCtx *SensorContext) GetTrigger(ctx context.Context, trigger *v1alpha
 	}
 
 	if trigger.Template.Kafka != nil {
-		result, err := kafka.NewKafkaTrigger(sensorCtx.Sensor, trigger, sensorCtx.kafkaProducers, log)
+		result, err := kafka.NewKafkaTrigger(sensor, trigger, clients.kafkaProducers, clients.kafkaSyncProducers, log)
 		if err != nil {
 			log.Error("failed to invoke the trigger", zap.Any("trigger", trigger.Template.Name), zap.Error(err))
 			return nil
@@ -83,7 +154,43 @@ func (sensorCtx *SensorContext) GetTrigger(ctx context.Context, trigger *v1alpha
 	}
 
 	if trigger.Template.NATS != nil {
-		result, err := nats.NewNATSTrigger(sensorCtx.Sensor, trigger, sensorCtx.natsConnections, log)
+		result, err := nats.NewNATSTrigger(sensor, trigger, clients.natsConnections, log)
+		if err != nil {
+			log.Error("failed to invoke the trigger", zap.Any("trigger", trigger.Template.Name), zap.Error(err))
+			return nil
+		}
+		return result
+	}
+
+	if trigger.Template.AMQP != nil {
+		result, err := amqp.NewAMQPTrigger(sensor, trigger, clients.amqpConnections, log)
+		if err != nil {
+			log.Error("failed to invoke the trigger", zap.Any("trigger", trigger.Template.Name), zap.Error(err))
+			return nil
+		}
+		return result
+	}
+
+	if trigger.Template.MQTT != nil {
+		result, err := mqtt.NewMQTTTrigger(sensor, trigger, clients.mqttClients, log)
+		if err != nil {
+			log.Error("failed to invoke the trigger", zap.Any("trigger", trigger.Template.Name), zap.Error(err))
+			return nil
+		}
+		return result
+	}
+
+	if trigger.Template.Pulsar != nil {
+		result, err := pulsar.NewPulsarTrigger(sensor, trigger, clients.pulsarClients, clients.pulsarProducers, log)
+		if err != nil {
+			log.Error("failed to invoke the trigger", zap.Any("trigger", trigger.Template.Name), zap.Error(err))
+			return nil
+		}
+		return result
+	}
+
+	if trigger.Template.Redis != nil {
+		result, err := redis.NewRedisTrigger(sensor, trigger, clients.redisClients, log)
 		if err != nil {
 			log.Error("failed to invoke the trigger", zap.Any("trigger", trigger.Template.Name), zap.Error(err))
 			return nil
@@ -91,8 +198,43 @@ func (sensorCtx *SensorContext) GetTrigger(ctx context.Context, trigger *v1alpha
 		return result
 	}
 
+	if trigger.Template.EventBus != nil {
+		ebDriver, conn, err := sensorCtx.getEventBusPublisher(ctx, sensor.Name)
+		if err != nil {
+			log.Error("failed to invoke the trigger", zap.Any("trigger", trigger.Template.Name), zap.Error(err))
+			return nil
+		}
+		return eventbustrigger.NewEventBusTrigger(sensor, trigger, ebDriver, conn, log)
+	}
+
+	if trigger.Template.Log != nil {
+		return logtrigger.NewLogTrigger(sensor, trigger, log)
+	}
+
+	if trigger.Template.ObjectStorage != nil {
+		result, err := objectstorage.NewObjectStorageTrigger(sensor, trigger, clients.minioClients, log)
+		if err != nil {
+			log.Error("failed to invoke the trigger", zap.Any("trigger", trigger.Template.Name), zap.Error(err))
+			return nil
+		}
+		return result
+	}
+
+	if trigger.Template.Job != nil {
+		return job.NewJobTrigger(sensorCtx.KubeClient, sensor, trigger, log)
+	}
+
 	if trigger.Template.Slack != nil {
-		result, err := slack.NewSlackTrigger(sensorCtx.Sensor, trigger, log, sensorCtx.slackHTTPClient)
+		result, err := slack.NewSlackTrigger(sensor, trigger, log, sensorCtx.slackHTTPClient)
+		if err != nil {
+			log.Error("failed to invoke the trigger", zap.Any("trigger", trigger.Template.Name), zap.Error(err))
+			return nil
+		}
+		return result
+	}
+
+	if trigger.Template.Email != nil {
+		result, err := email.NewEmailTrigger(sensor, trigger, log)
 		if err != nil {
 			log.Error("failed to invoke the trigger", zap.Any("trigger", trigger.Template.Name), zap.Error(err))
 			return nil
@@ -101,7 +243,7 @@ func (sensorCtx *SensorContext) GetTrigger(ctx context.Context, trigger *v1alpha
 	}
 
 	if trigger.Template.OpenWhisk != nil {
-		result, err := openwhisk.NewTriggerImpl(sensorCtx.Sensor, trigger, sensorCtx.openwhiskClients, log)
+		result, err := openwhisk.NewTriggerImpl(sensor, trigger, clients.openwhiskClients, log)
 		if err != nil {
 			log.Error("failed to invoke the trigger", zap.Any("trigger", trigger.Template.Name), zap.Error(err))
 			return nil
@@ -110,7 +252,7 @@ func (sensorCtx *SensorContext) GetTrigger(ctx context.Context, trigger *v1alpha
 	}
 
 	if trigger.Template.CustomTrigger != nil {
-		result, err := customtrigger.NewCustomTrigger(sensorCtx.Sensor, trigger, log, sensorCtx.customTriggerClients)
+		result, err := customtrigger.NewCustomTrigger(sensor, trigger, log, clients.customTriggerClients)
 		if err != nil {
 			log.Error("failed to invoke the trigger", zap.Any("trigger", trigger.Template.Name), zap.Error(err))
 			return nil
@@ -119,3 +261,42 @@ func (sensorCtx *SensorContext) GetTrigger(ctx context.Context, trigger *v1alpha
 	}
 	return nil
 }
+
+// getEventBusPublisher returns the EventBus driver and connection to publish the events of the EventBus triggers. It
+// connects to the EventBus if the connection is not open yet or has been lost.
+func (sensorCtx *SensorContext) getEventBusPublisher(ctx context.Context, sensorName string) (eventbusdriver.Driver, eventbusdriver.Connection, error) {
+	sensorCtx.eventBusLock.Lock()
+	defer sensorCtx.eventBusLock.Unlock()
+	if sensorCtx.eventBusDriver == nil {
+		clientID := fmt.Sprintf("client-%v", common.Hasher(fmt.Sprintf("%s-publisher", sensorName)))
+		ebDriver, err := eventbus.GetDriver(ctx, *sensorCtx.EventBusConfig, sensorCtx.EventBusSubject, clientID)
+		if err != nil {
+			return nil, nil, err
+		}
+		sensorCtx.eventBusDriver = ebDriver
+	}
+	if sensorCtx.eventBusConn == nil || sensorCtx.eventBusConn.IsClosed() {
+		conn, err := sensorCtx.eventBusDriver.Connect()
+		if err != nil {
+			return nil, nil, err
+		}
+		sensorCtx.eventBusConn = conn
+	}
+	return sensorCtx.eventBusDriver, sensorCtx.eventBusConn, nil
+}
+
+// getConcurrencyLock returns the lock that serializes the executions of the trigger between the check of its
+// concurrency policy and the creation of its resource.
+func (sensorCtx *SensorContext) getConcurrencyLock(triggerName string) *sync.Mutex {
+	sensorCtx.clientsLock.Lock()
+	defer sensorCtx.clientsLock.Unlock()
+	if sensorCtx.concurrencyLocks == nil {
+		sensorCtx.concurrencyLocks = make(map[string]*sync.Mutex)
+	}
+	lock, ok := sensorCtx.concurrencyLocks[triggerName]
+	if !ok {
+		lock = &sync.Mutex{}
+		sensorCtx.concurrencyLocks[triggerName] = lock
+	}
+	return lock
+}
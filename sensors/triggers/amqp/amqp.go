@@ -0,0 +1,187 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package amqp
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+	amqplib "github.com/streadway/amqp"
+	"go.uber.org/zap"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sensors/triggers"
+)
+
+// confirmTimeout is the time limit for the server to confirm a message
+const confirmTimeout = time.Minute
+
+// AMQPTrigger holds the context of the AMQP trigger.
+type AMQPTrigger struct {
+	// Sensor object.
+	Sensor *v1alpha1.Sensor
+	// Trigger reference.
+	Trigger *v1alpha1.Trigger
+	// Conn refers to the AMQP client connection.
+	Conn *amqplib.Connection
+	// Logger to log stuff.
+	Logger *zap.Logger
+}
+
+// NewAMQPTrigger returns new AMQP trigger.
+func NewAMQPTrigger(sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, amqpConnections map[string]*amqplib.Connection, logger *zap.Logger) (*AMQPTrigger, error) {
+	amqptrigger := trigger.Template.AMQP
+
+	conn, ok := amqpConnections[trigger.Template.Name]
+	if !ok || conn.IsClosed() {
+		var err error
+		if amqptrigger.TLS != nil {
+			tlsConfig, err := common.GetTLSConfig(amqptrigger.TLS.CACertPath, amqptrigger.TLS.ClientCertPath, amqptrigger.TLS.ClientKeyPath)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to get the tls configuration")
+			}
+			conn, err = amqplib.DialTLS(amqptrigger.URL, tlsConfig)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			conn, err = amqplib.Dial(amqptrigger.URL)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		amqpConnections[trigger.Template.Name] = conn
+	}
+
+	return &AMQPTrigger{
+		Sensor:  sensor,
+		Trigger: trigger,
+		Conn:    conn,
+		Logger:  logger,
+	}, nil
+}
+
+// FetchResource fetches the trigger. As the AMQP trigger is simply an AMQP client, there
+// is no need to fetch any resource from external source
+func (t *AMQPTrigger) FetchResource() (interface{}, error) {
+	return t.Trigger.Template.AMQP, nil
+}
+
+// ApplyResourceParameters applies parameters to the trigger resource
+func (t *AMQPTrigger) ApplyResourceParameters(events map[string]*v1alpha1.Event, resource interface{}) (interface{}, error) {
+	fetchedResource, ok := resource.(*v1alpha1.AMQPTrigger)
+	if !ok {
+		return nil, errors.New("failed to interpret the fetched trigger resource")
+	}
+
+	resourceBytes, err := json.Marshal(fetchedResource)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal the amqp trigger resource")
+	}
+	parameters := fetchedResource.Parameters
+	if parameters != nil {
+		updatedResourceBytes, err := triggers.ApplyParams(resourceBytes, parameters, events)
+		if err != nil {
+			return nil, err
+		}
+		var at *v1alpha1.AMQPTrigger
+		if err := json.Unmarshal(updatedResourceBytes, &at); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal the updated amqp trigger resource after applying resource parameters")
+		}
+		return at, nil
+	}
+	return resource, nil
+}
+
+// Execute executes the trigger
+func (t *AMQPTrigger) Execute(events map[string]*v1alpha1.Event, resource interface{}) (interface{}, error) {
+	trigger, ok := resource.(*v1alpha1.AMQPTrigger)
+	if !ok {
+		return nil, errors.New("failed to interpret the trigger resource")
+	}
+
+	if trigger.Payload == nil {
+		return nil, errors.New("payload parameters are not specified")
+	}
+
+	payload, err := triggers.ConstructPayload(events, trigger.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	ch, err := t.Conn.Channel()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open a channel")
+	}
+	defer ch.Close()
+
+	var confirms chan amqplib.Confirmation
+	if trigger.PublisherConfirms {
+		if err := ch.Confirm(false); err != nil {
+			return nil, errors.Wrap(err, "failed to put the channel in confirm mode")
+		}
+		confirms = ch.NotifyPublish(make(chan amqplib.Confirmation, 1))
+	}
+
+	if err := ch.Publish(trigger.ExchangeName, trigger.RoutingKey, false, false, newPublishing(trigger, payload)); err != nil {
+		return nil, errors.Wrapf(err, "failed to publish the message to the exchange %s", trigger.ExchangeName)
+	}
+
+	if confirms != nil {
+		select {
+		case confirm := <-confirms:
+			if !confirm.Ack {
+				return nil, errors.Errorf("the message published to the exchange %s is not acknowledged", trigger.ExchangeName)
+			}
+		case <-time.After(confirmTimeout):
+			return nil, errors.Errorf("timed out waiting for the confirmation of the message published to the exchange %s", trigger.ExchangeName)
+		}
+	}
+
+	return nil, nil
+}
+
+// newPublishing returns the message to publish.
+func newPublishing(trigger *v1alpha1.AMQPTrigger, payload []byte) amqplib.Publishing {
+	publishing := amqplib.Publishing{
+		ContentType:  trigger.ContentType,
+		DeliveryMode: amqplib.Transient,
+		Timestamp:    time.Now(),
+		Body:         payload,
+	}
+	if publishing.ContentType == "" {
+		publishing.ContentType = "application/json"
+	}
+	if trigger.Persistent {
+		publishing.DeliveryMode = amqplib.Persistent
+	}
+	if len(trigger.Headers) > 0 {
+		headers := make(amqplib.Table, len(trigger.Headers))
+		for key, value := range trigger.Headers {
+			headers[key] = value
+		}
+		publishing.Headers = headers
+	}
+	return publishing
+}
+
+// ApplyPolicy applies policy on the trigger
+func (t *AMQPTrigger) ApplyPolicy(resource interface{}) error {
+	return nil
+}
@@ -16,27 +16,38 @@ limitations under the License.
 package argo_workflow
 
 import (
-	"fmt"
-	"io/ioutil"
-	"os"
-	"os/exec"
+	"encoding/json"
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
 	"github.com/argoproj/argo-events/sensors/policy"
 	"github.com/argoproj/argo-events/sensors/triggers"
+	cloudevents "github.com/cloudevents/sdk-go/v2"
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
-	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 )
 
+// outputEventType is the type of the events that pass the outcome of the workflow to the later triggers
+const outputEventType = "argo-workflow"
+
+// WorkflowOutput is the outcome of the workflow passed to the later triggers of the sensor.
+type WorkflowOutput struct {
+	// Name of the workflow.
+	Name string `json:"name"`
+	// Namespace of the workflow.
+	Namespace string `json:"namespace"`
+	// Phase of the workflow once the trigger applied its policy, e.g. Succeeded if the policy waited for it.
+	Phase string `json:"phase,omitempty"`
+}
+
 // ArgoWorkflowTrigger implements Trigger interface for Argo workflow
 type ArgoWorkflowTrigger struct {
 	// K8sClient is Kubernetes client
@@ -49,8 +60,12 @@ type ArgoWorkflowTrigger struct {
 	Trigger *v1alpha1.Trigger
 	// logger to log stuff
 	Logger *zap.Logger
+	// ConcurrencyLock serializes the concurrency policy checks and the submission of the workflows of the trigger
+	ConcurrencyLock sync.Locker
 
 	namespableDynamicClient dynamic.NamespaceableResourceInterface
+	// output is the outcome of the workflow, once the trigger applied its policy
+	output *v1alpha1.Event
 }
 
 // NewArgoWorkflowTrigger returns a new Argo workflow trigger
@@ -106,110 +121,144 @@ func (t *ArgoWorkflowTrigger) Execute(events map[string]*v1alpha1.Event, resourc
 		}
 	}
 
-	submittedWFLabels := make(map[string]string)
-	if op == v1alpha1.Submit {
-		submittedWFLabels["events.argoproj.io/sensor"] = t.Sensor.Name
-		submittedWFLabels["events.argoproj.io/trigger"] = trigger.Template.Name
-		submittedWFLabels["events.argoproj.io/action-timestamp"] = strconv.Itoa(int(time.Now().UnixNano() / int64(time.Millisecond)))
-	}
-
 	namespace := obj.GetNamespace()
 	if namespace == "" {
 		namespace = t.Sensor.Namespace
 	}
 
-	var cmd *exec.Cmd
+	t.namespableDynamicClient = t.DynamicClient.Resource(t.workflowResource())
+	client := t.namespableDynamicClient.Namespace(namespace)
 
 	switch op {
 	case v1alpha1.Submit:
-		file, err := ioutil.TempFile("", fmt.Sprintf("%s%s", name, obj.GetGenerateName()))
-		if err != nil {
-			return nil, errors.Wrapf(err, "failed to create a temp file for the workflow %s", obj.GetName())
-		}
-		defer os.Remove(file.Name())
-
-		// Add labels
 		labels := obj.GetLabels()
 		if labels == nil {
 			labels = make(map[string]string)
 		}
-		for k, v := range submittedWFLabels {
+		for k, v := range t.submittedWorkflowLabels() {
 			labels[k] = v
 		}
 		obj.SetLabels(labels)
-
-		jObj, err := obj.MarshalJSON()
+		obj.SetNamespace(namespace)
+		if err := setWorkflowArguments(obj, trigger.Template.ArgoWorkflow.Arguments, events); err != nil {
+			return nil, err
+		}
+		triggers.SetOwnerReference(obj, t.Sensor, trigger.Template.ArgoWorkflow.GarbageCollection, t.Logger)
+		return t.submit(client, obj, events)
+	case v1alpha1.SubmitFrom:
+		wf, err := newWorkflowFrom(t.DynamicClient, t.workflowResource(), namespace, obj, t.submittedWorkflowLabels())
 		if err != nil {
 			return nil, err
 		}
-
-		if _, err := file.Write(jObj); err != nil {
-			return nil, errors.Wrapf(err, "failed to write workflow json %s to the temp file %s", name, file.Name())
+		if err := setWorkflowArguments(wf, trigger.Template.ArgoWorkflow.Arguments, events); err != nil {
+			return nil, err
 		}
-		cmd = exec.Command("argo", "-n", namespace, "submit", file.Name())
+		triggers.SetOwnerReference(wf, t.Sensor, trigger.Template.ArgoWorkflow.GarbageCollection, t.Logger)
+		return t.submit(client, wf, events)
 	case v1alpha1.Resubmit:
-		cmd = exec.Command("argo", "-n", namespace, "resubmit", name)
+		wf, err := newResubmittedWorkflow(client, name, t.submittedWorkflowLabels())
+		if err != nil {
+			return nil, err
+		}
+		triggers.SetOwnerReference(wf, t.Sensor, trigger.Template.ArgoWorkflow.GarbageCollection, t.Logger)
+		return t.submit(client, wf, events)
 	case v1alpha1.Resume:
-		cmd = exec.Command("argo", "-n", namespace, "resume", name)
+		return resumeWorkflow(client, name)
 	case v1alpha1.Retry:
-		cmd = exec.Command("argo", "-n", namespace, "retry", name)
+		return retryWorkflow(client, t.K8sClient, name)
 	case v1alpha1.Suspend:
-		cmd = exec.Command("argo", "-n", namespace, "suspend", name)
+		return suspendWorkflow(client, name)
+	case v1alpha1.Terminate:
+		return shutdownWorkflow(client, name, shutdownTerminate)
+	case v1alpha1.Stop:
+		return shutdownWorkflow(client, name, shutdownStop)
 	default:
 		return nil, errors.Errorf("unknown operation type %s", string(op))
 	}
+}
 
-	cmd.Stdout = os.Stdout
-	cmd.Stderr = os.Stderr
-	if err := cmd.Run(); err != nil {
-		return nil, errors.Wrapf(err, "failed to execute %s command for workflow %s", string(op), name)
-	}
-
-	t.namespableDynamicClient = t.DynamicClient.Resource(schema.GroupVersionResource{
-		Group:    "argoproj.io",
-		Version:  "v1alpha1",
-		Resource: "workflows",
-	})
-
-	if op != v1alpha1.Submit {
-		return t.namespableDynamicClient.Namespace(namespace).Get(name, metav1.GetOptions{})
+// submit submits the workflow, unless the concurrency policy of the trigger skips it.
+func (t *ArgoWorkflowTrigger) submit(client dynamic.ResourceInterface, wf *unstructured.Unstructured, events map[string]*v1alpha1.Event) (interface{}, error) {
+	if t.ConcurrencyLock != nil {
+		t.ConcurrencyLock.Lock()
+		defer t.ConcurrencyLock.Unlock()
 	}
-	l, err := t.namespableDynamicClient.Namespace(namespace).List(metav1.ListOptions{LabelSelector: labels.SelectorFromSet(submittedWFLabels).String()})
+	submit, err := triggers.ApplyConcurrencyPolicy(client, t.Sensor, t.Trigger, t.Trigger.Template.ArgoWorkflow.Concurrency, triggers.WorkflowCompletionCondition, events, wf, t.Logger)
 	if err != nil {
 		return nil, err
 	}
-	if len(l.Items) == 0 {
-		return nil, errors.New("failed to list created workflows for unknown reason")
+	if !submit {
+		return nil, nil
+	}
+	return submitWorkflow(client, wf)
+}
+
+// workflowResource returns the group version resource of the workflows, which defaults to argoproj.io/v1alpha1 workflows.
+func (t *ArgoWorkflowTrigger) workflowResource() schema.GroupVersionResource {
+	gvr := t.Trigger.Template.ArgoWorkflow.GroupVersionResource
+	if gvr.Resource == "" {
+		return schema.GroupVersionResource{
+			Group:    "argoproj.io",
+			Version:  "v1alpha1",
+			Resource: "workflows",
+		}
+	}
+	return schema.GroupVersionResource{
+		Group:    gvr.Group,
+		Version:  gvr.Version,
+		Resource: gvr.Resource,
+	}
+}
+
+// submittedWorkflowLabels returns the labels to set on the workflows created by the trigger.
+func (t *ArgoWorkflowTrigger) submittedWorkflowLabels() map[string]string {
+	return map[string]string{
+		triggers.LabelSensor:          t.Sensor.Name,
+		triggers.LabelTrigger:         t.Trigger.Template.Name,
+		triggers.LabelActionTimestamp: strconv.Itoa(int(time.Now().UnixNano() / int64(time.Millisecond))),
 	}
-	return l.Items[0], nil
 }
 
 // ApplyPolicy applies the policy on the trigger
 func (t *ArgoWorkflowTrigger) ApplyPolicy(resource interface{}) error {
-	trigger := t.Trigger
-
-	if trigger.Policy == nil || trigger.Policy.K8s == nil || trigger.Policy.K8s.Labels == nil {
+	// no workflow is submitted when the concurrency policy skips it
+	if resource == nil {
 		return nil
 	}
-
 	obj, ok := resource.(*unstructured.Unstructured)
 	if !ok {
 		return errors.New("failed to interpret the trigger resource")
 	}
+	// the outcome of the workflow is passed on even if the policy timed out, as the trigger doesn't fail then
+	policyErr := t.applyPolicy(obj)
+	if policyErr != nil && policyErr != policy.ErrTimedOut {
+		return policyErr
+	}
+	output, err := t.outputEvent(obj)
+	if err != nil {
+		return err
+	}
+	t.output = output
+	return policyErr
+}
 
-	p := policy.NewResourceLabels(trigger, t.namespableDynamicClient, obj)
-	if p == nil {
+func (t *ArgoWorkflowTrigger) applyPolicy(obj *unstructured.Unstructured) error {
+	trigger := t.Trigger
+	if trigger.Policy == nil || trigger.Policy.K8s == nil {
 		return nil
 	}
 
-	err := p.ApplyPolicy()
+	err := policy.NewResourceLabels(trigger, t.namespableDynamicClient, obj).ApplyPolicy()
+	if err == nil {
+		err = policy.NewResourceCondition(trigger, t.namespableDynamicClient, obj).ApplyPolicy()
+	}
 	if err != nil {
 		switch err {
 		case wait.ErrWaitTimeout:
 			if trigger.Policy.K8s.ErrorOnBackoffTimeout {
 				return errors.Errorf("failed to determine status of the triggered resource. setting trigger state as failed")
 			}
-			return nil
+			return policy.ErrTimedOut
 		default:
 			return err
 		}
@@ -217,3 +266,42 @@ func (t *ArgoWorkflowTrigger) ApplyPolicy(resource interface{}) error {
 
 	return nil
 }
+
+// Output returns the outcome of the workflow as an event for the later triggers, or nil if no workflow was executed.
+func (t *ArgoWorkflowTrigger) Output() *v1alpha1.Event {
+	return t.output
+}
+
+// outputEvent returns the outcome of the workflow as an event. The phase is read from the latest workflow
+// object, as the policy may have waited for the workflow to progress.
+func (t *ArgoWorkflowTrigger) outputEvent(obj *unstructured.Unstructured) (*v1alpha1.Event, error) {
+	if t.namespableDynamicClient != nil && obj.GetName() != "" {
+		latest, err := t.namespableDynamicClient.Namespace(obj.GetNamespace()).Get(obj.GetName(), metav1.GetOptions{})
+		if err != nil {
+			t.Logger.Warn("failed to get the latest workflow, the output may not have the latest phase", zap.String("name", obj.GetName()), zap.Error(err))
+		} else {
+			obj = latest
+		}
+	}
+	phase, _, _ := unstructured.NestedString(obj.Object, "status", "phase")
+	data, err := json.Marshal(&WorkflowOutput{
+		Name:      obj.GetName(),
+		Namespace: obj.GetNamespace(),
+		Phase:     phase,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal the output of the workflow")
+	}
+	return &v1alpha1.Event{
+		Context: &v1alpha1.EventContext{
+			ID:              string(obj.GetUID()),
+			Type:            outputEventType,
+			Source:          t.Sensor.Name,
+			Subject:         t.Trigger.Template.Name,
+			DataContentType: cloudevents.ApplicationJSON,
+			SpecVersion:     cloudevents.VersionV1,
+			Time:            metav1.Now(),
+		},
+		Data: data,
+	}, nil
+}
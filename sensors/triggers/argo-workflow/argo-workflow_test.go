@@ -16,12 +16,17 @@ limitations under the License.
 package argo_workflow
 
 import (
+	"encoding/json"
+	"sync"
 	"testing"
 
 	"github.com/argoproj/argo-events/common/logging"
 	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
 	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sensors/policy"
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -80,7 +85,7 @@ func getFakeWfTrigger() *ArgoWorkflowTrigger {
 				Source: &v1alpha1.ArtifactLocation{
 					Resource: &artifact,
 				},
-				Operation: "Submit",
+				Operation: v1alpha1.Submit,
 				GroupVersionResource: metav1.GroupVersionResource{
 					Group:    "argoproj.io",
 					Version:  "v1alpha1",
@@ -106,3 +111,343 @@ func TestFetchResource(t *testing.T) {
 func TestApplyResourceParameters(t *testing.T) {
 
 }
+
+func TestExecute(t *testing.T) {
+	t.Run("submit", func(t *testing.T) {
+		trigger := getFakeWfTrigger()
+		wf := newUnstructured("argoproj.io/v1alpha1", "Workflow", "", "test")
+		result, err := trigger.Execute(nil, wf)
+		assert.Nil(t, err)
+		obj, ok := result.(*unstructured.Unstructured)
+		assert.True(t, ok)
+		assert.Equal(t, "test", obj.GetName())
+		assert.Equal(t, "fake", obj.GetNamespace())
+		assert.Equal(t, "fake-sensor", obj.GetLabels()["events.argoproj.io/sensor"])
+		assert.Equal(t, "fake", obj.GetLabels()["events.argoproj.io/trigger"])
+	})
+
+	t.Run("resubmit", func(t *testing.T) {
+		trigger := getFakeWfTrigger()
+		trigger.Trigger.Template.ArgoWorkflow.Operation = v1alpha1.Resubmit
+		wf := newUnstructured("argoproj.io/v1alpha1", "Workflow", "fake", "test")
+		wf.Object["spec"] = map[string]interface{}{"entrypoint": "main"}
+		wf.SetLabels(map[string]string{labelCompleted: "true", labelPhase: phaseFailed})
+		_, err := trigger.DynamicClient.Resource(trigger.workflowResource()).Namespace("fake").Create(wf, metav1.CreateOptions{})
+		assert.Nil(t, err)
+
+		result, err := trigger.Execute(nil, newUnstructured("argoproj.io/v1alpha1", "Workflow", "fake", "test"))
+		assert.Nil(t, err)
+		obj := result.(*unstructured.Unstructured)
+		assert.Equal(t, "test-", obj.GetGenerateName())
+		assert.Equal(t, "test", obj.GetLabels()[labelResubmittedFrom])
+		assert.Equal(t, "", obj.GetLabels()[labelCompleted])
+		entrypoint, _, _ := unstructured.NestedString(obj.Object, "spec", "entrypoint")
+		assert.Equal(t, "main", entrypoint)
+	})
+
+	t.Run("suspend and resume", func(t *testing.T) {
+		trigger := getFakeWfTrigger()
+		wf := newUnstructured("argoproj.io/v1alpha1", "Workflow", "fake", "test")
+		wf.Object["status"] = map[string]interface{}{
+			"nodes": map[string]interface{}{
+				"test-1": map[string]interface{}{"type": nodeTypeSuspend, "phase": phaseRunning},
+				"test-2": map[string]interface{}{"type": nodeTypePod, "phase": phaseRunning},
+			},
+		}
+		_, err := trigger.DynamicClient.Resource(trigger.workflowResource()).Namespace("fake").Create(wf, metav1.CreateOptions{})
+		assert.Nil(t, err)
+
+		trigger.Trigger.Template.ArgoWorkflow.Operation = v1alpha1.Suspend
+		result, err := trigger.Execute(nil, newUnstructured("argoproj.io/v1alpha1", "Workflow", "fake", "test"))
+		assert.Nil(t, err)
+		suspended, _, _ := unstructured.NestedBool(result.(*unstructured.Unstructured).Object, "spec", "suspend")
+		assert.True(t, suspended)
+
+		trigger.Trigger.Template.ArgoWorkflow.Operation = v1alpha1.Resume
+		result, err = trigger.Execute(nil, newUnstructured("argoproj.io/v1alpha1", "Workflow", "fake", "test"))
+		assert.Nil(t, err)
+		obj := result.(*unstructured.Unstructured)
+		_, found, _ := unstructured.NestedBool(obj.Object, "spec", "suspend")
+		assert.False(t, found)
+		phase, _, _ := unstructured.NestedString(obj.Object, "status", "nodes", "test-1", "phase")
+		assert.Equal(t, phaseSucceeded, phase)
+		phase, _, _ = unstructured.NestedString(obj.Object, "status", "nodes", "test-2", "phase")
+		assert.Equal(t, phaseRunning, phase)
+	})
+
+	t.Run("retry", func(t *testing.T) {
+		trigger := getFakeWfTrigger()
+		trigger.Trigger.Template.ArgoWorkflow.Operation = v1alpha1.Retry
+		wf := newUnstructured("argoproj.io/v1alpha1", "Workflow", "fake", "test")
+		wf.Object["status"] = map[string]interface{}{
+			"phase":   phaseRunning,
+			"message": "",
+		}
+		_, err := trigger.DynamicClient.Resource(trigger.workflowResource()).Namespace("fake").Create(wf, metav1.CreateOptions{})
+		assert.Nil(t, err)
+		_, err = trigger.Execute(nil, newUnstructured("argoproj.io/v1alpha1", "Workflow", "fake", "test"))
+		assert.NotNil(t, err)
+
+		wf.SetName("test-failed")
+		wf.Object["status"] = map[string]interface{}{
+			"phase":   phaseFailed,
+			"message": "child failed",
+			"nodes": map[string]interface{}{
+				"test-failed-1": map[string]interface{}{"type": nodeTypePod, "phase": phaseSucceeded},
+				"test-failed-2": map[string]interface{}{"type": nodeTypePod, "phase": phaseFailed},
+			},
+		}
+		_, err = trigger.DynamicClient.Resource(trigger.workflowResource()).Namespace("fake").Create(wf, metav1.CreateOptions{})
+		assert.Nil(t, err)
+		_, err = trigger.K8sClient.CoreV1().Pods("fake").Create(&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "test-failed-2", Namespace: "fake"}})
+		assert.Nil(t, err)
+
+		result, err := trigger.Execute(nil, newUnstructured("argoproj.io/v1alpha1", "Workflow", "fake", "test-failed"))
+		assert.Nil(t, err)
+		obj := result.(*unstructured.Unstructured)
+		phase, _, _ := unstructured.NestedString(obj.Object, "status", "phase")
+		assert.Equal(t, phaseRunning, phase)
+		_, found, _ := unstructured.NestedString(obj.Object, "status", "message")
+		assert.False(t, found)
+		nodes, _, _ := unstructured.NestedMap(obj.Object, "status", "nodes")
+		assert.Equal(t, 1, len(nodes))
+		assert.Equal(t, "false", obj.GetLabels()[labelCompleted])
+		_, err = trigger.K8sClient.CoreV1().Pods("fake").Get("test-failed-2", metav1.GetOptions{})
+		assert.True(t, apierrors.IsNotFound(err))
+	})
+
+	t.Run("offloaded node status", func(t *testing.T) {
+		trigger := getFakeWfTrigger()
+		wf := newUnstructured("argoproj.io/v1alpha1", "Workflow", "fake", "test")
+		wf.Object["status"] = map[string]interface{}{
+			"phase":                    phaseFailed,
+			"offloadNodeStatusVersion": "fnv:1234",
+		}
+		_, err := trigger.DynamicClient.Resource(trigger.workflowResource()).Namespace("fake").Create(wf, metav1.CreateOptions{})
+		assert.Nil(t, err)
+
+		for _, operation := range []v1alpha1.ArgoWorkflowOperation{v1alpha1.Resume, v1alpha1.Retry} {
+			trigger.Trigger.Template.ArgoWorkflow.Operation = operation
+			_, err = trigger.Execute(nil, newUnstructured("argoproj.io/v1alpha1", "Workflow", "fake", "test"))
+			assert.NotNil(t, err)
+			assert.Contains(t, err.Error(), "offloaded")
+		}
+	})
+
+	t.Run("terminate and stop", func(t *testing.T) {
+		trigger := getFakeWfTrigger()
+		_, err := trigger.DynamicClient.Resource(trigger.workflowResource()).Namespace("fake").Create(newUnstructured("argoproj.io/v1alpha1", "Workflow", "fake", "test"), metav1.CreateOptions{})
+		assert.Nil(t, err)
+
+		trigger.Trigger.Template.ArgoWorkflow.Operation = v1alpha1.Terminate
+		result, err := trigger.Execute(nil, newUnstructured("argoproj.io/v1alpha1", "Workflow", "fake", "test"))
+		assert.Nil(t, err)
+		shutdown, _, _ := unstructured.NestedString(result.(*unstructured.Unstructured).Object, "spec", "shutdown")
+		assert.Equal(t, shutdownTerminate, shutdown)
+
+		trigger.Trigger.Template.ArgoWorkflow.Operation = v1alpha1.Stop
+		result, err = trigger.Execute(nil, newUnstructured("argoproj.io/v1alpha1", "Workflow", "fake", "test"))
+		assert.Nil(t, err)
+		shutdown, _, _ = unstructured.NestedString(result.(*unstructured.Unstructured).Object, "spec", "shutdown")
+		assert.Equal(t, shutdownStop, shutdown)
+	})
+
+	t.Run("missing name", func(t *testing.T) {
+		trigger := getFakeWfTrigger()
+		trigger.Trigger.Template.ArgoWorkflow.Operation = v1alpha1.Resume
+		_, err := trigger.Execute(nil, newUnstructured("argoproj.io/v1alpha1", "Workflow", "fake", ""))
+		assert.NotNil(t, err)
+	})
+}
+
+func TestSubmitFrom(t *testing.T) {
+	events := map[string]*v1alpha1.Event{
+		"test-dep": {
+			Context: &v1alpha1.EventContext{
+				ID:              "1",
+				Type:            "webhook",
+				Source:          "webhook-gateway",
+				DataContentType: "application/json",
+				Subject:         "example-1",
+			},
+			Data: []byte(`{"message": "hello"}`),
+		},
+	}
+	arguments := []v1alpha1.TriggerParameter{
+		{
+			Src: &v1alpha1.TriggerParameterSource{
+				DependencyName: "test-dep",
+				DataKey:        "message",
+			},
+			Dest: "message",
+		},
+	}
+
+	t.Run("workflow template", func(t *testing.T) {
+		trigger := getFakeWfTrigger()
+		trigger.Trigger.Template.ArgoWorkflow.Operation = v1alpha1.SubmitFrom
+		trigger.Trigger.Template.ArgoWorkflow.Arguments = arguments
+		source := newUnstructured("argoproj.io/v1alpha1", "WorkflowTemplate", "fake", "template")
+
+		_, err := trigger.Execute(events, source)
+		assert.NotNil(t, err)
+
+		_, err = trigger.DynamicClient.Resource(trigger.workflowResource().GroupVersion().WithResource("workflowtemplates")).Namespace("fake").Create(source.DeepCopy(), metav1.CreateOptions{})
+		assert.Nil(t, err)
+		result, err := trigger.Execute(events, source)
+		assert.Nil(t, err)
+		obj := result.(*unstructured.Unstructured)
+		assert.Equal(t, "Workflow", obj.GetKind())
+		assert.Equal(t, "template-", obj.GetGenerateName())
+		assert.Equal(t, "template", obj.GetLabels()[labelWorkflowTemplate])
+		assert.Equal(t, "fake-sensor", obj.GetLabels()["events.argoproj.io/sensor"])
+		ref, _, _ := unstructured.NestedString(obj.Object, "spec", "workflowTemplateRef", "name")
+		assert.Equal(t, "template", ref)
+		parameters, _, _ := unstructured.NestedSlice(obj.Object, "spec", "arguments", "parameters")
+		assert.Equal(t, []interface{}{map[string]interface{}{"name": "message", "value": "hello"}}, parameters)
+	})
+
+	t.Run("cluster workflow template", func(t *testing.T) {
+		trigger := getFakeWfTrigger()
+		trigger.Trigger.Template.ArgoWorkflow.Operation = v1alpha1.SubmitFrom
+		source := newUnstructured("argoproj.io/v1alpha1", "ClusterWorkflowTemplate", "", "template")
+		_, err := trigger.DynamicClient.Resource(trigger.workflowResource().GroupVersion().WithResource("clusterworkflowtemplates")).Create(source.DeepCopy(), metav1.CreateOptions{})
+		assert.Nil(t, err)
+		result, err := trigger.Execute(events, source)
+		assert.Nil(t, err)
+		obj := result.(*unstructured.Unstructured)
+		assert.Equal(t, "fake", obj.GetNamespace())
+		assert.Equal(t, "template", obj.GetLabels()[labelClusterTemplate])
+		clusterScope, _, _ := unstructured.NestedBool(obj.Object, "spec", "workflowTemplateRef", "clusterScope")
+		assert.True(t, clusterScope)
+	})
+
+	t.Run("cron workflow", func(t *testing.T) {
+		trigger := getFakeWfTrigger()
+		trigger.Trigger.Template.ArgoWorkflow.Operation = v1alpha1.SubmitFrom
+		trigger.Trigger.Template.ArgoWorkflow.Arguments = arguments
+		source := newUnstructured("argoproj.io/v1alpha1", "CronWorkflow", "fake", "cron")
+		cronWf := source.DeepCopy()
+		cronWf.Object["spec"] = map[string]interface{}{
+			"schedule": "* * * * *",
+			"workflowSpec": map[string]interface{}{
+				"entrypoint": "main",
+				"arguments": map[string]interface{}{
+					"parameters": []interface{}{
+						map[string]interface{}{"name": "message", "value": "hello world"},
+						map[string]interface{}{"name": "count", "value": "1"},
+					},
+				},
+			},
+		}
+		_, err := trigger.DynamicClient.Resource(trigger.workflowResource().GroupVersion().WithResource("cronworkflows")).Namespace("fake").Create(cronWf, metav1.CreateOptions{})
+		assert.Nil(t, err)
+		result, err := trigger.Execute(events, source)
+		assert.Nil(t, err)
+		obj := result.(*unstructured.Unstructured)
+		assert.Equal(t, "cron", obj.GetLabels()[labelCronWorkflow])
+		entrypoint, _, _ := unstructured.NestedString(obj.Object, "spec", "entrypoint")
+		assert.Equal(t, "main", entrypoint)
+		parameters, _, _ := unstructured.NestedSlice(obj.Object, "spec", "arguments", "parameters")
+		assert.Equal(t, []interface{}{
+			map[string]interface{}{"name": "message", "value": "hello"},
+			map[string]interface{}{"name": "count", "value": "1"},
+		}, parameters)
+	})
+
+	t.Run("unsupported kind", func(t *testing.T) {
+		trigger := getFakeWfTrigger()
+		trigger.Trigger.Template.ArgoWorkflow.Operation = v1alpha1.SubmitFrom
+		_, err := trigger.Execute(events, newUnstructured("argoproj.io/v1alpha1", "Workflow", "fake", "test"))
+		assert.NotNil(t, err)
+	})
+}
+
+func TestConcurrency(t *testing.T) {
+	trigger := getFakeWfTrigger()
+	trigger.Trigger.Template.ArgoWorkflow.Concurrency = &v1alpha1.TriggerConcurrency{
+		Policy: v1alpha1.ConcurrencyPolicyForbid,
+	}
+
+	wf := newUnstructured("argoproj.io/v1alpha1", "Workflow", "fake", "")
+	wf.SetGenerateName("test-")
+	result, err := trigger.Execute(nil, wf)
+	assert.Nil(t, err)
+	assert.NotNil(t, result)
+
+	wf = newUnstructured("argoproj.io/v1alpha1", "Workflow", "fake", "test-2")
+	result, err = trigger.Execute(nil, wf)
+	assert.Nil(t, err)
+	assert.Nil(t, result)
+	assert.Nil(t, trigger.ApplyPolicy(result))
+}
+
+func TestConcurrencyLock(t *testing.T) {
+	client := dynamicFake.NewSimpleDynamicClient(runtime.NewScheme())
+	lock := &sync.Mutex{}
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			trigger := getFakeWfTrigger()
+			trigger.DynamicClient = client
+			trigger.ConcurrencyLock = lock
+			trigger.Trigger.Template.ArgoWorkflow.Concurrency = &v1alpha1.TriggerConcurrency{
+				Policy: v1alpha1.ConcurrencyPolicyForbid,
+			}
+			wf := newUnstructured("argoproj.io/v1alpha1", "Workflow", "fake", "")
+			wf.SetGenerateName("test-")
+			_, err := trigger.Execute(nil, wf)
+			assert.Nil(t, err)
+		}()
+	}
+	wg.Wait()
+
+	list, err := client.Resource(getFakeWfTrigger().workflowResource()).Namespace("fake").List(metav1.ListOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(list.Items))
+}
+
+func TestOutput(t *testing.T) {
+	trigger := getFakeWfTrigger()
+	assert.Nil(t, trigger.Output())
+
+	result, err := trigger.Execute(nil, newUnstructured("argoproj.io/v1alpha1", "Workflow", "", "test"))
+	assert.Nil(t, err)
+	wf, ok := result.(*unstructured.Unstructured)
+	assert.True(t, ok)
+	// the workflow controller progresses the workflow after the submission
+	assert.Nil(t, unstructured.SetNestedField(wf.Object, "Running", "status", "phase"))
+	_, err = trigger.DynamicClient.Resource(trigger.workflowResource()).Namespace("fake").Update(wf, metav1.UpdateOptions{})
+	assert.Nil(t, err)
+
+	assert.Nil(t, trigger.ApplyPolicy(result))
+	output := trigger.Output()
+	assert.NotNil(t, output)
+	assert.Equal(t, "fake", output.Context.Subject)
+	var data WorkflowOutput
+	assert.Nil(t, json.Unmarshal(output.Data, &data))
+	assert.Equal(t, WorkflowOutput{Name: "test", Namespace: "fake", Phase: "Running"}, data)
+}
+
+func TestApplyPolicyTimeout(t *testing.T) {
+	trigger := getFakeWfTrigger()
+	trigger.Trigger.Policy = &v1alpha1.TriggerPolicy{
+		K8s: &v1alpha1.K8SResourcePolicy{
+			SuccessCondition: `status.phase == "Succeeded"`,
+			Timeout:          1,
+		},
+	}
+	result, err := trigger.Execute(nil, newUnstructured("argoproj.io/v1alpha1", "Workflow", "", "test"))
+	assert.Nil(t, err)
+
+	// the trigger doesn't fail, and the outcome of the workflow is passed on
+	assert.Equal(t, policy.ErrTimedOut, trigger.ApplyPolicy(result))
+	assert.NotNil(t, trigger.Output())
+
+	trigger.Trigger.Policy.K8s.ErrorOnBackoffTimeout = true
+	err = trigger.ApplyPolicy(result)
+	assert.NotNil(t, err)
+	assert.NotEqual(t, policy.ErrTimedOut, err)
+}
@@ -0,0 +1,342 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package argo_workflow
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sensors/triggers"
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+)
+
+// Workflow labels and phases, as defined by the Argo Workflow controller.
+const (
+	labelCompleted            = "workflows.argoproj.io/completed"
+	labelPhase                = "workflows.argoproj.io/phase"
+	labelResubmittedFrom      = "workflows.argoproj.io/resubmitted-from-workflow"
+	labelWorkflowTemplate     = "workflows.argoproj.io/workflow-template"
+	labelClusterTemplate      = "workflows.argoproj.io/cluster-workflow-template"
+	labelCronWorkflow         = "workflows.argoproj.io/cron-workflow"
+	kindWorkflowTemplate      = "WorkflowTemplate"
+	kindClusterTemplate       = "ClusterWorkflowTemplate"
+	kindCronWorkflow          = "CronWorkflow"
+	shutdownTerminate         = "Terminate"
+	shutdownStop              = "Stop"
+	nodeTypePod               = "Pod"
+	nodeTypeSuspend           = "Suspend"
+	phaseRunning              = "Running"
+	phaseSucceeded            = "Succeeded"
+	phaseSkipped              = "Skipped"
+	phaseFailed               = "Failed"
+	phaseError                = "Error"
+	workflowTimestampLayout   = time.RFC3339
+	workflowNodeFieldPhase    = "phase"
+	workflowNodeFieldFinished = "finishedAt"
+)
+
+// submitWorkflow creates the workflow.
+func submitWorkflow(client dynamic.ResourceInterface, obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	result, err := client.Create(obj, metav1.CreateOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to submit the workflow")
+	}
+	return result, nil
+}
+
+// newWorkflowFrom returns a workflow for the WorkflowTemplate, ClusterWorkflowTemplate or CronWorkflow.
+// Workflow templates are referenced by the workflow, whereas the workflow spec of a cron workflow is copied.
+func newWorkflowFrom(dynamicClient dynamic.Interface, gvr schema.GroupVersionResource, namespace string, source *unstructured.Unstructured, labels map[string]string) (*unstructured.Unstructured, error) {
+	name := source.GetName()
+	wf := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": gvr.GroupVersion().String(),
+			"kind":       "Workflow",
+		},
+	}
+	wf.SetNamespace(namespace)
+	wf.SetGenerateName(strings.TrimSuffix(name, "-") + "-")
+
+	newLabels := make(map[string]string)
+	for k, v := range labels {
+		newLabels[k] = v
+	}
+
+	switch kind := source.GetKind(); kind {
+	case kindWorkflowTemplate:
+		if _, err := dynamicClient.Resource(gvr.GroupVersion().WithResource("workflowtemplates")).Namespace(namespace).Get(name, metav1.GetOptions{}); err != nil {
+			return nil, errors.Wrapf(err, "failed to get the %s %s", kind, name)
+		}
+		wf.Object["spec"] = map[string]interface{}{
+			"workflowTemplateRef": map[string]interface{}{"name": name},
+		}
+		newLabels[labelWorkflowTemplate] = name
+	case kindClusterTemplate:
+		if _, err := dynamicClient.Resource(gvr.GroupVersion().WithResource("clusterworkflowtemplates")).Get(name, metav1.GetOptions{}); err != nil {
+			return nil, errors.Wrapf(err, "failed to get the %s %s", kind, name)
+		}
+		wf.Object["spec"] = map[string]interface{}{
+			"workflowTemplateRef": map[string]interface{}{"name": name, "clusterScope": true},
+		}
+		newLabels[labelClusterTemplate] = name
+	case kindCronWorkflow:
+		cronWf, err := dynamicClient.Resource(gvr.GroupVersion().WithResource("cronworkflows")).Namespace(namespace).Get(name, metav1.GetOptions{})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get the %s %s", kind, name)
+		}
+		spec, ok, err := unstructured.NestedMap(cronWf.Object, "spec", "workflowSpec")
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, errors.Errorf("%s %s has no workflow spec", kind, name)
+		}
+		wf.Object["spec"] = spec
+		newLabels[labelCronWorkflow] = name
+	default:
+		return nil, errors.Errorf("can't submit a workflow from the %s kind, only %s, %s and %s are supported", kind, kindWorkflowTemplate, kindClusterTemplate, kindCronWorkflow)
+	}
+	wf.SetLabels(newLabels)
+	return wf, nil
+}
+
+// setWorkflowArguments overrides the values of the workflow parameters, adding the missing ones.
+func setWorkflowArguments(wf *unstructured.Unstructured, arguments []v1alpha1.TriggerParameter, events map[string]*v1alpha1.Event) error {
+	if len(arguments) == 0 {
+		return nil
+	}
+	parameters, _, err := unstructured.NestedSlice(wf.Object, "spec", "arguments", "parameters")
+	if err != nil {
+		return err
+	}
+	for _, argument := range arguments {
+		value, err := triggers.ResolveParamValue(argument.Src, events)
+		if err != nil {
+			return errors.Wrapf(err, "failed to resolve the argument %s", argument.Dest)
+		}
+		found := false
+		for i, p := range parameters {
+			parameter, ok := p.(map[string]interface{})
+			if !ok || parameter["name"] != argument.Dest {
+				continue
+			}
+			current, _ := parameter["value"].(string)
+			switch argument.Operation {
+			case v1alpha1.TriggerParameterOpAppend:
+				parameter["value"] = current + value
+			case v1alpha1.TriggerParameterOpPrepend:
+				parameter["value"] = value + current
+			default:
+				parameter["value"] = value
+			}
+			parameters[i] = parameter
+			found = true
+			break
+		}
+		if !found {
+			parameters = append(parameters, map[string]interface{}{
+				"name":  argument.Dest,
+				"value": value,
+			})
+		}
+	}
+	return unstructured.SetNestedSlice(wf.Object, parameters, "spec", "arguments", "parameters")
+}
+
+// newResubmittedWorkflow returns a new workflow with the spec of an existing workflow.
+func newResubmittedWorkflow(client dynamic.ResourceInterface, name string, labels map[string]string) (*unstructured.Unstructured, error) {
+	wf, err := client.Get(name, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get the workflow %s", name)
+	}
+	spec, ok := wf.Object["spec"]
+	if !ok {
+		return nil, errors.Errorf("workflow %s has no spec", name)
+	}
+	newLabels := make(map[string]string)
+	for k, v := range wf.GetLabels() {
+		if k == labelCompleted || k == labelPhase {
+			continue
+		}
+		newLabels[k] = v
+	}
+	for k, v := range labels {
+		newLabels[k] = v
+	}
+	newLabels[labelResubmittedFrom] = name
+
+	newWf := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": wf.GetAPIVersion(),
+			"kind":       wf.GetKind(),
+			"spec":       spec,
+		},
+	}
+	newWf.SetNamespace(wf.GetNamespace())
+	newWf.SetGenerateName(strings.TrimSuffix(name, "-") + "-")
+	newWf.SetLabels(newLabels)
+	newWf.SetAnnotations(wf.GetAnnotations())
+	return newWf, nil
+}
+
+// suspendWorkflow suspends the workflow.
+func suspendWorkflow(client dynamic.ResourceInterface, name string) (*unstructured.Unstructured, error) {
+	result, err := client.Patch(name, types.MergePatchType, []byte(`{"spec":{"suspend":true}}`), metav1.PatchOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to suspend the workflow %s", name)
+	}
+	return result, nil
+}
+
+// shutdownWorkflow terminates or stops the workflow, with the Terminate or Stop shutdown strategy.
+func shutdownWorkflow(client dynamic.ResourceInterface, name, strategy string) (*unstructured.Unstructured, error) {
+	patch := fmt.Sprintf(`{"spec":{"shutdown":%q}}`, strategy)
+	result, err := client.Patch(name, types.MergePatchType, []byte(patch), metav1.PatchOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to %s the workflow %s", strings.ToLower(strategy), name)
+	}
+	return result, nil
+}
+
+// checkNodeStatusNotOffloaded returns an error if the node status of the workflow is offloaded to the database of
+// the Argo server, in which case the status in the workflow object is empty and can't be updated through the
+// Kubernetes API.
+func checkNodeStatusNotOffloaded(wf *unstructured.Unstructured) error {
+	version, _, _ := unstructured.NestedString(wf.Object, "status", "offloadNodeStatusVersion")
+	if version != "" {
+		return errors.Errorf("the node status of workflow %s is offloaded to the Argo Workflows database, use the Argo server to update it", wf.GetName())
+	}
+	return nil
+}
+
+// resumeWorkflow resumes the suspended workflow, and completes its running suspend nodes.
+// The node status layout is the one of Argo Workflows v2.10 and v2.11.
+func resumeWorkflow(client dynamic.ResourceInterface, name string) (*unstructured.Unstructured, error) {
+	var result *unstructured.Unstructured
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		wf, err := client.Get(name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if err := checkNodeStatusNotOffloaded(wf); err != nil {
+			return err
+		}
+		unstructured.RemoveNestedField(wf.Object, "spec", "suspend")
+		nodes, _, err := unstructured.NestedMap(wf.Object, "status", "nodes")
+		if err != nil {
+			return err
+		}
+		now := time.Now().UTC().Format(workflowTimestampLayout)
+		for id, n := range nodes {
+			node, ok := n.(map[string]interface{})
+			if !ok || node["type"] != nodeTypeSuspend || node[workflowNodeFieldPhase] != phaseRunning {
+				continue
+			}
+			node[workflowNodeFieldPhase] = phaseSucceeded
+			node[workflowNodeFieldFinished] = now
+			nodes[id] = node
+		}
+		if len(nodes) > 0 {
+			if err := unstructured.SetNestedMap(wf.Object, nodes, "status", "nodes"); err != nil {
+				return err
+			}
+		}
+		result, err = client.Update(wf, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to resume the workflow %s", name)
+	}
+	return result, nil
+}
+
+// retryWorkflow retries the failed workflow. The nodes that didn't succeed are reset, and the pods of the failed
+// nodes are deleted, so that the workflow controller runs them again.
+// The node status layout is the one of Argo Workflows v2.10 and v2.11.
+func retryWorkflow(client dynamic.ResourceInterface, k8sClient kubernetes.Interface, name string) (*unstructured.Unstructured, error) {
+	var result *unstructured.Unstructured
+	var failedPods []string
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		wf, err := client.Get(name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if err := checkNodeStatusNotOffloaded(wf); err != nil {
+			return err
+		}
+		phase, _, _ := unstructured.NestedString(wf.Object, "status", "phase")
+		if phase != phaseFailed && phase != phaseError {
+			return errors.Errorf("workflow %s is in the %s phase, only failed workflows can be retried", name, phase)
+		}
+		nodes, _, err := unstructured.NestedMap(wf.Object, "status", "nodes")
+		if err != nil {
+			return err
+		}
+		failedPods = nil
+		for id, n := range nodes {
+			node, ok := n.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			switch node[workflowNodeFieldPhase] {
+			case phaseSucceeded, phaseSkipped:
+				continue
+			}
+			if node["type"] == nodeTypePod {
+				failedPods = append(failedPods, id)
+			}
+			delete(nodes, id)
+		}
+		if err := unstructured.SetNestedMap(wf.Object, nodes, "status", "nodes"); err != nil {
+			return err
+		}
+		if err := unstructured.SetNestedField(wf.Object, phaseRunning, "status", "phase"); err != nil {
+			return err
+		}
+		unstructured.RemoveNestedField(wf.Object, "status", "message")
+		unstructured.RemoveNestedField(wf.Object, "status", "finishedAt")
+		labels := wf.GetLabels()
+		if labels == nil {
+			labels = make(map[string]string)
+		}
+		labels[labelCompleted] = "false"
+		delete(labels, labelPhase)
+		wf.SetLabels(labels)
+		result, err = client.Update(wf, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to retry the workflow %s", name)
+	}
+	for _, pod := range failedPods {
+		err := k8sClient.CoreV1().Pods(result.GetNamespace()).Delete(pod, &metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return nil, errors.Wrapf(err, "failed to delete the pod %s of the workflow %s", pod, name)
+		}
+	}
+	return result, nil
+}
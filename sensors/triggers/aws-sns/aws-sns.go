@@ -0,0 +1,144 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package aws_sns
+
+import (
+	"encoding/json"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/aws/aws-sdk-go/service/sns/snsiface"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	commonaws "github.com/argoproj/argo-events/eventsources/common/aws"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sensors/triggers"
+)
+
+// AWSSNSTrigger refers to trigger that publishes messages to AWS SNS topics
+type AWSSNSTrigger struct {
+	// SNSClient is AWS SNS client
+	SNSClient snsiface.SNSAPI
+	// Sensor object
+	Sensor *v1alpha1.Sensor
+	// Trigger definition
+	Trigger *v1alpha1.Trigger
+	// logger to log stuff
+	Logger *zap.Logger
+}
+
+// NewAWSSNSTrigger returns a new AWS SNS context
+func NewAWSSNSTrigger(snsClients map[string]snsiface.SNSAPI, sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, logger *zap.Logger) (*AWSSNSTrigger, error) {
+	snstrigger := trigger.Template.AWSSNS
+
+	snsClient, ok := snsClients[trigger.Template.Name]
+	if !ok {
+		awsSession, err := commonaws.CreateAWSSessionWithCredsInVolume(snstrigger.Region, snstrigger.RoleARN, snstrigger.AccessKey, snstrigger.SecretKey)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create a AWS session")
+		}
+		snsClient = sns.New(awsSession, &aws.Config{Region: &snstrigger.Region})
+		snsClients[trigger.Template.Name] = snsClient
+	}
+
+	return &AWSSNSTrigger{
+		SNSClient: snsClient,
+		Sensor:    sensor,
+		Trigger:   trigger,
+		Logger:    logger,
+	}, nil
+}
+
+// FetchResource fetches the trigger resource
+func (t *AWSSNSTrigger) FetchResource() (interface{}, error) {
+	return t.Trigger.Template.AWSSNS, nil
+}
+
+// ApplyResourceParameters applies parameters to the trigger resource
+func (t *AWSSNSTrigger) ApplyResourceParameters(events map[string]*v1alpha1.Event, resource interface{}) (interface{}, error) {
+	resourceBytes, err := json.Marshal(resource)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal the aws sns trigger resource")
+	}
+	parameters := t.Trigger.Template.AWSSNS.Parameters
+	if parameters != nil {
+		updatedResourceBytes, err := triggers.ApplyParams(resourceBytes, parameters, events)
+		if err != nil {
+			return nil, err
+		}
+		var st *v1alpha1.AWSSNSTrigger
+		if err := json.Unmarshal(updatedResourceBytes, &st); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal the updated aws sns trigger resource after applying resource parameters")
+		}
+		return st, nil
+	}
+	return resource, nil
+}
+
+// Execute executes the trigger
+func (t *AWSSNSTrigger) Execute(events map[string]*v1alpha1.Event, resource interface{}) (interface{}, error) {
+	trigger, ok := resource.(*v1alpha1.AWSSNSTrigger)
+	if !ok {
+		return nil, errors.New("failed to interpret the trigger resource")
+	}
+
+	if trigger.Payload == nil {
+		return nil, errors.New("payload parameters are not specified")
+	}
+
+	payload, err := triggers.ConstructPayload(events, trigger.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &sns.PublishInput{
+		TopicArn: aws.String(trigger.TopicArn),
+		Message:  aws.String(string(payload)),
+	}
+	if trigger.Subject != "" {
+		input.Subject = aws.String(trigger.Subject)
+	}
+	if len(trigger.MessageAttributes) > 0 {
+		attributes := make(map[string]*sns.MessageAttributeValue, len(trigger.MessageAttributes))
+		for key, value := range trigger.MessageAttributes {
+			attributes[key] = &sns.MessageAttributeValue{
+				DataType:    aws.String("String"),
+				StringValue: aws.String(value),
+			}
+		}
+		input.MessageAttributes = attributes
+	}
+	if trigger.MessageGroupID != "" {
+		input.MessageGroupId = aws.String(trigger.MessageGroupID)
+	}
+	if trigger.MessageDeduplicationID != "" {
+		input.MessageDeduplicationId = aws.String(trigger.MessageDeduplicationID)
+	}
+
+	response, err := t.SNSClient.Publish(input)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to publish the message to the topic %s", trigger.TopicArn)
+	}
+
+	t.Logger.Info("message successfully published to the topic", zap.String("topicArn", trigger.TopicArn), zap.String("messageId", aws.StringValue(response.MessageId)))
+	return response, nil
+}
+
+// No Policies for AWSSNSTrigger
+func (t *AWSSNSTrigger) ApplyPolicy(resource interface{}) error {
+	return nil
+}
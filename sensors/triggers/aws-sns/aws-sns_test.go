@@ -0,0 +1,152 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package aws_sns
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/aws/aws-sdk-go/service/sns/snsiface"
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-events/common/logging"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+var sensorObj = &v1alpha1.Sensor{
+	ObjectMeta: metav1.ObjectMeta{
+		Name:      "fake-sensor",
+		Namespace: "fake",
+	},
+	Spec: v1alpha1.SensorSpec{
+		Triggers: []v1alpha1.Trigger{
+			{
+				Template: &v1alpha1.TriggerTemplate{
+					Name: "fake-trigger",
+					AWSSNS: &v1alpha1.AWSSNSTrigger{
+						TopicArn: "arn:aws:sns:us-east-1:123456789012:fake-topic",
+						Region:   "us-east-1",
+						Payload: []v1alpha1.TriggerParameter{
+							{
+								Src: &v1alpha1.TriggerParameterSource{
+									DependencyName: "fake-dependency",
+									DataKey:        "message",
+								},
+								Dest: "message",
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+var testEvents = map[string]*v1alpha1.Event{
+	"fake-dependency": {
+		Context: &v1alpha1.EventContext{
+			ID:              "1",
+			Type:            "webhook",
+			Source:          "webhook-gateway",
+			DataContentType: "application/json",
+			SpecVersion:     cloudevents.VersionV1,
+			Subject:         "example-1",
+		},
+		Data: []byte(`{"message": "hello", "subject": "real-subject", "group": "real-group"}`),
+	},
+}
+
+type fakeSNSClient struct {
+	snsiface.SNSAPI
+	published *sns.PublishInput
+}
+
+func (c *fakeSNSClient) Publish(input *sns.PublishInput) (*sns.PublishOutput, error) {
+	c.published = input
+	return &sns.PublishOutput{MessageId: aws.String("fake-id")}, nil
+}
+
+func getAWSTrigger(client snsiface.SNSAPI) *AWSSNSTrigger {
+	return &AWSSNSTrigger{
+		SNSClient: client,
+		Sensor:    sensorObj.DeepCopy(),
+		Trigger:   sensorObj.Spec.Triggers[0].DeepCopy(),
+		Logger:    logging.NewArgoEventsLogger().Desugar(),
+	}
+}
+
+func TestAWSSNSTrigger_FetchResource(t *testing.T) {
+	trigger := getAWSTrigger(nil)
+	resource, err := trigger.FetchResource()
+	assert.Nil(t, err)
+	assert.NotNil(t, resource)
+
+	at, ok := resource.(*v1alpha1.AWSSNSTrigger)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, "arn:aws:sns:us-east-1:123456789012:fake-topic", at.TopicArn)
+}
+
+func TestAWSSNSTrigger_ApplyResourceParameters(t *testing.T) {
+	trigger := getAWSTrigger(nil)
+	trigger.Trigger.Template.AWSSNS.Parameters = []v1alpha1.TriggerParameter{
+		{
+			Src: &v1alpha1.TriggerParameterSource{
+				DependencyName: "fake-dependency",
+				DataKey:        "subject",
+			},
+			Dest: "subject",
+		},
+		{
+			Src: &v1alpha1.TriggerParameterSource{
+				DependencyName: "fake-dependency",
+				DataKey:        "group",
+			},
+			Dest: "messageGroupId",
+		},
+	}
+
+	response, err := trigger.ApplyResourceParameters(testEvents, trigger.Trigger.Template.AWSSNS)
+	assert.Nil(t, err)
+	assert.NotNil(t, response)
+
+	updatedObj, ok := response.(*v1alpha1.AWSSNSTrigger)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, "real-subject", updatedObj.Subject)
+	assert.Equal(t, "real-group", updatedObj.MessageGroupID)
+}
+
+func TestAWSSNSTrigger_Execute(t *testing.T) {
+	client := &fakeSNSClient{}
+	trigger := getAWSTrigger(client)
+	resource := trigger.Trigger.Template.AWSSNS
+	resource.Subject = "fake-subject"
+	resource.MessageAttributes = map[string]string{"source": "argo-events"}
+	resource.MessageDeduplicationID = "fake-id"
+
+	response, err := trigger.Execute(testEvents, resource)
+	assert.Nil(t, err)
+	assert.NotNil(t, response)
+
+	assert.Equal(t, "arn:aws:sns:us-east-1:123456789012:fake-topic", aws.StringValue(client.published.TopicArn))
+	assert.Equal(t, `{"message":"hello"}`, aws.StringValue(client.published.Message))
+	assert.Equal(t, "fake-subject", aws.StringValue(client.published.Subject))
+	assert.Equal(t, "fake-id", aws.StringValue(client.published.MessageDeduplicationId))
+	assert.Nil(t, client.published.MessageGroupId)
+	assert.Equal(t, "argo-events", aws.StringValue(client.published.MessageAttributes["source"].StringValue))
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package aws_sqs
+
+import (
+	"encoding/json"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/aws/aws-sdk-go/service/sqs/sqsiface"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	commonaws "github.com/argoproj/argo-events/eventsources/common/aws"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sensors/triggers"
+)
+
+// AWSSQSTrigger refers to trigger that sends messages to AWS SQS queues
+type AWSSQSTrigger struct {
+	// SQSClient is AWS SQS client
+	SQSClient sqsiface.SQSAPI
+	// Sensor object
+	Sensor *v1alpha1.Sensor
+	// Trigger definition
+	Trigger *v1alpha1.Trigger
+	// logger to log stuff
+	Logger *zap.Logger
+}
+
+// NewAWSSQSTrigger returns a new AWS SQS context
+func NewAWSSQSTrigger(sqsClients map[string]sqsiface.SQSAPI, sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, logger *zap.Logger) (*AWSSQSTrigger, error) {
+	sqstrigger := trigger.Template.AWSSQS
+
+	sqsClient, ok := sqsClients[trigger.Template.Name]
+	if !ok {
+		awsSession, err := commonaws.CreateAWSSessionWithCredsInVolume(sqstrigger.Region, sqstrigger.RoleARN, sqstrigger.AccessKey, sqstrigger.SecretKey)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create a AWS session")
+		}
+		sqsClient = sqs.New(awsSession, &aws.Config{Region: &sqstrigger.Region})
+		sqsClients[trigger.Template.Name] = sqsClient
+	}
+
+	return &AWSSQSTrigger{
+		SQSClient: sqsClient,
+		Sensor:    sensor,
+		Trigger:   trigger,
+		Logger:    logger,
+	}, nil
+}
+
+// FetchResource fetches the trigger resource
+func (t *AWSSQSTrigger) FetchResource() (interface{}, error) {
+	return t.Trigger.Template.AWSSQS, nil
+}
+
+// ApplyResourceParameters applies parameters to the trigger resource
+func (t *AWSSQSTrigger) ApplyResourceParameters(events map[string]*v1alpha1.Event, resource interface{}) (interface{}, error) {
+	resourceBytes, err := json.Marshal(resource)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal the aws sqs trigger resource")
+	}
+	parameters := t.Trigger.Template.AWSSQS.Parameters
+	if parameters != nil {
+		updatedResourceBytes, err := triggers.ApplyParams(resourceBytes, parameters, events)
+		if err != nil {
+			return nil, err
+		}
+		var st *v1alpha1.AWSSQSTrigger
+		if err := json.Unmarshal(updatedResourceBytes, &st); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal the updated aws sqs trigger resource after applying resource parameters")
+		}
+		return st, nil
+	}
+	return resource, nil
+}
+
+// Execute executes the trigger
+func (t *AWSSQSTrigger) Execute(events map[string]*v1alpha1.Event, resource interface{}) (interface{}, error) {
+	trigger, ok := resource.(*v1alpha1.AWSSQSTrigger)
+	if !ok {
+		return nil, errors.New("failed to interpret the trigger resource")
+	}
+
+	if trigger.Payload == nil {
+		return nil, errors.New("payload parameters are not specified")
+	}
+
+	payload, err := triggers.ConstructPayload(events, trigger.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	getQueueURLInput := &sqs.GetQueueUrlInput{
+		QueueName: &trigger.Queue,
+	}
+	if trigger.QueueAccountID != "" {
+		getQueueURLInput = getQueueURLInput.SetQueueOwnerAWSAccountId(trigger.QueueAccountID)
+	}
+	queueURL, err := t.SQSClient.GetQueueUrl(getQueueURLInput)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get the url of the queue %s", trigger.Queue)
+	}
+
+	input := &sqs.SendMessageInput{
+		QueueUrl:    queueURL.QueueUrl,
+		MessageBody: aws.String(string(payload)),
+	}
+	if len(trigger.MessageAttributes) > 0 {
+		attributes := make(map[string]*sqs.MessageAttributeValue, len(trigger.MessageAttributes))
+		for key, value := range trigger.MessageAttributes {
+			attributes[key] = &sqs.MessageAttributeValue{
+				DataType:    aws.String("String"),
+				StringValue: aws.String(value),
+			}
+		}
+		input.MessageAttributes = attributes
+	}
+	if trigger.MessageGroupID != "" {
+		input.MessageGroupId = aws.String(trigger.MessageGroupID)
+	}
+	if trigger.MessageDeduplicationID != "" {
+		input.MessageDeduplicationId = aws.String(trigger.MessageDeduplicationID)
+	}
+
+	response, err := t.SQSClient.SendMessage(input)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to send the message to the queue %s", trigger.Queue)
+	}
+
+	t.Logger.Info("message successfully sent to the queue", zap.String("queue", trigger.Queue), zap.String("messageId", aws.StringValue(response.MessageId)))
+	return response, nil
+}
+
+// No Policies for AWSSQSTrigger
+func (t *AWSSQSTrigger) ApplyPolicy(resource interface{}) error {
+	return nil
+}
@@ -0,0 +1,171 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package aws_sqs
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/aws/aws-sdk-go/service/sqs/sqsiface"
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-events/common/logging"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+var sensorObj = &v1alpha1.Sensor{
+	ObjectMeta: metav1.ObjectMeta{
+		Name:      "fake-sensor",
+		Namespace: "fake",
+	},
+	Spec: v1alpha1.SensorSpec{
+		Triggers: []v1alpha1.Trigger{
+			{
+				Template: &v1alpha1.TriggerTemplate{
+					Name: "fake-trigger",
+					AWSSQS: &v1alpha1.AWSSQSTrigger{
+						Queue:  "fake-queue.fifo",
+						Region: "us-east-1",
+						Payload: []v1alpha1.TriggerParameter{
+							{
+								Src: &v1alpha1.TriggerParameterSource{
+									DependencyName: "fake-dependency",
+									DataKey:        "message",
+								},
+								Dest: "message",
+							},
+						},
+						MessageAttributes: map[string]string{
+							"source": "argo-events",
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+var testEvents = map[string]*v1alpha1.Event{
+	"fake-dependency": {
+		Context: &v1alpha1.EventContext{
+			ID:              "1",
+			Type:            "webhook",
+			Source:          "webhook-gateway",
+			DataContentType: "application/json",
+			SpecVersion:     cloudevents.VersionV1,
+			Subject:         "example-1",
+		},
+		Data: []byte(`{"message": "hello", "group": "real-group", "id": "real-id", "env": "prod"}`),
+	},
+}
+
+type fakeSQSClient struct {
+	sqsiface.SQSAPI
+	queueURLInput *sqs.GetQueueUrlInput
+	sent          *sqs.SendMessageInput
+}
+
+func (c *fakeSQSClient) GetQueueUrl(input *sqs.GetQueueUrlInput) (*sqs.GetQueueUrlOutput, error) {
+	c.queueURLInput = input
+	return &sqs.GetQueueUrlOutput{
+		QueueUrl: aws.String("https://sqs.us-east-1.amazonaws.com/123456789012/" + *input.QueueName),
+	}, nil
+}
+
+func (c *fakeSQSClient) SendMessage(input *sqs.SendMessageInput) (*sqs.SendMessageOutput, error) {
+	c.sent = input
+	return &sqs.SendMessageOutput{MessageId: aws.String("fake-id")}, nil
+}
+
+func getAWSTrigger(client sqsiface.SQSAPI) *AWSSQSTrigger {
+	return &AWSSQSTrigger{
+		SQSClient: client,
+		Sensor:    sensorObj.DeepCopy(),
+		Trigger:   sensorObj.Spec.Triggers[0].DeepCopy(),
+		Logger:    logging.NewArgoEventsLogger().Desugar(),
+	}
+}
+
+func TestAWSSQSTrigger_FetchResource(t *testing.T) {
+	trigger := getAWSTrigger(nil)
+	resource, err := trigger.FetchResource()
+	assert.Nil(t, err)
+	assert.NotNil(t, resource)
+
+	at, ok := resource.(*v1alpha1.AWSSQSTrigger)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, "fake-queue.fifo", at.Queue)
+}
+
+func TestAWSSQSTrigger_ApplyResourceParameters(t *testing.T) {
+	trigger := getAWSTrigger(nil)
+	trigger.Trigger.Template.AWSSQS.Parameters = []v1alpha1.TriggerParameter{
+		{
+			Src: &v1alpha1.TriggerParameterSource{
+				DependencyName: "fake-dependency",
+				DataKey:        "group",
+			},
+			Dest: "messageGroupId",
+		},
+		{
+			Src: &v1alpha1.TriggerParameterSource{
+				DependencyName: "fake-dependency",
+				DataKey:        "id",
+			},
+			Dest: "messageDeduplicationId",
+		},
+		{
+			Src: &v1alpha1.TriggerParameterSource{
+				DependencyName: "fake-dependency",
+				DataKey:        "env",
+			},
+			Dest: "messageAttributes.env",
+		},
+	}
+
+	response, err := trigger.ApplyResourceParameters(testEvents, trigger.Trigger.Template.AWSSQS)
+	assert.Nil(t, err)
+	assert.NotNil(t, response)
+
+	updatedObj, ok := response.(*v1alpha1.AWSSQSTrigger)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, "real-group", updatedObj.MessageGroupID)
+	assert.Equal(t, "real-id", updatedObj.MessageDeduplicationID)
+	assert.Equal(t, map[string]string{"source": "argo-events", "env": "prod"}, updatedObj.MessageAttributes)
+}
+
+func TestAWSSQSTrigger_Execute(t *testing.T) {
+	client := &fakeSQSClient{}
+	trigger := getAWSTrigger(client)
+	resource := trigger.Trigger.Template.AWSSQS
+	resource.QueueAccountID = "123456789012"
+	resource.MessageGroupID = "fake-group"
+
+	response, err := trigger.Execute(testEvents, resource)
+	assert.Nil(t, err)
+	assert.NotNil(t, response)
+
+	assert.Equal(t, "123456789012", aws.StringValue(client.queueURLInput.QueueOwnerAWSAccountId))
+	assert.Equal(t, "https://sqs.us-east-1.amazonaws.com/123456789012/fake-queue.fifo", aws.StringValue(client.sent.QueueUrl))
+	assert.Equal(t, `{"message":"hello"}`, aws.StringValue(client.sent.MessageBody))
+	assert.Equal(t, "fake-group", aws.StringValue(client.sent.MessageGroupId))
+	assert.Nil(t, client.sent.MessageDeduplicationId)
+	assert.Equal(t, "argo-events", aws.StringValue(client.sent.MessageAttributes["source"].StringValue))
+	assert.Equal(t, "String", aws.StringValue(client.sent.MessageAttributes["source"].DataType))
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package triggers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+// ApplyConcurrencyPolicy applies the concurrency policy of the trigger before it creates the resource. The running
+// resources of the trigger are deleted by the Replace policy, whereas the Forbid policy skips the resource.
+// It returns false if the resource must not be created.
+func ApplyConcurrencyPolicy(client dynamic.ResourceInterface, sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, concurrency *v1alpha1.TriggerConcurrency, defaultCompletionCondition string, events map[string]*v1alpha1.Event, obj *unstructured.Unstructured, logger *zap.Logger) (bool, error) {
+	if concurrency == nil || concurrency.Policy == "" || concurrency.Policy == v1alpha1.ConcurrencyPolicyAllow {
+		return true, nil
+	}
+
+	selector := labels.Set{
+		LabelSensor:  sensor.Name,
+		LabelTrigger: trigger.Template.Name,
+	}
+	if concurrency.CorrelationKey != nil {
+		key, err := ResolveParamValue(concurrency.CorrelationKey, events)
+		if err != nil {
+			return false, errors.Wrap(err, "failed to resolve the correlation key")
+		}
+		hash := sha256.Sum256([]byte(key))
+		selector[LabelCorrelationKey] = hex.EncodeToString(hash[:])[:32]
+		objLabels := obj.GetLabels()
+		if objLabels == nil {
+			objLabels = make(map[string]string)
+		}
+		objLabels[LabelCorrelationKey] = selector[LabelCorrelationKey]
+		obj.SetLabels(objLabels)
+	}
+
+	condition := concurrency.CompletionCondition
+	if condition == "" {
+		condition = defaultCompletionCondition
+	}
+	completion, err := common.CompileCondition(condition)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to compile the completion condition")
+	}
+
+	list, err := client.List(metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return false, errors.Wrap(err, "failed to list the resources of the trigger")
+	}
+	var running []string
+	for i := range list.Items {
+		if completion == nil || !common.EvaluateCondition(completion, &list.Items[i]) {
+			running = append(running, list.Items[i].GetName())
+		}
+	}
+	if len(running) == 0 {
+		return true, nil
+	}
+
+	switch concurrency.Policy {
+	case v1alpha1.ConcurrencyPolicyForbid:
+		logger.Info("skipping the resource, the resources of the trigger are still running", zap.Strings("running", running))
+		return false, nil
+	case v1alpha1.ConcurrencyPolicyReplace:
+		logger.Info("replacing the running resources of the trigger", zap.Strings("running", running))
+		propagation := metav1.DeletePropagationBackground
+		for _, name := range running {
+			if err := client.Delete(name, &metav1.DeleteOptions{PropagationPolicy: &propagation}); err != nil && !apierrors.IsNotFound(err) {
+				return false, errors.Wrapf(err, "failed to delete the running resource %s", name)
+			}
+		}
+		return true, nil
+	default:
+		return false, errors.Errorf("unknown concurrency policy %s", concurrency.Policy)
+	}
+}
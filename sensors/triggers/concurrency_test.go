@@ -0,0 +1,130 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package triggers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+
+	"github.com/argoproj/argo-events/common/logging"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+func TestApplyConcurrencyPolicy(t *testing.T) {
+	gvr := schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+	trigger := &sensorObj.Spec.Triggers[0]
+	logger := logging.NewArgoEventsLogger().Desugar()
+
+	newResource := func(name, phase string, extraLabels map[string]string) *unstructured.Unstructured {
+		obj := newUnstructured("apps/v1", "Deployment", "fake", name)
+		obj.Object["status"] = map[string]interface{}{"phase": phase}
+		objLabels := map[string]string{
+			LabelSensor:  sensorObj.Name,
+			LabelTrigger: trigger.Template.Name,
+		}
+		for k, v := range extraLabels {
+			objLabels[k] = v
+		}
+		obj.SetLabels(objLabels)
+		return obj
+	}
+	names := func(client dynamic.ResourceInterface) []string {
+		list, err := client.List(metav1.ListOptions{})
+		assert.Nil(t, err)
+		var result []string
+		for _, item := range list.Items {
+			result = append(result, item.GetName())
+		}
+		return result
+	}
+
+	t.Run("allow", func(t *testing.T) {
+		client := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), newResource("running", "Running", nil)).Resource(gvr).Namespace("fake")
+		create, err := ApplyConcurrencyPolicy(client, sensorObj, trigger, nil, "", nil, newUnstructured("apps/v1", "Deployment", "fake", "new"), logger)
+		assert.Nil(t, err)
+		assert.True(t, create)
+	})
+
+	concurrency := &v1alpha1.TriggerConcurrency{
+		CompletionCondition: `status.phase == "Succeeded"`,
+	}
+
+	t.Run("forbid", func(t *testing.T) {
+		forbid := concurrency.DeepCopy()
+		forbid.Policy = v1alpha1.ConcurrencyPolicyForbid
+		client := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), newResource("succeeded", "Succeeded", nil)).Resource(gvr).Namespace("fake")
+		create, err := ApplyConcurrencyPolicy(client, sensorObj, trigger, forbid, "", nil, newUnstructured("apps/v1", "Deployment", "fake", "new"), logger)
+		assert.Nil(t, err)
+		assert.True(t, create)
+
+		_, err = client.Create(newResource("running", "Running", nil), metav1.CreateOptions{})
+		assert.Nil(t, err)
+		create, err = ApplyConcurrencyPolicy(client, sensorObj, trigger, forbid, "", nil, newUnstructured("apps/v1", "Deployment", "fake", "new"), logger)
+		assert.Nil(t, err)
+		assert.False(t, create)
+		assert.ElementsMatch(t, []string{"succeeded", "running"}, names(client))
+	})
+
+	t.Run("replace", func(t *testing.T) {
+		replace := concurrency.DeepCopy()
+		replace.Policy = v1alpha1.ConcurrencyPolicyReplace
+		client := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(),
+			newResource("succeeded", "Succeeded", nil),
+			newResource("running", "Running", nil),
+		).Resource(gvr).Namespace("fake")
+		create, err := ApplyConcurrencyPolicy(client, sensorObj, trigger, replace, "", nil, newUnstructured("apps/v1", "Deployment", "fake", "new"), logger)
+		assert.Nil(t, err)
+		assert.True(t, create)
+		assert.ElementsMatch(t, []string{"succeeded"}, names(client))
+	})
+
+	t.Run("correlation key", func(t *testing.T) {
+		forbid := concurrency.DeepCopy()
+		forbid.Policy = v1alpha1.ConcurrencyPolicyForbid
+		forbid.CorrelationKey = &v1alpha1.TriggerParameterSource{
+			DependencyName: "fake-dependency",
+			DataKey:        "name.first",
+		}
+		events := map[string]*v1alpha1.Event{
+			"fake-dependency": {
+				Context: &v1alpha1.EventContext{ID: "1", DataContentType: "application/json"},
+				Data:    []byte(`{"name": {"first": "fake"}}`),
+			},
+		}
+		client := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), newResource("other-key", "Running", map[string]string{LabelCorrelationKey: "other"})).Resource(gvr).Namespace("fake")
+
+		obj := newUnstructured("apps/v1", "Deployment", "fake", "new")
+		create, err := ApplyConcurrencyPolicy(client, sensorObj, trigger, forbid, "", events, obj, logger)
+		assert.Nil(t, err)
+		assert.True(t, create)
+		key := obj.GetLabels()[LabelCorrelationKey]
+		assert.Equal(t, 32, len(key))
+
+		_, err = client.Create(newResource("same-key", "Running", map[string]string{LabelCorrelationKey: key}), metav1.CreateOptions{})
+		assert.Nil(t, err)
+		create, err = ApplyConcurrencyPolicy(client, sensorObj, trigger, forbid, "", events, newUnstructured("apps/v1", "Deployment", "fake", "new"), logger)
+		assert.Nil(t, err)
+		assert.False(t, create)
+	})
+}
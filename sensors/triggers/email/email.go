@@ -0,0 +1,314 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package email
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sensors/triggers"
+)
+
+// sendTimeout is the time limit to send an email
+const sendTimeout = time.Minute
+
+type EmailTrigger struct {
+	// Sensor refer to the sensor object
+	Sensor *v1alpha1.Sensor
+	// Trigger refers to the trigger resource
+	Trigger *v1alpha1.Trigger
+	// Logger to log stuff
+	Logger *zap.Logger
+}
+
+// NewEmailTrigger returns a new Email trigger context
+func NewEmailTrigger(sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, logger *zap.Logger) (*EmailTrigger, error) {
+	return &EmailTrigger{
+		Sensor:  sensor,
+		Trigger: trigger,
+		Logger:  logger,
+	}, nil
+}
+
+func (t *EmailTrigger) FetchResource() (interface{}, error) {
+	return t.Trigger.Template.Email, nil
+}
+
+func (t *EmailTrigger) ApplyResourceParameters(events map[string]*v1alpha1.Event, resource interface{}) (interface{}, error) {
+	resourceBytes, err := json.Marshal(resource)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal the Email trigger resource")
+	}
+	parameters := t.Trigger.Template.Email.Parameters
+
+	if parameters != nil {
+		updatedResourceBytes, err := triggers.ApplyParams(resourceBytes, parameters, events)
+		if err != nil {
+			return nil, err
+		}
+
+		var et *v1alpha1.EmailTrigger
+		if err := json.Unmarshal(updatedResourceBytes, &et); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal the updated Email trigger resource after applying resource parameters")
+		}
+
+		return et, nil
+	}
+
+	return resource, nil
+}
+
+// Execute executes the trigger
+func (t *EmailTrigger) Execute(events map[string]*v1alpha1.Event, resource interface{}) (interface{}, error) {
+	t.Logger.Info("executing EmailTrigger")
+	emailTrigger, ok := resource.(*v1alpha1.EmailTrigger)
+	if !ok {
+		return nil, errors.New("failed to marshal the Email trigger resource")
+	}
+
+	from, err := mail.ParseAddress(emailTrigger.From)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid sender address %s", emailTrigger.From)
+	}
+	var recipients []string
+	for _, address := range append(append([]string{}, emailTrigger.To...), emailTrigger.Cc...) {
+		recipient, err := mail.ParseAddress(address)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid recipient address %s", address)
+		}
+		recipients = append(recipients, recipient.Address)
+	}
+	if len(recipients) == 0 {
+		return nil, errors.New("no email recipient provided")
+	}
+
+	message, err := buildMessage(emailTrigger, time.Now())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to build the email")
+	}
+
+	if err := t.send(emailTrigger, from.Address, recipients, message); err != nil {
+		return nil, errors.Wrapf(err, "failed to send the email through %s", emailTrigger.Host)
+	}
+
+	t.Logger.Info("email successfully sent", zap.Strings("recipients", recipients), zap.String("subject", emailTrigger.Subject))
+	return nil, nil
+}
+
+// send sends the message through the SMTP server.
+func (t *EmailTrigger) send(emailTrigger *v1alpha1.EmailTrigger, from string, recipients []string, message []byte) error {
+	security := emailTrigger.Security
+	if security == "" {
+		security = v1alpha1.EmailSecurityStartTLS
+	}
+	port := int(emailTrigger.Port)
+	if port == 0 {
+		port = 587
+		if security == v1alpha1.EmailSecurityTLS {
+			port = 465
+		}
+	}
+	addr := net.JoinHostPort(emailTrigger.Host, strconv.Itoa(port))
+
+	tlsConfig, err := getTLSConfig(emailTrigger)
+	if err != nil {
+		return err
+	}
+
+	dialer := &net.Dialer{Timeout: sendTimeout}
+	var conn net.Conn
+	if security == v1alpha1.EmailSecurityTLS {
+		conn, err = tls.DialWithDialer(dialer, "tcp", addr, tlsConfig)
+	} else {
+		conn, err = dialer.Dial("tcp", addr)
+	}
+	if err != nil {
+		return err
+	}
+	if err := conn.SetDeadline(time.Now().Add(sendTimeout)); err != nil {
+		conn.Close()
+		return err
+	}
+
+	client, err := smtp.NewClient(conn, emailTrigger.Host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+
+	if security == v1alpha1.EmailSecurityStartTLS {
+		if ok, _ := client.Extension("STARTTLS"); !ok {
+			return errors.New("the server doesn't support STARTTLS")
+		}
+		if err := client.StartTLS(tlsConfig); err != nil {
+			return err
+		}
+	}
+
+	if emailTrigger.Username != nil && emailTrigger.Password != nil {
+		username, err := common.GetSecretFromVolume(emailTrigger.Username)
+		if err != nil {
+			return errors.Wrap(err, "failed to retrieve the username")
+		}
+		password, err := common.GetSecretFromVolume(emailTrigger.Password)
+		if err != nil {
+			return errors.Wrap(err, "failed to retrieve the password")
+		}
+		if err := client.Auth(smtp.PlainAuth("", strings.TrimSpace(username), strings.TrimSpace(password), emailTrigger.Host)); err != nil {
+			return err
+		}
+	}
+
+	if err := client.Mail(from); err != nil {
+		return err
+	}
+	for _, recipient := range recipients {
+		if err := client.Rcpt(recipient); err != nil {
+			return errors.Wrapf(err, "recipient %s is rejected", recipient)
+		}
+	}
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(message); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
+
+// getTLSConfig returns the tls configuration of the connection to the SMTP server.
+func getTLSConfig(emailTrigger *v1alpha1.EmailTrigger) (*tls.Config, error) {
+	tlsConfig := &tls.Config{ServerName: emailTrigger.Host}
+	if emailTrigger.TLS == nil {
+		return tlsConfig, nil
+	}
+	if emailTrigger.TLS.CACertPath != "" {
+		caCert, err := ioutil.ReadFile(emailTrigger.TLS.CACertPath)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read ca cert file %s", emailTrigger.TLS.CACertPath)
+		}
+		pool := x509.NewCertPool()
+		pool.AppendCertsFromPEM(caCert)
+		tlsConfig.RootCAs = pool
+	}
+	if emailTrigger.TLS.ClientCertPath != "" && emailTrigger.TLS.ClientKeyPath != "" {
+		clientCert, err := tls.LoadX509KeyPair(emailTrigger.TLS.ClientCertPath, emailTrigger.TLS.ClientKeyPath)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to load client cert key pair %s", emailTrigger.TLS.ClientCertPath)
+		}
+		tlsConfig.Certificates = []tls.Certificate{clientCert}
+	}
+	return tlsConfig, nil
+}
+
+// buildMessage builds the email with its headers. An email with both the plain text and the HTML bodies is a
+// multipart/alternative message.
+func buildMessage(emailTrigger *v1alpha1.EmailTrigger, now time.Time) ([]byte, error) {
+	var buf bytes.Buffer
+	header := func(key, value string) {
+		fmt.Fprintf(&buf, "%s: %s\r\n", key, value)
+	}
+	header("From", emailTrigger.From)
+	if len(emailTrigger.To) > 0 {
+		header("To", strings.Join(emailTrigger.To, ", "))
+	}
+	if len(emailTrigger.Cc) > 0 {
+		header("Cc", strings.Join(emailTrigger.Cc, ", "))
+	}
+	header("Subject", mime.QEncoding.Encode("utf-8", emailTrigger.Subject))
+	header("Date", now.Format(time.RFC1123Z))
+	header("MIME-Version", "1.0")
+
+	if emailTrigger.Body == "" || emailTrigger.HTML == "" {
+		contentType, body := "text/plain", emailTrigger.Body
+		if emailTrigger.HTML != "" {
+			contentType, body = "text/html", emailTrigger.HTML
+		}
+		header("Content-Type", contentType+"; charset=UTF-8")
+		header("Content-Transfer-Encoding", "quoted-printable")
+		buf.WriteString("\r\n")
+		if err := writeQuotedPrintable(&buf, body); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	}
+
+	var parts bytes.Buffer
+	writer := multipart.NewWriter(&parts)
+	header("Content-Type", "multipart/alternative; boundary="+writer.Boundary())
+	buf.WriteString("\r\n")
+	for _, part := range []struct {
+		contentType string
+		body        string
+	}{
+		{"text/plain", emailTrigger.Body},
+		{"text/html", emailTrigger.HTML},
+	} {
+		w, err := writer.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType + "; charset=UTF-8"},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err := writeQuotedPrintable(w, part.body); err != nil {
+			return nil, err
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	buf.Write(parts.Bytes())
+	return buf.Bytes(), nil
+}
+
+func writeQuotedPrintable(w io.Writer, body string) error {
+	qp := quotedprintable.NewWriter(w)
+	if _, err := qp.Write([]byte(body)); err != nil {
+		return err
+	}
+	return qp.Close()
+}
+
+// No Policies for EmailTrigger
+func (t *EmailTrigger) ApplyPolicy(resource interface{}) error {
+	return nil
+}
@@ -0,0 +1,282 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package email
+
+import (
+	"net"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-events/common/logging"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+var sensorObj = &v1alpha1.Sensor{
+	ObjectMeta: metav1.ObjectMeta{
+		Name:      "fake-sensor",
+		Namespace: "fake",
+	},
+	Spec: v1alpha1.SensorSpec{
+		Triggers: []v1alpha1.Trigger{
+			{
+				Template: &v1alpha1.TriggerTemplate{
+					Name: "fake-trigger",
+					Email: &v1alpha1.EmailTrigger{
+						Host:    "smtp.example.com",
+						From:    "Argo Events <argo-events@example.com>",
+						To:      []string{"fake@example.com"},
+						Subject: "fake-subject",
+						Body:    "fake-body",
+					},
+				},
+			},
+		},
+	},
+}
+
+func getEmailTrigger() *EmailTrigger {
+	return &EmailTrigger{
+		Sensor:  sensorObj.DeepCopy(),
+		Trigger: sensorObj.Spec.Triggers[0].DeepCopy(),
+		Logger:  logging.NewArgoEventsLogger().Desugar(),
+	}
+}
+
+func TestEmailTrigger_FetchResource(t *testing.T) {
+	trigger := getEmailTrigger()
+	resource, err := trigger.FetchResource()
+	assert.Nil(t, err)
+	assert.NotNil(t, resource)
+
+	ot, ok := resource.(*v1alpha1.EmailTrigger)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, "fake-subject", ot.Subject)
+	assert.Equal(t, "fake-body", ot.Body)
+}
+
+func TestEmailTrigger_ApplyResourceParameters(t *testing.T) {
+	trigger := getEmailTrigger()
+
+	testEvents := map[string]*v1alpha1.Event{
+		"fake-dependency": {
+			Context: &v1alpha1.EventContext{
+				ID:              "1",
+				Type:            "webhook",
+				Source:          "webhook-gateway",
+				DataContentType: "application/json",
+				SpecVersion:     "1.0",
+				Subject:         "example-1",
+			},
+			Data: []byte(`{"to": "real@example.com", "subject": "real-subject", "html": "<b>real-body</b>"}`),
+		},
+	}
+
+	trigger.Trigger.Template.Email.Parameters = []v1alpha1.TriggerParameter{
+		{
+			Src: &v1alpha1.TriggerParameterSource{
+				DependencyName: "fake-dependency",
+				DataKey:        "to",
+			},
+			Dest: "to.0",
+		},
+		{
+			Src: &v1alpha1.TriggerParameterSource{
+				DependencyName: "fake-dependency",
+				DataKey:        "subject",
+			},
+			Dest: "subject",
+		},
+		{
+			Src: &v1alpha1.TriggerParameterSource{
+				DependencyName: "fake-dependency",
+				DataKey:        "html",
+			},
+			Dest: "html",
+		},
+	}
+
+	resource, err := trigger.ApplyResourceParameters(testEvents, trigger.Trigger.Template.Email)
+	assert.Nil(t, err)
+	assert.NotNil(t, resource)
+
+	ot, ok := resource.(*v1alpha1.EmailTrigger)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, []string{"real@example.com"}, ot.To)
+	assert.Equal(t, "real-subject", ot.Subject)
+	assert.Equal(t, "<b>real-body</b>", ot.HTML)
+	assert.Equal(t, "fake-body", ot.Body)
+}
+
+func TestBuildMessage(t *testing.T) {
+	now := time.Date(2020, 6, 1, 10, 0, 0, 0, time.UTC)
+
+	t.Run("plain text", func(t *testing.T) {
+		message, err := buildMessage(&v1alpha1.EmailTrigger{
+			From:    "from@example.com",
+			To:      []string{"a@example.com", "b@example.com"},
+			Cc:      []string{"c@example.com"},
+			Subject: "héllo",
+			Body:    "hello world",
+		}, now)
+		assert.Nil(t, err)
+		msg := string(message)
+		assert.Contains(t, msg, "From: from@example.com\r\n")
+		assert.Contains(t, msg, "To: a@example.com, b@example.com\r\n")
+		assert.Contains(t, msg, "Cc: c@example.com\r\n")
+		assert.Contains(t, msg, "Subject: =?utf-8?q?h=C3=A9llo?=\r\n")
+		assert.Contains(t, msg, "Date: Mon, 01 Jun 2020 10:00:00 +0000\r\n")
+		assert.Contains(t, msg, "Content-Type: text/plain; charset=UTF-8\r\n")
+		assert.True(t, strings.HasSuffix(msg, "\r\n\r\nhello world"))
+	})
+
+	t.Run("html", func(t *testing.T) {
+		message, err := buildMessage(&v1alpha1.EmailTrigger{
+			From: "from@example.com",
+			To:   []string{"a@example.com"},
+			HTML: "<p>hello</p>",
+		}, now)
+		assert.Nil(t, err)
+		msg := string(message)
+		assert.Contains(t, msg, "Content-Type: text/html; charset=UTF-8\r\n")
+		assert.True(t, strings.HasSuffix(msg, "\r\n\r\n<p>hello</p>"))
+	})
+
+	t.Run("plain text and html", func(t *testing.T) {
+		message, err := buildMessage(&v1alpha1.EmailTrigger{
+			From: "from@example.com",
+			To:   []string{"a@example.com"},
+			Body: "hello",
+			HTML: "<p>hello</p>",
+		}, now)
+		assert.Nil(t, err)
+		msg := string(message)
+		assert.Contains(t, msg, "Content-Type: multipart/alternative; boundary=")
+		plain := strings.Index(msg, "Content-Type: text/plain; charset=UTF-8")
+		html := strings.Index(msg, "Content-Type: text/html; charset=UTF-8")
+		assert.True(t, plain > 0)
+		assert.True(t, html > plain)
+	})
+}
+
+// fakeSMTPServer accepts a single session and records the envelope and the data it receives.
+type fakeSMTPServer struct {
+	listener   net.Listener
+	from       string
+	recipients []string
+	data       string
+	done       chan struct{}
+}
+
+func newFakeSMTPServer(t *testing.T) *fakeSMTPServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	server := &fakeSMTPServer{listener: listener, done: make(chan struct{})}
+	go server.serve()
+	return server
+}
+
+func (s *fakeSMTPServer) serve() {
+	defer close(s.done)
+	nc, err := s.listener.Accept()
+	if err != nil {
+		return
+	}
+	conn := textproto.NewConn(nc)
+	defer conn.Close()
+	_ = conn.PrintfLine("220 localhost ESMTP")
+	for {
+		line, err := conn.ReadLine()
+		if err != nil {
+			return
+		}
+		command := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+		switch {
+		case command == "EHLO" || command == "HELO":
+			_ = conn.PrintfLine("250 localhost")
+		case strings.HasPrefix(strings.ToUpper(line), "MAIL FROM:"):
+			s.from = strings.Trim(line[len("MAIL FROM:"):], "<>")
+			_ = conn.PrintfLine("250 OK")
+		case strings.HasPrefix(strings.ToUpper(line), "RCPT TO:"):
+			s.recipients = append(s.recipients, strings.Trim(line[len("RCPT TO:"):], "<>"))
+			_ = conn.PrintfLine("250 OK")
+		case command == "DATA":
+			_ = conn.PrintfLine("354 go ahead")
+			data, err := conn.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			s.data = string(data)
+			_ = conn.PrintfLine("250 OK")
+		case command == "QUIT":
+			_ = conn.PrintfLine("221 bye")
+			return
+		default:
+			_ = conn.PrintfLine("502 unsupported")
+		}
+	}
+}
+
+func TestEmailTrigger_Execute(t *testing.T) {
+	server := newFakeSMTPServer(t)
+	defer server.listener.Close()
+
+	host, port, err := net.SplitHostPort(server.listener.Addr().String())
+	assert.Nil(t, err)
+	portNumber, err := strconv.Atoi(port)
+	assert.Nil(t, err)
+
+	trigger := getEmailTrigger()
+	emailTrigger := trigger.Trigger.Template.Email
+	emailTrigger.Host = host
+	emailTrigger.Port = int32(portNumber)
+	emailTrigger.Security = v1alpha1.EmailSecurityNone
+	emailTrigger.Cc = []string{"Someone <cc@example.com>"}
+
+	result, err := trigger.Execute(nil, emailTrigger)
+	assert.Nil(t, err)
+	assert.Nil(t, result)
+
+	<-server.done
+	assert.Equal(t, "argo-events@example.com", server.from)
+	assert.Equal(t, []string{"fake@example.com", "cc@example.com"}, server.recipients)
+	assert.Contains(t, server.data, "Subject: fake-subject\n")
+	assert.Contains(t, server.data, "fake-body")
+}
+
+func TestEmailTrigger_ExecuteStartTLSNotSupported(t *testing.T) {
+	server := newFakeSMTPServer(t)
+	defer server.listener.Close()
+
+	host, port, err := net.SplitHostPort(server.listener.Addr().String())
+	assert.Nil(t, err)
+	portNumber, err := strconv.Atoi(port)
+	assert.Nil(t, err)
+
+	trigger := getEmailTrigger()
+	emailTrigger := trigger.Trigger.Template.Email
+	emailTrigger.Host = host
+	emailTrigger.Port = int32(portNumber)
+
+	_, err = trigger.Execute(nil, emailTrigger)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "STARTTLS")
+}
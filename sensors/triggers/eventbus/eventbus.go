@@ -0,0 +1,141 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package eventbus
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/argoproj/argo-events/eventbus/driver"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sensors/triggers"
+)
+
+// defaultEventType is the type of the events published by the trigger if the type is not specified
+const defaultEventType = "sensor"
+
+// EventBusTrigger holds the context of the EventBus trigger.
+type EventBusTrigger struct {
+	// Sensor object.
+	Sensor *v1alpha1.Sensor
+	// Trigger reference.
+	Trigger *v1alpha1.Trigger
+	// Driver refers to the EventBus driver of the sensor.
+	Driver driver.Driver
+	// Conn refers to the EventBus connection used to publish the events.
+	Conn driver.Connection
+	// Logger to log stuff.
+	Logger *zap.Logger
+}
+
+// NewEventBusTrigger returns new EventBus trigger.
+func NewEventBusTrigger(sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, ebDriver driver.Driver, conn driver.Connection, logger *zap.Logger) *EventBusTrigger {
+	return &EventBusTrigger{
+		Sensor:  sensor,
+		Trigger: trigger,
+		Driver:  ebDriver,
+		Conn:    conn,
+		Logger:  logger,
+	}
+}
+
+// FetchResource fetches the trigger. As the EventBus trigger simply publishes an event on the EventBus, there
+// is no need to fetch any resource from external source
+func (t *EventBusTrigger) FetchResource() (interface{}, error) {
+	return t.Trigger.Template.EventBus, nil
+}
+
+// ApplyResourceParameters applies parameters to the trigger resource
+func (t *EventBusTrigger) ApplyResourceParameters(events map[string]*v1alpha1.Event, resource interface{}) (interface{}, error) {
+	fetchedResource, ok := resource.(*v1alpha1.EventBusTrigger)
+	if !ok {
+		return nil, errors.New("failed to interpret the fetched trigger resource")
+	}
+
+	resourceBytes, err := json.Marshal(fetchedResource)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal the eventbus trigger resource")
+	}
+	parameters := fetchedResource.Parameters
+	if parameters != nil {
+		updatedResourceBytes, err := triggers.ApplyParams(resourceBytes, parameters, events)
+		if err != nil {
+			return nil, err
+		}
+		var et *v1alpha1.EventBusTrigger
+		if err := json.Unmarshal(updatedResourceBytes, &et); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal the updated eventbus trigger resource after applying resource parameters")
+		}
+		return et, nil
+	}
+	return resource, nil
+}
+
+// Execute executes the trigger
+func (t *EventBusTrigger) Execute(events map[string]*v1alpha1.Event, resource interface{}) (interface{}, error) {
+	trigger, ok := resource.(*v1alpha1.EventBusTrigger)
+	if !ok {
+		return nil, errors.New("failed to interpret the trigger resource")
+	}
+
+	if trigger.Payload == nil {
+		return nil, errors.New("payload parameters are not specified")
+	}
+
+	payload, err := triggers.ConstructPayload(events, trigger.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	eventType := trigger.Type
+	if eventType == "" {
+		eventType = defaultEventType
+	}
+
+	event := cloudevents.NewEvent()
+	event.SetID(fmt.Sprintf("%x", uuid.New()))
+	event.SetType(eventType)
+	event.SetSource(trigger.Source)
+	event.SetSubject(trigger.Subject)
+	event.SetTime(time.Now())
+	if err := event.SetData(cloudevents.ApplicationJSON, payload); err != nil {
+		return nil, errors.Wrap(err, "failed to set the data of the event")
+	}
+	eventBody, err := json.Marshal(event)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal the event")
+	}
+
+	if t.Conn == nil || t.Conn.IsClosed() {
+		return nil, errors.New("failed to publish the event, eventbus connection closed")
+	}
+	if err := t.Driver.Publish(t.Conn, eventBody); err != nil {
+		return nil, errors.Wrapf(err, "failed to publish the event with source %s and subject %s", trigger.Source, trigger.Subject)
+	}
+
+	return event.ID(), nil
+}
+
+// ApplyPolicy applies policy on the trigger
+func (t *EventBusTrigger) ApplyPolicy(resource interface{}) error {
+	return nil
+}
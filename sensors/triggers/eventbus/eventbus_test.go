@@ -0,0 +1,151 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package eventbus
+
+import (
+	"encoding/json"
+	"testing"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-events/common/logging"
+	"github.com/argoproj/argo-events/eventbus/driver"
+	"github.com/argoproj/argo-events/eventbus/driver/mocks"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+var sensorObj = &v1alpha1.Sensor{
+	ObjectMeta: metav1.ObjectMeta{
+		Name:      "fake-sensor",
+		Namespace: "fake",
+	},
+	Spec: v1alpha1.SensorSpec{
+		Triggers: []v1alpha1.Trigger{
+			{
+				Template: &v1alpha1.TriggerTemplate{
+					Name: "fake-trigger",
+					EventBus: &v1alpha1.EventBusTrigger{
+						Source:  "fake-sensor",
+						Subject: "order-",
+						Payload: []v1alpha1.TriggerParameter{
+							{
+								Src: &v1alpha1.TriggerParameterSource{
+									DependencyName: "fake-dependency",
+									DataKey:        "id",
+								},
+								Dest: "id",
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+var testEvents = map[string]*v1alpha1.Event{
+	"fake-dependency": {
+		Context: &v1alpha1.EventContext{
+			ID:              "1",
+			Type:            "webhook",
+			Source:          "webhook-gateway",
+			DataContentType: "application/json",
+			SpecVersion:     cloudevents.VersionV1,
+			Subject:         "example-1",
+		},
+		Data: []byte(`{"id": "1", "status": "approved"}`),
+	},
+}
+
+type fakeConnection struct {
+	driver.Connection
+	closed bool
+}
+
+func (c *fakeConnection) IsClosed() bool {
+	return c.closed
+}
+
+func getEventBusTrigger(ebDriver driver.Driver, conn driver.Connection) *EventBusTrigger {
+	return NewEventBusTrigger(sensorObj.DeepCopy(), sensorObj.Spec.Triggers[0].DeepCopy(), ebDriver, conn, logging.NewArgoEventsLogger().Desugar())
+}
+
+func TestEventBusTrigger_FetchResource(t *testing.T) {
+	trigger := getEventBusTrigger(&mocks.Driver{}, &fakeConnection{})
+	resource, err := trigger.FetchResource()
+	assert.Nil(t, err)
+	assert.NotNil(t, resource)
+
+	et, ok := resource.(*v1alpha1.EventBusTrigger)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, "fake-sensor", et.Source)
+}
+
+func TestEventBusTrigger_ApplyResourceParameters(t *testing.T) {
+	trigger := getEventBusTrigger(&mocks.Driver{}, &fakeConnection{})
+	trigger.Trigger.Template.EventBus.Parameters = []v1alpha1.TriggerParameter{
+		{
+			Src: &v1alpha1.TriggerParameterSource{
+				DependencyName: "fake-dependency",
+				DataKey:        "status",
+			},
+			Dest:      "subject",
+			Operation: v1alpha1.TriggerParameterOpAppend,
+		},
+	}
+
+	response, err := trigger.ApplyResourceParameters(testEvents, trigger.Trigger.Template.EventBus)
+	assert.Nil(t, err)
+	assert.NotNil(t, response)
+
+	updatedObj, ok := response.(*v1alpha1.EventBusTrigger)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, "order-approved", updatedObj.Subject)
+}
+
+func TestEventBusTrigger_Execute(t *testing.T) {
+	conn := &fakeConnection{}
+	ebDriver := &mocks.Driver{}
+	var published []byte
+	ebDriver.On("Publish", conn, mock.Anything).Run(func(args mock.Arguments) {
+		published = args.Get(1).([]byte)
+	}).Return(nil).Once()
+	ebDriver.On("Publish", conn, mock.Anything).Return(errors.New("fake error")).Once()
+	trigger := getEventBusTrigger(ebDriver, conn)
+
+	id, err := trigger.Execute(testEvents, trigger.Trigger.Template.EventBus)
+	assert.Nil(t, err)
+
+	event := cloudevents.NewEvent()
+	assert.Nil(t, json.Unmarshal(published, &event))
+	assert.Equal(t, id, event.ID())
+	assert.Equal(t, "sensor", event.Type())
+	assert.Equal(t, "fake-sensor", event.Source())
+	assert.Equal(t, "order-", event.Subject())
+	assert.Equal(t, `{"id":"1"}`, string(event.Data()))
+
+	_, err = trigger.Execute(testEvents, trigger.Trigger.Template.EventBus)
+	assert.NotNil(t, err)
+
+	conn.closed = true
+	_, err = trigger.Execute(testEvents, trigger.Trigger.Template.EventBus)
+	assert.NotNil(t, err)
+	ebDriver.AssertExpectations(t)
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package triggers
+
+import (
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+// Labels of the resources created by the triggers
+const (
+	// LabelSensor is the label for the name of the sensor that created the resource
+	LabelSensor = "events.argoproj.io/sensor"
+	// LabelTrigger is the label for the name of the trigger that created the resource
+	LabelTrigger = "events.argoproj.io/trigger"
+	// LabelActionTimestamp is the label for the time in milliseconds when the trigger created the resource
+	LabelActionTimestamp = "events.argoproj.io/action-timestamp"
+	// LabelCompletedAt is the label for the time in seconds when the resource was first seen completed
+	LabelCompletedAt = "events.argoproj.io/completed-at"
+	// LabelCorrelationKey is the label for the hash of the correlation key of the resource
+	LabelCorrelationKey = "events.argoproj.io/correlation-key"
+)
+
+// WorkflowCompletionCondition identifies the completed workflows, if the trigger doesn't define a completion condition.
+const WorkflowCompletionCondition = `metadata.labels["workflows.argoproj.io/completed"] == "true"`
+
+// SetOwnerReference sets the sensor as the owner of the resource, if the garbage collection policy asks for it.
+// Owner references can't cross namespaces, so resources outside the namespace of the sensor are left as they are.
+func SetOwnerReference(obj *unstructured.Unstructured, sensor *v1alpha1.Sensor, gc *v1alpha1.GarbageCollection, logger *zap.Logger) {
+	if gc == nil || !gc.OwnerReference || obj.GetNamespace() != sensor.Namespace {
+		return
+	}
+	if sensor.UID == "" {
+		logger.Warn("the UID of the sensor is unknown, not setting the sensor as the owner of the resource", zap.String("name", obj.GetName()))
+		return
+	}
+	for _, ref := range obj.GetOwnerReferences() {
+		if ref.UID == sensor.UID {
+			return
+		}
+	}
+	obj.SetOwnerReferences(append(obj.GetOwnerReferences(), metav1.OwnerReference{
+		APIVersion: v1alpha1.SchemeGroupVersion.String(),
+		Kind:       "Sensor",
+		Name:       sensor.Name,
+		UID:        sensor.UID,
+	}))
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package triggers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+func TestSetOwnerReference(t *testing.T) {
+	sensor := sensorObj.DeepCopy()
+	sensor.UID = types.UID("fake-uid")
+	gc := &v1alpha1.GarbageCollection{OwnerReference: true}
+
+	obj := newUnstructured("apps/v1", "Deployment", "fake", "test")
+	SetOwnerReference(obj, sensor, nil, zap.NewNop())
+	assert.Empty(t, obj.GetOwnerReferences())
+
+	SetOwnerReference(obj, sensor, gc, zap.NewNop())
+	SetOwnerReference(obj, sensor, gc, zap.NewNop())
+	refs := obj.GetOwnerReferences()
+	assert.Equal(t, 1, len(refs))
+	assert.Equal(t, "Sensor", refs[0].Kind)
+	assert.Equal(t, "fake-sensor", refs[0].Name)
+	assert.Equal(t, types.UID("fake-uid"), refs[0].UID)
+
+	other := newUnstructured("apps/v1", "Deployment", "other", "test")
+	SetOwnerReference(other, sensor, gc, zap.NewNop())
+	assert.Empty(t, other.GetOwnerReferences())
+
+	withoutUID := newUnstructured("apps/v1", "Deployment", "fake", "test")
+	SetOwnerReference(withoutUID, sensorObj.DeepCopy(), gc, zap.NewNop())
+	assert.Empty(t, withoutUID.GetOwnerReferences())
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package gcp_pubsub
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"google.golang.org/api/option"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sensors/triggers"
+)
+
+// publishTimeout is the time limit to publish a message
+const publishTimeout = time.Minute
+
+// GCPPubSubTrigger refers to trigger that publishes messages to GCP Pub/Sub topics
+type GCPPubSubTrigger struct {
+	// Client is GCP Pub/Sub client
+	Client *pubsub.Client
+	// Sensor object
+	Sensor *v1alpha1.Sensor
+	// Trigger definition
+	Trigger *v1alpha1.Trigger
+	// logger to log stuff
+	Logger *zap.Logger
+}
+
+// NewGCPPubSubTrigger returns a new GCP Pub/Sub context.
+// The client connects to the Pub/Sub emulator if the PUBSUB_EMULATOR_HOST environment variable is set.
+func NewGCPPubSubTrigger(pubsubClients map[string]*pubsub.Client, sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, logger *zap.Logger) (*GCPPubSubTrigger, error) {
+	pubsubtrigger := trigger.Template.GCPPubSub
+
+	client, ok := pubsubClients[trigger.Template.Name]
+	if !ok {
+		var opts []option.ClientOption
+		if pubsubtrigger.CredentialSecret != nil {
+			jsonCred, err := common.GetSecretFromVolume(pubsubtrigger.CredentialSecret)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to retrieve the credentials")
+			}
+			opts = append(opts, option.WithCredentialsJSON([]byte(jsonCred)))
+		}
+		var err error
+		client, err = pubsub.NewClient(context.Background(), pubsubtrigger.ProjectID, opts...)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create a GCP Pub/Sub client")
+		}
+		pubsubClients[trigger.Template.Name] = client
+	}
+
+	return &GCPPubSubTrigger{
+		Client:  client,
+		Sensor:  sensor,
+		Trigger: trigger,
+		Logger:  logger,
+	}, nil
+}
+
+// FetchResource fetches the trigger resource
+func (t *GCPPubSubTrigger) FetchResource() (interface{}, error) {
+	return t.Trigger.Template.GCPPubSub, nil
+}
+
+// ApplyResourceParameters applies parameters to the trigger resource
+func (t *GCPPubSubTrigger) ApplyResourceParameters(events map[string]*v1alpha1.Event, resource interface{}) (interface{}, error) {
+	resourceBytes, err := json.Marshal(resource)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal the gcp pubsub trigger resource")
+	}
+	parameters := t.Trigger.Template.GCPPubSub.Parameters
+	if parameters != nil {
+		updatedResourceBytes, err := triggers.ApplyParams(resourceBytes, parameters, events)
+		if err != nil {
+			return nil, err
+		}
+		var pt *v1alpha1.GCPPubSubTrigger
+		if err := json.Unmarshal(updatedResourceBytes, &pt); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal the updated gcp pubsub trigger resource after applying resource parameters")
+		}
+		return pt, nil
+	}
+	return resource, nil
+}
+
+// Execute executes the trigger
+func (t *GCPPubSubTrigger) Execute(events map[string]*v1alpha1.Event, resource interface{}) (interface{}, error) {
+	trigger, ok := resource.(*v1alpha1.GCPPubSubTrigger)
+	if !ok {
+		return nil, errors.New("failed to interpret the trigger resource")
+	}
+
+	if trigger.Payload == nil {
+		return nil, errors.New("payload parameters are not specified")
+	}
+
+	payload, err := triggers.ConstructPayload(events, trigger.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	projectID := trigger.TopicProjectID
+	if projectID == "" {
+		projectID = trigger.ProjectID
+	}
+	topic := t.Client.TopicInProject(trigger.Topic, projectID)
+	topic.EnableMessageOrdering = trigger.OrderingKey != ""
+	defer topic.Stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), publishTimeout)
+	defer cancel()
+
+	messageID, err := topic.Publish(ctx, &pubsub.Message{
+		Data:        payload,
+		Attributes:  trigger.Attributes,
+		OrderingKey: trigger.OrderingKey,
+	}).Get(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to publish the message to the topic %s", topic.String())
+	}
+
+	t.Logger.Info("message successfully published to the topic", zap.String("topic", topic.String()), zap.String("messageId", messageID))
+	return messageID, nil
+}
+
+// No Policies for GCPPubSubTrigger
+func (t *GCPPubSubTrigger) ApplyPolicy(resource interface{}) error {
+	return nil
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package gcp_pubsub
+
+import (
+	"context"
+	"testing"
+
+	"cloud.google.com/go/pubsub"
+	"cloud.google.com/go/pubsub/pstest"
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/api/option"
+	"google.golang.org/grpc"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-events/common/logging"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+var sensorObj = &v1alpha1.Sensor{
+	ObjectMeta: metav1.ObjectMeta{
+		Name:      "fake-sensor",
+		Namespace: "fake",
+	},
+	Spec: v1alpha1.SensorSpec{
+		Triggers: []v1alpha1.Trigger{
+			{
+				Template: &v1alpha1.TriggerTemplate{
+					Name: "fake-trigger",
+					GCPPubSub: &v1alpha1.GCPPubSubTrigger{
+						ProjectID: "fake-project",
+						Topic:     "fake-topic",
+						Payload: []v1alpha1.TriggerParameter{
+							{
+								Src: &v1alpha1.TriggerParameterSource{
+									DependencyName: "fake-dependency",
+									DataKey:        "message",
+								},
+								Dest: "message",
+							},
+						},
+						Attributes: map[string]string{
+							"source": "argo-events",
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+var testEvents = map[string]*v1alpha1.Event{
+	"fake-dependency": {
+		Context: &v1alpha1.EventContext{
+			ID:              "1",
+			Type:            "webhook",
+			Source:          "webhook-gateway",
+			DataContentType: "application/json",
+			SpecVersion:     cloudevents.VersionV1,
+			Subject:         "example-1",
+		},
+		Data: []byte(`{"message": "hello", "customer": "real-customer", "env": "prod"}`),
+	},
+}
+
+// getPubSubTrigger returns a trigger whose client is connected to a fake Pub/Sub server.
+func getPubSubTrigger(t *testing.T) (*GCPPubSubTrigger, *pstest.Server) {
+	server := pstest.NewServer()
+	ctx := context.Background()
+	client, err := pubsub.NewClient(ctx, "fake-project",
+		option.WithEndpoint(server.Addr),
+		option.WithoutAuthentication(),
+		option.WithGRPCDialOption(grpc.WithInsecure()),
+	)
+	assert.Nil(t, err)
+	_, err = client.CreateTopic(ctx, "fake-topic")
+	assert.Nil(t, err)
+
+	trigger, err := NewGCPPubSubTrigger(map[string]*pubsub.Client{"fake-trigger": client}, sensorObj.DeepCopy(), sensorObj.Spec.Triggers[0].DeepCopy(), logging.NewArgoEventsLogger().Desugar())
+	assert.Nil(t, err)
+	return trigger, server
+}
+
+func TestGCPPubSubTrigger_FetchResource(t *testing.T) {
+	trigger, server := getPubSubTrigger(t)
+	defer server.Close()
+
+	resource, err := trigger.FetchResource()
+	assert.Nil(t, err)
+	assert.NotNil(t, resource)
+
+	pt, ok := resource.(*v1alpha1.GCPPubSubTrigger)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, "fake-topic", pt.Topic)
+}
+
+func TestGCPPubSubTrigger_ApplyResourceParameters(t *testing.T) {
+	trigger, server := getPubSubTrigger(t)
+	defer server.Close()
+
+	trigger.Trigger.Template.GCPPubSub.Parameters = []v1alpha1.TriggerParameter{
+		{
+			Src: &v1alpha1.TriggerParameterSource{
+				DependencyName: "fake-dependency",
+				DataKey:        "customer",
+			},
+			Dest: "orderingKey",
+		},
+		{
+			Src: &v1alpha1.TriggerParameterSource{
+				DependencyName: "fake-dependency",
+				DataKey:        "env",
+			},
+			Dest: "attributes.env",
+		},
+	}
+
+	response, err := trigger.ApplyResourceParameters(testEvents, trigger.Trigger.Template.GCPPubSub)
+	assert.Nil(t, err)
+	assert.NotNil(t, response)
+
+	updatedObj, ok := response.(*v1alpha1.GCPPubSubTrigger)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, "real-customer", updatedObj.OrderingKey)
+	assert.Equal(t, map[string]string{"source": "argo-events", "env": "prod"}, updatedObj.Attributes)
+}
+
+func TestGCPPubSubTrigger_Execute(t *testing.T) {
+	trigger, server := getPubSubTrigger(t)
+	defer server.Close()
+
+	resource := trigger.Trigger.Template.GCPPubSub
+	resource.OrderingKey = "fake-key"
+
+	response, err := trigger.Execute(testEvents, resource)
+	assert.Nil(t, err)
+	assert.NotNil(t, response)
+
+	messages := server.Messages()
+	assert.Equal(t, 1, len(messages))
+	assert.Equal(t, response, messages[0].ID)
+	assert.Equal(t, `{"message":"hello"}`, string(messages[0].Data))
+	assert.Equal(t, map[string]string{"source": "argo-events"}, messages[0].Attributes)
+
+	resource.Topic = "unknown-topic"
+	_, err = trigger.Execute(testEvents, resource)
+	assert.NotNil(t, err)
+}
@@ -183,7 +183,15 @@ func (t *HTTPTrigger) Execute(events map[string]*v1alpha1.Event, resource interf
 
 	t.Logger.Info("making a http request...", zap.Any("url", trigger.URL))
 
-	return t.Client.Do(request)
+	response, err := t.Client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	if t.Trigger.RetryStrategy != nil && triggers.IsRetryableHTTPStatus(response.StatusCode) {
+		response.Body.Close()
+		return nil, triggers.NewRetryableError(errors.Errorf("http request to %s failed with response status %d", trigger.URL, response.StatusCode))
+	}
+	return response, nil
 }
 
 // ApplyPolicy applies policy on the trigger
@@ -17,6 +17,7 @@ package http
 
 import (
 	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	cloudevents "github.com/cloudevents/sdk-go/v2"
@@ -24,7 +25,9 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/argoproj/argo-events/common/logging"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
 	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sensors/triggers"
 )
 
 var sensorObj = &v1alpha1.Sensor{
@@ -133,3 +136,27 @@ func TestHTTPTrigger_ApplyPolicy(t *testing.T) {
 	err = trigger.ApplyPolicy(response)
 	assert.NotNil(t, err)
 }
+
+func TestHTTPTrigger_Execute(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		writer.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	trigger := getFakeHTTPTrigger()
+	trigger.Client = server.Client()
+	trigger.Trigger.Template.HTTP.URL = server.URL
+
+	resource, err := trigger.Execute(nil, trigger.Trigger.Template.HTTP)
+	assert.Nil(t, err)
+	response, ok := resource.(*http.Response)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, http.StatusServiceUnavailable, response.StatusCode)
+	response.Body.Close()
+
+	trigger.Trigger.RetryStrategy = &apicommon.Backoff{Steps: 2}
+	resource, err = trigger.Execute(nil, trigger.Trigger.Template.HTTP)
+	assert.NotNil(t, err)
+	assert.Nil(t, resource)
+	assert.True(t, triggers.IsRetryableError(err))
+}
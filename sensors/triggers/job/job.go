@@ -0,0 +1,345 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package job
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+	watchtools "k8s.io/client-go/tools/watch"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sensors/triggers"
+)
+
+const (
+	// defaultTimeout is the time to wait for the Job, if the trigger doesn't define it
+	defaultTimeout = 300 * time.Second
+	// outputEventType is the type of the events that pass the outcome of the Job to the later triggers
+	outputEventType = "job"
+	// JobSucceeded is the status of a completed Job
+	JobSucceeded = "Succeeded"
+	// JobFailed is the status of a failed Job
+	JobFailed = "Failed"
+)
+
+// JobOutput is the outcome of the Job passed to the later triggers of the sensor.
+type JobOutput struct {
+	// Name of the Job.
+	Name string `json:"name"`
+	// Namespace of the Job.
+	Namespace string `json:"namespace"`
+	// Status of the Job, Succeeded or Failed.
+	Status string `json:"status"`
+	// Reason and message of the failure of the Job.
+	Message string `json:"message,omitempty"`
+	// ExitCode of the first container of the last pod of the Job.
+	ExitCode *int32 `json:"exitCode,omitempty"`
+	// Logs are the last lines of the logs of the container.
+	Logs string `json:"logs,omitempty"`
+}
+
+// JobTrigger holds the context of the Job trigger.
+type JobTrigger struct {
+	// K8sClient is the Kubernetes client.
+	K8sClient kubernetes.Interface
+	// Sensor object.
+	Sensor *v1alpha1.Sensor
+	// Trigger reference.
+	Trigger *v1alpha1.Trigger
+	// Logger to log stuff.
+	Logger *zap.Logger
+
+	// resolved is the trigger resource with the parameters applied, as it was executed
+	resolved *v1alpha1.JobTrigger
+	// output is the outcome of the Job, once the trigger waited for it
+	output *v1alpha1.Event
+	// getLogs returns the last lines of the logs of a container
+	getLogs func(namespace, pod, container string, lines int64) (string, error)
+}
+
+// NewJobTrigger returns new Job trigger.
+func NewJobTrigger(k8sClient kubernetes.Interface, sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, logger *zap.Logger) *JobTrigger {
+	return &JobTrigger{
+		K8sClient: k8sClient,
+		Sensor:    sensor,
+		Trigger:   trigger,
+		Logger:    logger,
+		getLogs: func(namespace, pod, container string, lines int64) (string, error) {
+			logs, err := k8sClient.CoreV1().Pods(namespace).GetLogs(pod, &corev1.PodLogOptions{
+				Container: container,
+				TailLines: &lines,
+			}).DoRaw()
+			return string(logs), err
+		},
+	}
+}
+
+// FetchResource fetches the trigger. As the Job is defined in the trigger, there
+// is no need to fetch any resource from external source
+func (t *JobTrigger) FetchResource() (interface{}, error) {
+	return t.Trigger.Template.Job, nil
+}
+
+// ApplyResourceParameters applies parameters to the trigger resource
+func (t *JobTrigger) ApplyResourceParameters(events map[string]*v1alpha1.Event, resource interface{}) (interface{}, error) {
+	fetchedResource, ok := resource.(*v1alpha1.JobTrigger)
+	if !ok {
+		return nil, errors.New("failed to interpret the fetched trigger resource")
+	}
+
+	resourceBytes, err := json.Marshal(fetchedResource)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal the job trigger resource")
+	}
+	parameters := fetchedResource.Parameters
+	if parameters != nil {
+		updatedResourceBytes, err := triggers.ApplyParams(resourceBytes, parameters, events)
+		if err != nil {
+			return nil, err
+		}
+		var jt *v1alpha1.JobTrigger
+		if err := json.Unmarshal(updatedResourceBytes, &jt); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal the updated job trigger resource after applying resource parameters")
+		}
+		return jt, nil
+	}
+	return resource, nil
+}
+
+// Execute executes the trigger
+func (t *JobTrigger) Execute(events map[string]*v1alpha1.Event, resource interface{}) (interface{}, error) {
+	trigger, ok := resource.(*v1alpha1.JobTrigger)
+	if !ok {
+		return nil, errors.New("failed to interpret the trigger resource")
+	}
+	if trigger.Template == nil {
+		return nil, errors.New("pod template of the job is not specified")
+	}
+
+	namespace := trigger.Namespace
+	if namespace == "" {
+		namespace = t.Sensor.Namespace
+	}
+
+	podTemplate := trigger.Template.DeepCopy()
+	if podTemplate.Spec.RestartPolicy == "" {
+		podTemplate.Spec.RestartPolicy = corev1.RestartPolicyNever
+	}
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: fmt.Sprintf("%s-", t.Trigger.Template.Name),
+			Namespace:    namespace,
+			Labels: map[string]string{
+				triggers.LabelSensor:  t.Sensor.Name,
+				triggers.LabelTrigger: t.Trigger.Template.Name,
+			},
+		},
+		Spec: batchv1.JobSpec{
+			Template:                *podTemplate,
+			BackoffLimit:            trigger.BackoffLimit,
+			ActiveDeadlineSeconds:   trigger.ActiveDeadlineSeconds,
+			TTLSecondsAfterFinished: trigger.TTLSecondsAfterFinished,
+		},
+	}
+
+	t.Logger.Info("creating the job...")
+	created, err := t.K8sClient.BatchV1().Jobs(namespace).Create(job)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create the job")
+	}
+	t.resolved = trigger
+	return created, nil
+}
+
+// ApplyPolicy waits for the Job to complete or fail, if the trigger is configured to wait for it
+func (t *JobTrigger) ApplyPolicy(resource interface{}) error {
+	trigger := t.resolved
+	if trigger == nil || !trigger.Wait {
+		return nil
+	}
+
+	job, ok := resource.(*batchv1.Job)
+	if !ok {
+		return errors.New("failed to interpret the trigger resource")
+	}
+
+	timeout := defaultTimeout
+	if trigger.Timeout > 0 {
+		timeout = time.Duration(trigger.Timeout) * time.Second
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	finished, err := t.waitForJob(ctx, job)
+	if err != nil {
+		return errors.Wrapf(err, "failed to wait for the job %s", job.Name)
+	}
+
+	output := t.jobOutput(finished, trigger.LogLines)
+	event, err := outputEvent(t.Sensor, t.Trigger, finished, output)
+	if err != nil {
+		return err
+	}
+	t.output = event
+
+	if output.Status == JobFailed && !trigger.AllowFailure {
+		return errors.Errorf("job %s failed: %s", finished.Name, output.Message)
+	}
+	return nil
+}
+
+// Output returns the outcome of the Job as an event for the later triggers, or nil if the trigger didn't wait for it.
+func (t *JobTrigger) Output() *v1alpha1.Event {
+	return t.output
+}
+
+// waitForJob watches the Job until it completes or fails.
+func (t *JobTrigger) waitForJob(ctx context.Context, job *batchv1.Job) (*batchv1.Job, error) {
+	client := t.K8sClient.BatchV1().Jobs(job.Namespace)
+	for {
+		current, err := client.Get(job.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		if _, finished := jobStatus(current); finished {
+			return current, nil
+		}
+
+		w, err := client.Watch(metav1.ListOptions{
+			FieldSelector:   fields.OneTermEqualSelector("metadata.name", job.Name).String(),
+			ResourceVersion: current.ResourceVersion,
+		})
+		if err != nil {
+			return nil, err
+		}
+		event, err := watchtools.UntilWithoutRetry(ctx, w, func(event watch.Event) (bool, error) {
+			current, ok := event.Object.(*batchv1.Job)
+			if !ok || current.Name != job.Name {
+				return false, nil
+			}
+			switch event.Type {
+			case watch.Deleted:
+				return false, errors.New("job was deleted before it finished")
+			case watch.Added, watch.Modified:
+				_, finished := jobStatus(current)
+				return finished, nil
+			}
+			return false, nil
+		})
+		// the watch is closed by the server, watch the job again
+		if err == watchtools.ErrWatchClosed {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		return event.Object.(*batchv1.Job), nil
+	}
+}
+
+// jobStatus returns the condition that finished the Job, if any.
+func jobStatus(job *batchv1.Job) (*batchv1.JobCondition, bool) {
+	for i, condition := range job.Status.Conditions {
+		if condition.Status != corev1.ConditionTrue {
+			continue
+		}
+		if condition.Type == batchv1.JobComplete || condition.Type == batchv1.JobFailed {
+			return &job.Status.Conditions[i], true
+		}
+	}
+	return nil, false
+}
+
+// jobOutput collects the outcome of the finished Job. The exit code and the logs are best effort, as the pods
+// may already be gone.
+func (t *JobTrigger) jobOutput(job *batchv1.Job, logLines int64) *JobOutput {
+	output := &JobOutput{
+		Name:      job.Name,
+		Namespace: job.Namespace,
+		Status:    JobSucceeded,
+	}
+	if condition, _ := jobStatus(job); condition != nil && condition.Type == batchv1.JobFailed {
+		output.Status = JobFailed
+		output.Message = fmt.Sprintf("%s: %s", condition.Reason, condition.Message)
+	}
+
+	pods, err := t.K8sClient.CoreV1().Pods(job.Namespace).List(metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("job-name=%s", job.Name),
+	})
+	if err != nil {
+		t.Logger.Warn("failed to list the pods of the job", zap.String("job", job.Name), zap.Error(err))
+		return output
+	}
+	if len(pods.Items) == 0 || len(job.Spec.Template.Spec.Containers) == 0 {
+		return output
+	}
+	// the last pod holds the outcome of the Job if the pod was retried
+	sort.Slice(pods.Items, func(i, j int) bool {
+		return pods.Items[i].CreationTimestamp.Before(&pods.Items[j].CreationTimestamp)
+	})
+	pod := pods.Items[len(pods.Items)-1]
+	container := job.Spec.Template.Spec.Containers[0].Name
+
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.Name == container && status.State.Terminated != nil {
+			exitCode := status.State.Terminated.ExitCode
+			output.ExitCode = &exitCode
+		}
+	}
+	if logLines > 0 {
+		logs, err := t.getLogs(job.Namespace, pod.Name, container, logLines)
+		if err != nil {
+			t.Logger.Warn("failed to get the logs of the job", zap.String("job", job.Name), zap.Error(err))
+		} else {
+			output.Logs = logs
+		}
+	}
+	return output
+}
+
+// outputEvent wraps the outcome of the Job into an event for the later triggers.
+func outputEvent(sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, job *batchv1.Job, output *JobOutput) (*v1alpha1.Event, error) {
+	data, err := json.Marshal(output)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal the output of the job")
+	}
+	return &v1alpha1.Event{
+		Context: &v1alpha1.EventContext{
+			ID:              string(job.UID),
+			Type:            outputEventType,
+			Source:          sensor.Name,
+			Subject:         trigger.Template.Name,
+			DataContentType: cloudevents.ApplicationJSON,
+			SpecVersion:     cloudevents.VersionV1,
+			Time:            metav1.Now(),
+		},
+		Data: data,
+	}, nil
+}
@@ -0,0 +1,220 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package job
+
+import (
+	"encoding/json"
+	"testing"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/stretchr/testify/assert"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/argoproj/argo-events/common/logging"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sensors/triggers"
+)
+
+var sensorObj = &v1alpha1.Sensor{
+	ObjectMeta: metav1.ObjectMeta{
+		Name:      "fake-sensor",
+		Namespace: "fake",
+	},
+	Spec: v1alpha1.SensorSpec{
+		Triggers: []v1alpha1.Trigger{
+			{
+				Template: &v1alpha1.TriggerTemplate{
+					Name: "fake-trigger",
+					Job: &v1alpha1.JobTrigger{
+						Template: &corev1.PodTemplateSpec{
+							Spec: corev1.PodSpec{
+								Containers: []corev1.Container{
+									{
+										Name:    "main",
+										Image:   "alpine",
+										Command: []string{"echo"},
+										Args:    []string{"hello"},
+									},
+								},
+							},
+						},
+						LogLines: 10,
+					},
+				},
+			},
+		},
+	},
+}
+
+var testEvents = map[string]*v1alpha1.Event{
+	"fake-dependency": {
+		Context: &v1alpha1.EventContext{
+			ID:              "1",
+			Type:            "webhook",
+			Source:          "webhook-gateway",
+			DataContentType: "application/json",
+			SpecVersion:     cloudevents.VersionV1,
+			Subject:         "example-1",
+		},
+		Data: []byte(`{"message": "world"}`),
+	},
+}
+
+func getJobTrigger() *JobTrigger {
+	client := fake.NewSimpleClientset()
+	// the fake client doesn't generate the names
+	client.PrependReactor("create", "jobs", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		job := action.(k8stesting.CreateAction).GetObject().(*batchv1.Job)
+		job.Name = job.GenerateName + "abcde"
+		return false, nil, nil
+	})
+	trigger := NewJobTrigger(client, sensorObj.DeepCopy(), sensorObj.Spec.Triggers[0].DeepCopy(), logging.NewArgoEventsLogger().Desugar())
+	trigger.getLogs = func(namespace, pod, container string, lines int64) (string, error) {
+		return "hello\n", nil
+	}
+	return trigger
+}
+
+// finishJob sets the final condition of the Job and creates its terminated pod
+func finishJob(t *testing.T, client *fake.Clientset, job *batchv1.Job, conditionType batchv1.JobConditionType, exitCode int32) {
+	job.Status.Conditions = []batchv1.JobCondition{
+		{Type: conditionType, Status: corev1.ConditionTrue, Reason: "BackoffLimitExceeded", Message: "Job has reached the specified backoff limit"},
+	}
+	_, err := client.BatchV1().Jobs(job.Namespace).UpdateStatus(job)
+	assert.Nil(t, err)
+	_, err = client.CoreV1().Pods(job.Namespace).Create(&corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      job.Name + "-xyz",
+			Namespace: job.Namespace,
+			Labels:    map[string]string{"job-name": job.Name},
+		},
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{
+				{Name: "main", State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: exitCode}}},
+			},
+		},
+	})
+	assert.Nil(t, err)
+}
+
+func TestJobTrigger_FetchResource(t *testing.T) {
+	trigger := getJobTrigger()
+	resource, err := trigger.FetchResource()
+	assert.Nil(t, err)
+	assert.NotNil(t, resource)
+
+	jt, ok := resource.(*v1alpha1.JobTrigger)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, "alpine", jt.Template.Spec.Containers[0].Image)
+}
+
+func TestJobTrigger_ApplyResourceParameters(t *testing.T) {
+	trigger := getJobTrigger()
+	trigger.Trigger.Template.Job.Parameters = []v1alpha1.TriggerParameter{
+		{
+			Src: &v1alpha1.TriggerParameterSource{
+				DependencyName: "fake-dependency",
+				DataKey:        "message",
+			},
+			Dest: "template.spec.containers.0.args.0",
+		},
+	}
+
+	response, err := trigger.ApplyResourceParameters(testEvents, trigger.Trigger.Template.Job)
+	assert.Nil(t, err)
+	assert.NotNil(t, response)
+
+	updatedObj, ok := response.(*v1alpha1.JobTrigger)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, []string{"world"}, updatedObj.Template.Spec.Containers[0].Args)
+}
+
+func TestJobTrigger_Execute(t *testing.T) {
+	trigger := getJobTrigger()
+	result, err := trigger.Execute(testEvents, trigger.Trigger.Template.Job)
+	assert.Nil(t, err)
+
+	job, ok := result.(*batchv1.Job)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, "fake-trigger-abcde", job.Name)
+	assert.Equal(t, "fake", job.Namespace)
+	assert.Equal(t, "fake-sensor", job.Labels[triggers.LabelSensor])
+	assert.Equal(t, "fake-trigger", job.Labels[triggers.LabelTrigger])
+	assert.Equal(t, corev1.RestartPolicyNever, job.Spec.Template.Spec.RestartPolicy)
+}
+
+func TestJobTrigger_ApplyPolicy(t *testing.T) {
+	t.Run("don't wait for the job", func(t *testing.T) {
+		trigger := getJobTrigger()
+		result, err := trigger.Execute(testEvents, trigger.Trigger.Template.Job)
+		assert.Nil(t, err)
+		assert.Nil(t, trigger.ApplyPolicy(result))
+		assert.Nil(t, trigger.Output())
+	})
+
+	t.Run("job succeeded", func(t *testing.T) {
+		trigger := getJobTrigger()
+		trigger.Trigger.Template.Job.Wait = true
+		result, err := trigger.Execute(testEvents, trigger.Trigger.Template.Job)
+		assert.Nil(t, err)
+		finishJob(t, trigger.K8sClient.(*fake.Clientset), result.(*batchv1.Job), batchv1.JobComplete, 0)
+
+		assert.Nil(t, trigger.ApplyPolicy(result))
+		output := trigger.Output()
+		assert.NotNil(t, output)
+		assert.Equal(t, "fake-trigger", output.Context.Subject)
+		var data JobOutput
+		assert.Nil(t, json.Unmarshal(output.Data, &data))
+		assert.Equal(t, "fake-trigger-abcde", data.Name)
+		assert.Equal(t, JobSucceeded, data.Status)
+		assert.Equal(t, int32(0), *data.ExitCode)
+		assert.Equal(t, "hello\n", data.Logs)
+	})
+
+	t.Run("job failed", func(t *testing.T) {
+		trigger := getJobTrigger()
+		trigger.Trigger.Template.Job.Wait = true
+		result, err := trigger.Execute(testEvents, trigger.Trigger.Template.Job)
+		assert.Nil(t, err)
+		finishJob(t, trigger.K8sClient.(*fake.Clientset), result.(*batchv1.Job), batchv1.JobFailed, 1)
+
+		assert.NotNil(t, trigger.ApplyPolicy(result))
+
+		trigger.Trigger.Template.Job.AllowFailure = true
+		assert.Nil(t, trigger.ApplyPolicy(result))
+		var data JobOutput
+		assert.Nil(t, json.Unmarshal(trigger.Output().Data, &data))
+		assert.Equal(t, JobFailed, data.Status)
+		assert.Equal(t, int32(1), *data.ExitCode)
+		assert.Equal(t, "BackoffLimitExceeded: Job has reached the specified backoff limit", data.Message)
+	})
+
+	t.Run("job timed out", func(t *testing.T) {
+		trigger := getJobTrigger()
+		trigger.Trigger.Template.Job.Wait = true
+		trigger.Trigger.Template.Job.Timeout = 1
+		result, err := trigger.Execute(testEvents, trigger.Trigger.Template.Job)
+		assert.Nil(t, err)
+
+		assert.NotNil(t, trigger.ApplyPolicy(result))
+		assert.Nil(t, trigger.Output())
+	})
+}
@@ -38,73 +38,107 @@ type KafkaTrigger struct {
 	Trigger *v1alpha1.Trigger
 	// Kafka async producer
 	Producer sarama.AsyncProducer
+	// SyncProducer is used instead of the async producer if the trigger has a retry strategy, so that
+	// the delivery errors are returned to the execution
+	SyncProducer sarama.SyncProducer
 	// Logger to log stuff
 	Logger *zap.Logger
 }
 
 // NewKafkaTrigger returns a new kafka trigger context.
-func NewKafkaTrigger(sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, kafkaProducers map[string]sarama.AsyncProducer, logger *zap.Logger) (*KafkaTrigger, error) {
+func NewKafkaTrigger(sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, kafkaProducers map[string]sarama.AsyncProducer, kafkaSyncProducers map[string]sarama.SyncProducer, logger *zap.Logger) (*KafkaTrigger, error) {
 	kafkatrigger := trigger.Template.Kafka
+	result := &KafkaTrigger{
+		Sensor:  sensor,
+		Trigger: trigger,
+		Logger:  logger,
+	}
 
-	producer, ok := kafkaProducers[trigger.Template.Name]
-	if !ok {
-		var err error
-		config := sarama.NewConfig()
-
-		if kafkatrigger.TLS != nil {
-			if kafkatrigger.TLS.ClientCertPath != "" && kafkatrigger.TLS.ClientKeyPath != "" && kafkatrigger.TLS.CACertPath != "" {
-				cert, err := tls.LoadX509KeyPair(kafkatrigger.TLS.ClientCertPath, kafkatrigger.TLS.ClientKeyPath)
-				if err != nil {
-					return nil, err
-				}
-
-				caCert, err := ioutil.ReadFile(kafkatrigger.TLS.CACertPath)
-				if err != nil {
-					return nil, err
-				}
-
-				caCertPool := x509.NewCertPool()
-				caCertPool.AppendCertsFromPEM(caCert)
-
-				config.Net.TLS.Config = &tls.Config{
-					Certificates:       []tls.Certificate{cert},
-					RootCAs:            caCertPool,
-					InsecureSkipVerify: true,
-				}
-				config.Net.TLS.Enable = true
+	if trigger.RetryStrategy != nil {
+		producer, ok := kafkaSyncProducers[trigger.Template.Name]
+		if !ok {
+			config, err := newProducerConfig(kafkatrigger)
+			if err != nil {
+				return nil, err
 			}
+			config.Producer.Return.Successes = true
+			producer, err = sarama.NewSyncProducer([]string{kafkatrigger.URL}, config)
+			if err != nil {
+				return nil, err
+			}
+			kafkaSyncProducers[trigger.Template.Name] = producer
 		}
+		result.SyncProducer = producer
+		return result, nil
+	}
 
-		if kafkatrigger.Compress {
-			config.Producer.Compression = sarama.CompressionSnappy
-		}
-
-		ff := 500
-		if kafkatrigger.FlushFrequency != 0 {
-			ff = int(kafkatrigger.FlushFrequency)
-		}
-		config.Producer.Flush.Frequency = time.Duration(ff)
-
-		ra := sarama.WaitForAll
-		if kafkatrigger.RequiredAcks != 0 {
-			ra = sarama.RequiredAcks(kafkatrigger.RequiredAcks)
+	producer, ok := kafkaProducers[trigger.Template.Name]
+	if !ok {
+		config, err := newProducerConfig(kafkatrigger)
+		if err != nil {
+			return nil, err
 		}
-		config.Producer.RequiredAcks = ra
-
 		producer, err = sarama.NewAsyncProducer([]string{kafkatrigger.URL}, config)
 		if err != nil {
 			return nil, err
 		}
+		// The errors must be read, the producer blocks once the channel is full. The loop exits once the producer is closed.
+		go func() {
+			for err := range producer.Errors() {
+				logger.Error("failed to produce a message", zap.Any("topic", err.Msg.Topic), zap.Error(err.Err))
+			}
+		}()
 
 		kafkaProducers[trigger.Template.Name] = producer
 	}
+	result.Producer = producer
+	return result, nil
+}
+
+// newProducerConfig returns the producer configuration of the trigger.
+func newProducerConfig(kafkatrigger *v1alpha1.KafkaTrigger) (*sarama.Config, error) {
+	config := sarama.NewConfig()
 
-	return &KafkaTrigger{
-		Sensor:   sensor,
-		Trigger:  trigger,
-		Producer: producer,
-		Logger:   logger,
-	}, nil
+	if kafkatrigger.TLS != nil {
+		if kafkatrigger.TLS.ClientCertPath != "" && kafkatrigger.TLS.ClientKeyPath != "" && kafkatrigger.TLS.CACertPath != "" {
+			cert, err := tls.LoadX509KeyPair(kafkatrigger.TLS.ClientCertPath, kafkatrigger.TLS.ClientKeyPath)
+			if err != nil {
+				return nil, err
+			}
+
+			caCert, err := ioutil.ReadFile(kafkatrigger.TLS.CACertPath)
+			if err != nil {
+				return nil, err
+			}
+
+			caCertPool := x509.NewCertPool()
+			caCertPool.AppendCertsFromPEM(caCert)
+
+			config.Net.TLS.Config = &tls.Config{
+				Certificates:       []tls.Certificate{cert},
+				RootCAs:            caCertPool,
+				InsecureSkipVerify: true,
+			}
+			config.Net.TLS.Enable = true
+		}
+	}
+
+	if kafkatrigger.Compress {
+		config.Producer.Compression = sarama.CompressionSnappy
+	}
+
+	ff := 500
+	if kafkatrigger.FlushFrequency != 0 {
+		ff = int(kafkatrigger.FlushFrequency)
+	}
+	config.Producer.Flush.Frequency = time.Duration(ff)
+
+	ra := sarama.WaitForAll
+	if kafkatrigger.RequiredAcks != 0 {
+		ra = sarama.RequiredAcks(kafkatrigger.RequiredAcks)
+	}
+	config.Producer.RequiredAcks = ra
+	return config, nil
 }
 
 // FetchResource fetches the trigger. As the Kafka trigger is simply a Kafka producer, there
@@ -160,13 +194,20 @@ func (t *KafkaTrigger) Execute(events map[string]*v1alpha1.Event, resource inter
 		pk = trigger.URL
 	}
 
-	t.Producer.Input() <- &sarama.ProducerMessage{
+	msg := &sarama.ProducerMessage{
 		Topic:     trigger.Topic,
 		Key:       sarama.StringEncoder(pk),
 		Value:     sarama.ByteEncoder(payload),
 		Partition: trigger.Partition,
 		Timestamp: time.Now().UTC(),
 	}
+	if t.SyncProducer != nil {
+		if _, _, err := t.SyncProducer.SendMessage(msg); err != nil {
+			return nil, errors.Wrapf(err, "failed to produce a message to topic %s", trigger.Topic)
+		}
+	} else {
+		t.Producer.Input() <- msg
+	}
 
 	t.Logger.Info("successfully produced a message", zap.Any("topic", trigger.Topic), zap.Any("partition", trigger.Partition))
 
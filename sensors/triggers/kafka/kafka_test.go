@@ -25,7 +25,9 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/argoproj/argo-events/common/logging"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
 	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sensors/triggers"
 )
 
 var sensorObj = &v1alpha1.Sensor{
@@ -57,7 +59,7 @@ var sensorObj = &v1alpha1.Sensor{
 }
 
 func getFakeKafkaTrigger(producers map[string]sarama.AsyncProducer) (*KafkaTrigger, error) {
-	return NewKafkaTrigger(sensorObj.DeepCopy(), sensorObj.Spec.Triggers[0].DeepCopy(), producers, logging.NewArgoEventsLogger().Desugar())
+	return NewKafkaTrigger(sensorObj.DeepCopy(), sensorObj.Spec.Triggers[0].DeepCopy(), producers, map[string]sarama.SyncProducer{}, logging.NewArgoEventsLogger().Desugar())
 }
 
 func TestKafkaTrigger_FetchResource(t *testing.T) {
@@ -157,3 +159,34 @@ func TestKafkaTrigger_Execute(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Nil(t, result)
 }
+
+func TestKafkaTrigger_ExecuteWithRetryStrategy(t *testing.T) {
+	producer := mocks.NewSyncProducer(t, nil)
+	trigger := sensorObj.Spec.Triggers[0].DeepCopy()
+	trigger.RetryStrategy = &apicommon.Backoff{Steps: 3}
+	defaultValue := "hello"
+	trigger.Template.Kafka.Payload = []v1alpha1.TriggerParameter{
+		{
+			Src: &v1alpha1.TriggerParameterSource{
+				DependencyName: "fake-dependency",
+				DataKey:        "message",
+				Value:          &defaultValue,
+			},
+			Dest: "message",
+		},
+	}
+	kafkaTrigger, err := NewKafkaTrigger(sensorObj.DeepCopy(), trigger, map[string]sarama.AsyncProducer{}, map[string]sarama.SyncProducer{
+		"fake-trigger": producer,
+	}, logging.NewArgoEventsLogger().Desugar())
+	assert.Nil(t, err)
+	assert.Nil(t, kafkaTrigger.Producer)
+
+	producer.ExpectSendMessageAndFail(sarama.ErrLeaderNotAvailable)
+	_, err = kafkaTrigger.Execute(map[string]*v1alpha1.Event{}, trigger.Template.Kafka)
+	assert.NotNil(t, err)
+	assert.True(t, triggers.IsRetryableError(err))
+
+	producer.ExpectSendMessageAndSucceed()
+	_, err = kafkaTrigger.Execute(map[string]*v1alpha1.Event{}, trigger.Template.Kafka)
+	assert.Nil(t, err)
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package log
+
+import (
+	"bytes"
+	"encoding/json"
+	"text/template"
+
+	"github.com/Masterminds/sprig"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sensors/triggers"
+)
+
+// LogTrigger holds the context of the Log trigger.
+type LogTrigger struct {
+	// Sensor object.
+	Sensor *v1alpha1.Sensor
+	// Trigger reference.
+	Trigger *v1alpha1.Trigger
+	// Logger to log the events and the payload.
+	Logger *zap.Logger
+}
+
+// logEvent is the event as it is logged, with the JSON decoded data
+type logEvent struct {
+	Context *v1alpha1.EventContext `json:"context"`
+	Data    interface{}            `json:"data"`
+}
+
+// NewLogTrigger returns new Log trigger.
+func NewLogTrigger(sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, logger *zap.Logger) *LogTrigger {
+	return &LogTrigger{
+		Sensor:  sensor,
+		Trigger: trigger,
+		Logger:  logger,
+	}
+}
+
+// FetchResource fetches the trigger. As the Log trigger simply logs the events, there
+// is no need to fetch any resource from external source
+func (t *LogTrigger) FetchResource() (interface{}, error) {
+	return t.Trigger.Template.Log, nil
+}
+
+// ApplyResourceParameters applies parameters to the trigger resource
+func (t *LogTrigger) ApplyResourceParameters(events map[string]*v1alpha1.Event, resource interface{}) (interface{}, error) {
+	fetchedResource, ok := resource.(*v1alpha1.LogTrigger)
+	if !ok {
+		return nil, errors.New("failed to interpret the fetched trigger resource")
+	}
+
+	resourceBytes, err := json.Marshal(fetchedResource)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal the log trigger resource")
+	}
+	parameters := fetchedResource.Parameters
+	if parameters != nil {
+		updatedResourceBytes, err := triggers.ApplyParams(resourceBytes, parameters, events)
+		if err != nil {
+			return nil, err
+		}
+		var lt *v1alpha1.LogTrigger
+		if err := json.Unmarshal(updatedResourceBytes, &lt); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal the updated log trigger resource after applying resource parameters")
+		}
+		return lt, nil
+	}
+	return resource, nil
+}
+
+// Execute executes the trigger
+func (t *LogTrigger) Execute(events map[string]*v1alpha1.Event, resource interface{}) (interface{}, error) {
+	trigger, ok := resource.(*v1alpha1.LogTrigger)
+	if !ok {
+		return nil, errors.New("failed to interpret the trigger resource")
+	}
+
+	var payload interface{}
+	if trigger.Payload != nil {
+		payloadBytes, err := triggers.ConstructPayload(events, trigger.Payload)
+		if err != nil {
+			return nil, err
+		}
+		payload = decodeJSON(payloadBytes)
+	}
+
+	logEvents := make(map[string]logEvent, len(events))
+	for name, event := range events {
+		logEvents[name] = logEvent{
+			Context: event.Context,
+			Data:    decodeJSON(event.Data),
+		}
+	}
+
+	if trigger.Format == "" {
+		t.Logger.Info("resolved the events of the trigger", zap.Any("trigger", t.Trigger.Template.Name), zap.Any("events", logEvents), zap.Any("payload", payload))
+		return nil, nil
+	}
+
+	tpl, err := template.New("log").Funcs(sprig.HermeticTxtFuncMap()).Parse(trigger.Format)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse the log format")
+	}
+	var buf bytes.Buffer
+	if err := tpl.Execute(&buf, map[string]interface{}{
+		"Events":  logEvents,
+		"Payload": payload,
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed to render the log message")
+	}
+	message := buf.String()
+	t.Logger.Info(message, zap.Any("trigger", t.Trigger.Template.Name))
+	return message, nil
+}
+
+// decodeJSON returns the JSON decoded data, or the data as a string if it is not JSON.
+func decodeJSON(data []byte) interface{} {
+	if data == nil {
+		return nil
+	}
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return string(data)
+	}
+	return value
+}
+
+// ApplyPolicy applies policy on the trigger
+func (t *LogTrigger) ApplyPolicy(resource interface{}) error {
+	return nil
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package log
+
+import (
+	"testing"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+var sensorObj = &v1alpha1.Sensor{
+	ObjectMeta: metav1.ObjectMeta{
+		Name:      "fake-sensor",
+		Namespace: "fake",
+	},
+	Spec: v1alpha1.SensorSpec{
+		Triggers: []v1alpha1.Trigger{
+			{
+				Template: &v1alpha1.TriggerTemplate{
+					Name: "fake-trigger",
+					Log: &v1alpha1.LogTrigger{
+						Payload: []v1alpha1.TriggerParameter{
+							{
+								Src: &v1alpha1.TriggerParameterSource{
+									DependencyName: "fake-dependency",
+									DataKey:        "name",
+								},
+								Dest: "name",
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+var testEvents = map[string]*v1alpha1.Event{
+	"fake-dependency": {
+		Context: &v1alpha1.EventContext{
+			ID:              "1",
+			Type:            "webhook",
+			Source:          "webhook-gateway",
+			DataContentType: "application/json",
+			SpecVersion:     cloudevents.VersionV1,
+			Subject:         "example-1",
+		},
+		Data: []byte(`{"name": "fake", "format": "{{ .Payload.name }} from {{ (index .Events \"fake-dependency\").Context.Source }}"}`),
+	},
+}
+
+func getLogTrigger() (*LogTrigger, *observer.ObservedLogs) {
+	core, logs := observer.New(zap.InfoLevel)
+	return NewLogTrigger(sensorObj.DeepCopy(), sensorObj.Spec.Triggers[0].DeepCopy(), zap.New(core)), logs
+}
+
+func TestLogTrigger_FetchResource(t *testing.T) {
+	trigger, _ := getLogTrigger()
+	resource, err := trigger.FetchResource()
+	assert.Nil(t, err)
+	assert.NotNil(t, resource)
+
+	_, ok := resource.(*v1alpha1.LogTrigger)
+	assert.Equal(t, true, ok)
+}
+
+func TestLogTrigger_ApplyResourceParameters(t *testing.T) {
+	trigger, _ := getLogTrigger()
+	trigger.Trigger.Template.Log.Parameters = []v1alpha1.TriggerParameter{
+		{
+			Src: &v1alpha1.TriggerParameterSource{
+				DependencyName: "fake-dependency",
+				DataKey:        "format",
+			},
+			Dest: "format",
+		},
+	}
+
+	response, err := trigger.ApplyResourceParameters(testEvents, trigger.Trigger.Template.Log)
+	assert.Nil(t, err)
+	assert.NotNil(t, response)
+
+	updatedObj, ok := response.(*v1alpha1.LogTrigger)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, `{{ .Payload.name }} from {{ (index .Events "fake-dependency").Context.Source }}`, updatedObj.Format)
+}
+
+func TestLogTrigger_Execute(t *testing.T) {
+	t.Run("log as JSON", func(t *testing.T) {
+		trigger, logs := getLogTrigger()
+		_, err := trigger.Execute(testEvents, trigger.Trigger.Template.Log)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, logs.Len())
+		fields := logs.All()[0].ContextMap()
+		assert.Equal(t, map[string]interface{}{"name": "fake"}, fields["payload"])
+		event := fields["events"].(map[string]logEvent)["fake-dependency"]
+		assert.Equal(t, "webhook-gateway", event.Context.Source)
+		assert.Equal(t, "fake", event.Data.(map[string]interface{})["name"])
+	})
+
+	t.Run("log with format", func(t *testing.T) {
+		trigger, logs := getLogTrigger()
+		resource := trigger.Trigger.Template.Log
+		resource.Format = `{{ .Payload.name }} from {{ (index .Events "fake-dependency").Context.Source }}`
+		message, err := trigger.Execute(testEvents, resource)
+		assert.Nil(t, err)
+		assert.Equal(t, "fake from webhook-gateway", message)
+		assert.Equal(t, 1, logs.Len())
+		assert.Equal(t, "fake from webhook-gateway", logs.All()[0].Message)
+	})
+
+	t.Run("invalid format", func(t *testing.T) {
+		trigger, _ := getLogTrigger()
+		resource := trigger.Trigger.Template.Log
+		resource.Format = "{{ .Payload.name "
+		_, err := trigger.Execute(testEvents, resource)
+		assert.NotNil(t, err)
+	})
+}
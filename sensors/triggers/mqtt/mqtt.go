@@ -0,0 +1,155 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package mqtt
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	mqttlib "github.com/eclipse/paho.mqtt.golang"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sensors/triggers"
+)
+
+// operationTimeout is the time limit to connect to the broker and to publish a message
+const operationTimeout = time.Minute
+
+// MQTTTrigger holds the context of the MQTT trigger.
+type MQTTTrigger struct {
+	// Sensor object.
+	Sensor *v1alpha1.Sensor
+	// Trigger reference.
+	Trigger *v1alpha1.Trigger
+	// Client refers to the MQTT client.
+	Client mqttlib.Client
+	// Logger to log stuff.
+	Logger *zap.Logger
+}
+
+// NewMQTTTrigger returns new MQTT trigger. The clients are pooled per broker, so the triggers
+// publishing to the same broker share the connection.
+func NewMQTTTrigger(sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, mqttClients map[string]mqttlib.Client, logger *zap.Logger) (*MQTTTrigger, error) {
+	mqtttrigger := trigger.Template.MQTT
+
+	key := ClientKey(mqtttrigger)
+	client, ok := mqttClients[key]
+	if !ok {
+		opts := mqttlib.NewClientOptions().AddBroker(mqtttrigger.URL).SetClientID(mqtttrigger.ClientID)
+		if mqtttrigger.TLS != nil {
+			tlsConfig, err := common.GetTLSConfig(mqtttrigger.TLS.CACertPath, mqtttrigger.TLS.ClientCertPath, mqtttrigger.TLS.ClientKeyPath)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to get the tls configuration")
+			}
+			opts.TLSConfig = tlsConfig
+		}
+
+		client = mqttlib.NewClient(opts)
+		if err := wait(client.Connect()); err != nil {
+			return nil, errors.Wrapf(err, "failed to connect to the mqtt broker %s", mqtttrigger.URL)
+		}
+
+		mqttClients[key] = client
+	}
+
+	return &MQTTTrigger{
+		Sensor:  sensor,
+		Trigger: trigger,
+		Client:  client,
+		Logger:  logger,
+	}, nil
+}
+
+// ClientKey returns the key of the pooled client of the trigger.
+func ClientKey(trigger *v1alpha1.MQTTTrigger) string {
+	key := []string{trigger.URL, trigger.ClientID}
+	if trigger.TLS != nil {
+		key = append(key, trigger.TLS.CACertPath, trigger.TLS.ClientCertPath, trigger.TLS.ClientKeyPath)
+	}
+	return strings.Join(key, "|")
+}
+
+// FetchResource fetches the trigger. As the MQTT trigger is simply a MQTT client, there
+// is no need to fetch any resource from external source
+func (t *MQTTTrigger) FetchResource() (interface{}, error) {
+	return t.Trigger.Template.MQTT, nil
+}
+
+// ApplyResourceParameters applies parameters to the trigger resource
+func (t *MQTTTrigger) ApplyResourceParameters(events map[string]*v1alpha1.Event, resource interface{}) (interface{}, error) {
+	fetchedResource, ok := resource.(*v1alpha1.MQTTTrigger)
+	if !ok {
+		return nil, errors.New("failed to interpret the fetched trigger resource")
+	}
+
+	resourceBytes, err := json.Marshal(fetchedResource)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal the mqtt trigger resource")
+	}
+	parameters := fetchedResource.Parameters
+	if parameters != nil {
+		updatedResourceBytes, err := triggers.ApplyParams(resourceBytes, parameters, events)
+		if err != nil {
+			return nil, err
+		}
+		var mt *v1alpha1.MQTTTrigger
+		if err := json.Unmarshal(updatedResourceBytes, &mt); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal the updated mqtt trigger resource after applying resource parameters")
+		}
+		return mt, nil
+	}
+	return resource, nil
+}
+
+// Execute executes the trigger
+func (t *MQTTTrigger) Execute(events map[string]*v1alpha1.Event, resource interface{}) (interface{}, error) {
+	trigger, ok := resource.(*v1alpha1.MQTTTrigger)
+	if !ok {
+		return nil, errors.New("failed to interpret the trigger resource")
+	}
+
+	if trigger.Payload == nil {
+		return nil, errors.New("payload parameters are not specified")
+	}
+
+	payload, err := triggers.ConstructPayload(events, trigger.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := wait(t.Client.Publish(trigger.Topic, byte(trigger.QoS), trigger.Retained, payload)); err != nil {
+		return nil, errors.Wrapf(err, "failed to publish the message to the topic %s", trigger.Topic)
+	}
+
+	return nil, nil
+}
+
+// wait waits for the completion of the token.
+func wait(token mqttlib.Token) error {
+	if !token.WaitTimeout(operationTimeout) {
+		return errors.New("timed out")
+	}
+	return token.Error()
+}
+
+// ApplyPolicy applies policy on the trigger
+func (t *MQTTTrigger) ApplyPolicy(resource interface{}) error {
+	return nil
+}
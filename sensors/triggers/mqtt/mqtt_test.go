@@ -0,0 +1,174 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package mqtt
+
+import (
+	"testing"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	mqttlib "github.com/eclipse/paho.mqtt.golang"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-events/common/logging"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+var sensorObj = &v1alpha1.Sensor{
+	ObjectMeta: metav1.ObjectMeta{
+		Name:      "fake-sensor",
+		Namespace: "fake",
+	},
+	Spec: v1alpha1.SensorSpec{
+		Triggers: []v1alpha1.Trigger{
+			{
+				Template: &v1alpha1.TriggerTemplate{
+					Name: "fake-trigger",
+					MQTT: &v1alpha1.MQTTTrigger{
+						URL:   "tcp://localhost:1883",
+						Topic: "devices/fake/commands",
+						Payload: []v1alpha1.TriggerParameter{
+							{
+								Src: &v1alpha1.TriggerParameterSource{
+									DependencyName: "fake-dependency",
+									DataKey:        "command",
+								},
+								Dest: "command",
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+var testEvents = map[string]*v1alpha1.Event{
+	"fake-dependency": {
+		Context: &v1alpha1.EventContext{
+			ID:              "1",
+			Type:            "webhook",
+			Source:          "webhook-gateway",
+			DataContentType: "application/json",
+			SpecVersion:     cloudevents.VersionV1,
+			Subject:         "example-1",
+		},
+		Data: []byte(`{"command": "reboot", "device": "real-device"}`),
+	},
+}
+
+type fakeToken struct {
+	err error
+}
+
+func (t *fakeToken) Wait() bool                     { return true }
+func (t *fakeToken) WaitTimeout(time.Duration) bool { return true }
+func (t *fakeToken) Error() error                   { return t.err }
+
+type publishedMessage struct {
+	topic    string
+	qos      byte
+	retained bool
+	payload  interface{}
+}
+
+type fakeClient struct {
+	mqttlib.Client
+	published []publishedMessage
+	err       error
+}
+
+func (c *fakeClient) Publish(topic string, qos byte, retained bool, payload interface{}) mqttlib.Token {
+	c.published = append(c.published, publishedMessage{topic: topic, qos: qos, retained: retained, payload: payload})
+	return &fakeToken{err: c.err}
+}
+
+func getMQTTTrigger(t *testing.T, client mqttlib.Client) *MQTTTrigger {
+	trigger := sensorObj.Spec.Triggers[0].DeepCopy()
+	clients := map[string]mqttlib.Client{ClientKey(trigger.Template.MQTT): client}
+	result, err := NewMQTTTrigger(sensorObj.DeepCopy(), trigger, clients, logging.NewArgoEventsLogger().Desugar())
+	assert.Nil(t, err)
+	return result
+}
+
+func TestClientKey(t *testing.T) {
+	trigger := sensorObj.Spec.Triggers[0].Template.MQTT.DeepCopy()
+	key := ClientKey(trigger)
+
+	trigger.Topic = "devices/other/commands"
+	assert.Equal(t, key, ClientKey(trigger))
+
+	trigger.ClientID = "fake-client"
+	assert.NotEqual(t, key, ClientKey(trigger))
+
+	trigger.ClientID = ""
+	trigger.TLS = &v1alpha1.TLSConfig{CACertPath: "ca", ClientCertPath: "cert", ClientKeyPath: "key"}
+	assert.NotEqual(t, key, ClientKey(trigger))
+}
+
+func TestMQTTTrigger_FetchResource(t *testing.T) {
+	trigger := getMQTTTrigger(t, &fakeClient{})
+	resource, err := trigger.FetchResource()
+	assert.Nil(t, err)
+	assert.NotNil(t, resource)
+
+	mt, ok := resource.(*v1alpha1.MQTTTrigger)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, "devices/fake/commands", mt.Topic)
+}
+
+func TestMQTTTrigger_ApplyResourceParameters(t *testing.T) {
+	trigger := getMQTTTrigger(t, &fakeClient{})
+	trigger.Trigger.Template.MQTT.Topic = "devices/"
+	trigger.Trigger.Template.MQTT.Parameters = []v1alpha1.TriggerParameter{
+		{
+			Src: &v1alpha1.TriggerParameterSource{
+				DependencyName: "fake-dependency",
+				DataKey:        "device",
+			},
+			Dest:      "topic",
+			Operation: v1alpha1.TriggerParameterOpAppend,
+		},
+	}
+
+	response, err := trigger.ApplyResourceParameters(testEvents, trigger.Trigger.Template.MQTT)
+	assert.Nil(t, err)
+	assert.NotNil(t, response)
+
+	updatedObj, ok := response.(*v1alpha1.MQTTTrigger)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, "devices/real-device", updatedObj.Topic)
+}
+
+func TestMQTTTrigger_Execute(t *testing.T) {
+	client := &fakeClient{}
+	trigger := getMQTTTrigger(t, client)
+	resource := trigger.Trigger.Template.MQTT
+	resource.QoS = 1
+	resource.Retained = true
+
+	_, err := trigger.Execute(testEvents, resource)
+	assert.Nil(t, err)
+	assert.Equal(t, []publishedMessage{
+		{topic: "devices/fake/commands", qos: 1, retained: true, payload: []byte(`{"command":"reboot"}`)},
+	}, client.published)
+
+	client.err = errors.New("fake error")
+	_, err = trigger.Execute(testEvents, resource)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,180 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package object_storage
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"text/template"
+
+	"github.com/Masterminds/sprig"
+	"github.com/minio/minio-go"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sensors/triggers"
+	"github.com/argoproj/argo-events/store"
+)
+
+// defaultContentType is the content type of the objects if it is not specified
+const defaultContentType = "application/json"
+
+// ObjectWriter writes objects to the object storage. It is implemented by the minio client.
+type ObjectWriter interface {
+	PutObject(bucketName, objectName string, reader io.Reader, objectSize int64, opts minio.PutObjectOptions) (int64, error)
+}
+
+// ObjectStorageTrigger holds the context of the ObjectStorage trigger.
+type ObjectStorageTrigger struct {
+	// Sensor object.
+	Sensor *v1alpha1.Sensor
+	// Trigger reference.
+	Trigger *v1alpha1.Trigger
+	// Client refers to the object storage client.
+	Client ObjectWriter
+	// Logger to log stuff.
+	Logger *zap.Logger
+}
+
+// NewObjectStorageTrigger returns new ObjectStorage trigger.
+func NewObjectStorageTrigger(sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, minioClients map[string]*minio.Client, logger *zap.Logger) (*ObjectStorageTrigger, error) {
+	objectStorageTrigger := trigger.Template.ObjectStorage
+
+	client, ok := minioClients[trigger.Template.Name]
+	if !ok {
+		creds, err := store.GetCredentials(&v1alpha1.ArtifactLocation{S3: objectStorageTrigger.S3})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to retrieve the credentials")
+		}
+		client, err = store.NewMinioClient(objectStorageTrigger.S3, *creds)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to create a client for the endpoint %s", objectStorageTrigger.S3.Endpoint)
+		}
+		minioClients[trigger.Template.Name] = client
+	}
+
+	return &ObjectStorageTrigger{
+		Sensor:  sensor,
+		Trigger: trigger,
+		Client:  client,
+		Logger:  logger,
+	}, nil
+}
+
+// FetchResource fetches the trigger. As the ObjectStorage trigger simply writes an object, there
+// is no need to fetch any resource from external source
+func (t *ObjectStorageTrigger) FetchResource() (interface{}, error) {
+	return t.Trigger.Template.ObjectStorage, nil
+}
+
+// ApplyResourceParameters applies parameters to the trigger resource
+func (t *ObjectStorageTrigger) ApplyResourceParameters(events map[string]*v1alpha1.Event, resource interface{}) (interface{}, error) {
+	fetchedResource, ok := resource.(*v1alpha1.ObjectStorageTrigger)
+	if !ok {
+		return nil, errors.New("failed to interpret the fetched trigger resource")
+	}
+
+	resourceBytes, err := json.Marshal(fetchedResource)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal the object storage trigger resource")
+	}
+	parameters := fetchedResource.Parameters
+	if parameters != nil {
+		updatedResourceBytes, err := triggers.ApplyParams(resourceBytes, parameters, events)
+		if err != nil {
+			return nil, err
+		}
+		var ot *v1alpha1.ObjectStorageTrigger
+		if err := json.Unmarshal(updatedResourceBytes, &ot); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal the updated object storage trigger resource after applying resource parameters")
+		}
+		return ot, nil
+	}
+	return resource, nil
+}
+
+// Execute executes the trigger
+func (t *ObjectStorageTrigger) Execute(events map[string]*v1alpha1.Event, resource interface{}) (interface{}, error) {
+	trigger, ok := resource.(*v1alpha1.ObjectStorageTrigger)
+	if !ok {
+		return nil, errors.New("failed to interpret the trigger resource")
+	}
+
+	if trigger.Payload == nil {
+		return nil, errors.New("payload parameters are not specified")
+	}
+
+	payload, err := triggers.ConstructPayload(events, trigger.Payload)
+	if err != nil {
+		return nil, err
+	}
+	var data interface{}
+	if err := json.Unmarshal(payload, &data); err != nil {
+		return nil, errors.Wrap(err, "failed to decode the payload")
+	}
+
+	key, err := render(trigger.S3.Bucket.Key, data)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to render the key of the object")
+	}
+	if key == "" {
+		return nil, errors.New("the key of the object is empty")
+	}
+
+	body := payload
+	if trigger.Body != "" {
+		renderedBody, err := render(trigger.Body, data)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to render the body of the object")
+		}
+		body = []byte(renderedBody)
+	}
+
+	contentType := trigger.ContentType
+	if contentType == "" {
+		contentType = defaultContentType
+	}
+
+	if _, err := t.Client.PutObject(trigger.S3.Bucket.Name, key, bytes.NewReader(body), int64(len(body)), minio.PutObjectOptions{
+		ContentType:  contentType,
+		UserMetadata: trigger.S3.Metadata,
+	}); err != nil {
+		return nil, errors.Wrapf(err, "failed to write the object %s to the bucket %s", key, trigger.S3.Bucket.Name)
+	}
+
+	return key, nil
+}
+
+// render executes the go-template with the data. The fields missing from the data are errors, so that the
+// objects are not written with "<no value>" in their keys.
+func render(text string, data interface{}) (string, error) {
+	tpl, err := template.New("object").Funcs(sprig.HermeticTxtFuncMap()).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	if err := tpl.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// ApplyPolicy applies policy on the trigger
+func (t *ObjectStorageTrigger) ApplyPolicy(resource interface{}) error {
+	return nil
+}
@@ -0,0 +1,196 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package object_storage
+
+import (
+	"io"
+	"io/ioutil"
+	"testing"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/minio/minio-go"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-events/common/logging"
+	apicommon "github.com/argoproj/argo-events/pkg/apis/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+var sensorObj = &v1alpha1.Sensor{
+	ObjectMeta: metav1.ObjectMeta{
+		Name:      "fake-sensor",
+		Namespace: "fake",
+	},
+	Spec: v1alpha1.SensorSpec{
+		Triggers: []v1alpha1.Trigger{
+			{
+				Template: &v1alpha1.TriggerTemplate{
+					Name: "fake-trigger",
+					ObjectStorage: &v1alpha1.ObjectStorageTrigger{
+						S3: &apicommon.S3Artifact{
+							Endpoint: "minio.argo-events:9000",
+							Bucket: &apicommon.S3Bucket{
+								Name: "events",
+								Key:  "events/{{ .repo }}/{{ .id }}.json",
+							},
+							AccessKey: &corev1.SecretKeySelector{Key: "accesskey"},
+							SecretKey: &corev1.SecretKeySelector{Key: "secretkey"},
+						},
+						Payload: []v1alpha1.TriggerParameter{
+							{
+								Src: &v1alpha1.TriggerParameterSource{
+									DependencyName: "fake-dependency",
+									DataKey:        "id",
+								},
+								Dest: "id",
+							},
+							{
+								Src: &v1alpha1.TriggerParameterSource{
+									DependencyName: "fake-dependency",
+									DataKey:        "repo",
+								},
+								Dest: "repo",
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+var testEvents = map[string]*v1alpha1.Event{
+	"fake-dependency": {
+		Context: &v1alpha1.EventContext{
+			ID:              "1",
+			Type:            "webhook",
+			Source:          "webhook-gateway",
+			DataContentType: "application/json",
+			SpecVersion:     cloudevents.VersionV1,
+			Subject:         "example-1",
+		},
+		Data: []byte(`{"id": "1", "repo": "argo-events", "bucket": "archive"}`),
+	},
+}
+
+type writtenObject struct {
+	bucket      string
+	key         string
+	body        string
+	contentType string
+}
+
+type fakeClient struct {
+	objects []writtenObject
+	err     error
+}
+
+func (c *fakeClient) PutObject(bucketName, objectName string, reader io.Reader, objectSize int64, opts minio.PutObjectOptions) (int64, error) {
+	if c.err != nil {
+		return 0, c.err
+	}
+	body, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return 0, err
+	}
+	c.objects = append(c.objects, writtenObject{bucket: bucketName, key: objectName, body: string(body), contentType: opts.ContentType})
+	return objectSize, nil
+}
+
+func getObjectStorageTrigger(client ObjectWriter) *ObjectStorageTrigger {
+	return &ObjectStorageTrigger{
+		Sensor:  sensorObj.DeepCopy(),
+		Trigger: sensorObj.Spec.Triggers[0].DeepCopy(),
+		Client:  client,
+		Logger:  logging.NewArgoEventsLogger().Desugar(),
+	}
+}
+
+func TestObjectStorageTrigger_FetchResource(t *testing.T) {
+	trigger := getObjectStorageTrigger(&fakeClient{})
+	resource, err := trigger.FetchResource()
+	assert.Nil(t, err)
+	assert.NotNil(t, resource)
+
+	ot, ok := resource.(*v1alpha1.ObjectStorageTrigger)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, "events", ot.S3.Bucket.Name)
+}
+
+func TestObjectStorageTrigger_ApplyResourceParameters(t *testing.T) {
+	trigger := getObjectStorageTrigger(&fakeClient{})
+	trigger.Trigger.Template.ObjectStorage.Parameters = []v1alpha1.TriggerParameter{
+		{
+			Src: &v1alpha1.TriggerParameterSource{
+				DependencyName: "fake-dependency",
+				DataKey:        "bucket",
+			},
+			Dest: "s3.bucket.name",
+		},
+	}
+
+	response, err := trigger.ApplyResourceParameters(testEvents, trigger.Trigger.Template.ObjectStorage)
+	assert.Nil(t, err)
+	assert.NotNil(t, response)
+
+	updatedObj, ok := response.(*v1alpha1.ObjectStorageTrigger)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, "archive", updatedObj.S3.Bucket.Name)
+}
+
+func TestObjectStorageTrigger_Execute(t *testing.T) {
+	t.Run("write the payload", func(t *testing.T) {
+		client := &fakeClient{}
+		trigger := getObjectStorageTrigger(client)
+
+		key, err := trigger.Execute(testEvents, trigger.Trigger.Template.ObjectStorage)
+		assert.Nil(t, err)
+		assert.Equal(t, "events/argo-events/1.json", key)
+		assert.Equal(t, []writtenObject{
+			{bucket: "events", key: "events/argo-events/1.json", body: `{"id":"1","repo":"argo-events"}`, contentType: "application/json"},
+		}, client.objects)
+
+		client.err = errors.New("fake error")
+		_, err = trigger.Execute(testEvents, trigger.Trigger.Template.ObjectStorage)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("write the rendered body", func(t *testing.T) {
+		client := &fakeClient{}
+		trigger := getObjectStorageTrigger(client)
+		resource := trigger.Trigger.Template.ObjectStorage
+		resource.Body = "{{ .repo }}: {{ .id }}"
+		resource.ContentType = "text/plain"
+
+		_, err := trigger.Execute(testEvents, resource)
+		assert.Nil(t, err)
+		assert.Equal(t, []writtenObject{
+			{bucket: "events", key: "events/argo-events/1.json", body: "argo-events: 1", contentType: "text/plain"},
+		}, client.objects)
+	})
+
+	t.Run("invalid key template", func(t *testing.T) {
+		trigger := getObjectStorageTrigger(&fakeClient{})
+		resource := trigger.Trigger.Template.ObjectStorage
+		resource.S3.Bucket.Key = "events/{{ .repo "
+
+		_, err := trigger.Execute(testEvents, resource)
+		assert.NotNil(t, err)
+	})
+}
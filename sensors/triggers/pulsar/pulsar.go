@@ -0,0 +1,157 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package pulsar
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sensors/triggers"
+)
+
+// sendTimeout is the time limit to produce a message
+const sendTimeout = time.Minute
+
+// PulsarTrigger holds the context of the Pulsar trigger.
+type PulsarTrigger struct {
+	// Sensor object.
+	Sensor *v1alpha1.Sensor
+	// Trigger reference.
+	Trigger *v1alpha1.Trigger
+	// Producer refers to the Pulsar producer of the topic.
+	Producer pulsar.Producer
+	// Logger to log stuff.
+	Logger *zap.Logger
+}
+
+// NewPulsarTrigger returns new Pulsar trigger.
+func NewPulsarTrigger(sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, pulsarClients map[string]pulsar.Client, pulsarProducers map[string]pulsar.Producer, logger *zap.Logger) (*PulsarTrigger, error) {
+	pulsartrigger := trigger.Template.Pulsar
+
+	producer, ok := pulsarProducers[trigger.Template.Name]
+	if !ok {
+		client, ok := pulsarClients[trigger.Template.Name]
+		if !ok {
+			clientOpt := pulsar.ClientOptions{
+				URL:                        pulsartrigger.URL,
+				TLSTrustCertsFilePath:      pulsartrigger.TLSTrustCertsFilePath,
+				TLSAllowInsecureConnection: pulsartrigger.TLSAllowInsecureConnection,
+				TLSValidateHostname:        pulsartrigger.TLSValidateHostname,
+			}
+			if pulsartrigger.TLS != nil {
+				clientOpt.Authentication = pulsar.NewAuthenticationTLS(pulsartrigger.TLS.ClientCertPath, pulsartrigger.TLS.ClientKeyPath)
+			}
+
+			var err error
+			client, err = pulsar.NewClient(clientOpt)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to create a pulsar client")
+			}
+			pulsarClients[trigger.Template.Name] = client
+		}
+
+		var err error
+		producer, err = client.CreateProducer(pulsar.ProducerOptions{
+			Topic: pulsartrigger.Topic,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to create a producer for the topic %s", pulsartrigger.Topic)
+		}
+		pulsarProducers[trigger.Template.Name] = producer
+	}
+
+	return &PulsarTrigger{
+		Sensor:   sensor,
+		Trigger:  trigger,
+		Producer: producer,
+		Logger:   logger,
+	}, nil
+}
+
+// FetchResource fetches the trigger. As the Pulsar trigger is simply a Pulsar producer, there
+// is no need to fetch any resource from external source
+func (t *PulsarTrigger) FetchResource() (interface{}, error) {
+	return t.Trigger.Template.Pulsar, nil
+}
+
+// ApplyResourceParameters applies parameters to the trigger resource
+func (t *PulsarTrigger) ApplyResourceParameters(events map[string]*v1alpha1.Event, resource interface{}) (interface{}, error) {
+	fetchedResource, ok := resource.(*v1alpha1.PulsarTrigger)
+	if !ok {
+		return nil, errors.New("failed to interpret the fetched trigger resource")
+	}
+
+	resourceBytes, err := json.Marshal(fetchedResource)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal the pulsar trigger resource")
+	}
+	parameters := fetchedResource.Parameters
+	if parameters != nil {
+		updatedResourceBytes, err := triggers.ApplyParams(resourceBytes, parameters, events)
+		if err != nil {
+			return nil, err
+		}
+		var pt *v1alpha1.PulsarTrigger
+		if err := json.Unmarshal(updatedResourceBytes, &pt); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal the updated pulsar trigger resource after applying resource parameters")
+		}
+		return pt, nil
+	}
+	return resource, nil
+}
+
+// Execute executes the trigger
+func (t *PulsarTrigger) Execute(events map[string]*v1alpha1.Event, resource interface{}) (interface{}, error) {
+	trigger, ok := resource.(*v1alpha1.PulsarTrigger)
+	if !ok {
+		return nil, errors.New("failed to interpret the trigger resource")
+	}
+
+	if trigger.Payload == nil {
+		return nil, errors.New("payload parameters are not specified")
+	}
+
+	payload, err := triggers.ConstructPayload(events, trigger.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), sendTimeout)
+	defer cancel()
+
+	// The producer is bound to the topic of the trigger template, so the parameters can't change the topic.
+	messageID, err := t.Producer.Send(ctx, &pulsar.ProducerMessage{
+		Payload:    payload,
+		Key:        trigger.Key,
+		Properties: trigger.Properties,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to produce the message on the topic %s", t.Trigger.Template.Pulsar.Topic)
+	}
+
+	return messageID, nil
+}
+
+// ApplyPolicy applies policy on the trigger
+func (t *PulsarTrigger) ApplyPolicy(resource interface{}) error {
+	return nil
+}
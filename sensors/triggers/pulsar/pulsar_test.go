@@ -0,0 +1,149 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package pulsar
+
+import (
+	"context"
+	"testing"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-events/common/logging"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+var sensorObj = &v1alpha1.Sensor{
+	ObjectMeta: metav1.ObjectMeta{
+		Name:      "fake-sensor",
+		Namespace: "fake",
+	},
+	Spec: v1alpha1.SensorSpec{
+		Triggers: []v1alpha1.Trigger{
+			{
+				Template: &v1alpha1.TriggerTemplate{
+					Name: "fake-trigger",
+					Pulsar: &v1alpha1.PulsarTrigger{
+						URL:   "pulsar://localhost:6650",
+						Topic: "fake-topic",
+						Key:   "fake-key",
+						Payload: []v1alpha1.TriggerParameter{
+							{
+								Src: &v1alpha1.TriggerParameterSource{
+									DependencyName: "fake-dependency",
+									DataKey:        "name",
+								},
+								Dest: "name",
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+var testEvents = map[string]*v1alpha1.Event{
+	"fake-dependency": {
+		Context: &v1alpha1.EventContext{
+			ID:              "1",
+			Type:            "webhook",
+			Source:          "webhook-gateway",
+			DataContentType: "application/json",
+			SpecVersion:     cloudevents.VersionV1,
+			Subject:         "example-1",
+		},
+		Data: []byte(`{"name": "fake", "region": "us-east-1"}`),
+	},
+}
+
+type fakeProducer struct {
+	pulsar.Producer
+	messages []*pulsar.ProducerMessage
+	err      error
+}
+
+func (p *fakeProducer) Send(ctx context.Context, msg *pulsar.ProducerMessage) (pulsar.MessageID, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+	p.messages = append(p.messages, msg)
+	return pulsar.EarliestMessageID(), nil
+}
+
+func getPulsarTrigger(t *testing.T, producer pulsar.Producer) *PulsarTrigger {
+	trigger := sensorObj.Spec.Triggers[0].DeepCopy()
+	producers := map[string]pulsar.Producer{trigger.Template.Name: producer}
+	result, err := NewPulsarTrigger(sensorObj.DeepCopy(), trigger, map[string]pulsar.Client{}, producers, logging.NewArgoEventsLogger().Desugar())
+	assert.Nil(t, err)
+	return result
+}
+
+func TestPulsarTrigger_FetchResource(t *testing.T) {
+	trigger := getPulsarTrigger(t, &fakeProducer{})
+	resource, err := trigger.FetchResource()
+	assert.Nil(t, err)
+	assert.NotNil(t, resource)
+
+	pt, ok := resource.(*v1alpha1.PulsarTrigger)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, "fake-topic", pt.Topic)
+}
+
+func TestPulsarTrigger_ApplyResourceParameters(t *testing.T) {
+	trigger := getPulsarTrigger(t, &fakeProducer{})
+	trigger.Trigger.Template.Pulsar.Parameters = []v1alpha1.TriggerParameter{
+		{
+			Src: &v1alpha1.TriggerParameterSource{
+				DependencyName: "fake-dependency",
+				DataKey:        "region",
+			},
+			Dest: "key",
+		},
+	}
+
+	response, err := trigger.ApplyResourceParameters(testEvents, trigger.Trigger.Template.Pulsar)
+	assert.Nil(t, err)
+	assert.NotNil(t, response)
+
+	updatedObj, ok := response.(*v1alpha1.PulsarTrigger)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, "us-east-1", updatedObj.Key)
+}
+
+func TestPulsarTrigger_Execute(t *testing.T) {
+	producer := &fakeProducer{}
+	trigger := getPulsarTrigger(t, producer)
+	resource := trigger.Trigger.Template.Pulsar
+	resource.Properties = map[string]string{"source": "argo-events"}
+
+	_, err := trigger.Execute(testEvents, resource)
+	assert.Nil(t, err)
+	assert.Equal(t, []*pulsar.ProducerMessage{
+		{
+			Payload:    []byte(`{"name":"fake"}`),
+			Key:        "fake-key",
+			Properties: map[string]string{"source": "argo-events"},
+		},
+	}, producer.messages)
+
+	producer.err = errors.New("fake error")
+	_, err = trigger.Execute(testEvents, resource)
+	assert.NotNil(t, err)
+}
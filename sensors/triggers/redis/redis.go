@@ -0,0 +1,175 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package redis
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/go-redis/redis"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/argoproj/argo-events/common"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+	"github.com/argoproj/argo-events/sensors/triggers"
+)
+
+// RedisTrigger holds the context of the Redis trigger.
+type RedisTrigger struct {
+	// Sensor object.
+	Sensor *v1alpha1.Sensor
+	// Trigger reference.
+	Trigger *v1alpha1.Trigger
+	// Client refers to the Redis client.
+	Client redis.Cmdable
+	// Logger to log stuff.
+	Logger *zap.Logger
+}
+
+// NewRedisTrigger returns new Redis trigger.
+func NewRedisTrigger(sensor *v1alpha1.Sensor, trigger *v1alpha1.Trigger, redisClients map[string]*redis.Client, logger *zap.Logger) (*RedisTrigger, error) {
+	redistrigger := trigger.Template.Redis
+
+	client, ok := redisClients[trigger.Template.Name]
+	if !ok {
+		opt := &redis.Options{
+			Addr: redistrigger.HostAddress,
+			DB:   int(redistrigger.DB),
+		}
+		if redistrigger.Password != nil {
+			password, err := common.GetSecretFromVolume(redistrigger.Password)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to retrieve the password")
+			}
+			opt.Password = strings.TrimSpace(password)
+		}
+		if redistrigger.TLS != nil {
+			tlsConfig, err := common.GetTLSConfig(redistrigger.TLS.CACertPath, redistrigger.TLS.ClientCertPath, redistrigger.TLS.ClientKeyPath)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to get the tls configuration")
+			}
+			opt.TLSConfig = tlsConfig
+		}
+
+		client = redis.NewClient(opt)
+		if status := client.Ping(); status.Err() != nil {
+			_ = client.Close()
+			return nil, errors.Wrapf(status.Err(), "failed to connect to host %s and db %d", redistrigger.HostAddress, redistrigger.DB)
+		}
+		redisClients[trigger.Template.Name] = client
+	}
+
+	return &RedisTrigger{
+		Sensor:  sensor,
+		Trigger: trigger,
+		Client:  client,
+		Logger:  logger,
+	}, nil
+}
+
+// FetchResource fetches the trigger. As the Redis trigger is simply a Redis client, there
+// is no need to fetch any resource from external source
+func (t *RedisTrigger) FetchResource() (interface{}, error) {
+	return t.Trigger.Template.Redis, nil
+}
+
+// ApplyResourceParameters applies parameters to the trigger resource
+func (t *RedisTrigger) ApplyResourceParameters(events map[string]*v1alpha1.Event, resource interface{}) (interface{}, error) {
+	fetchedResource, ok := resource.(*v1alpha1.RedisTrigger)
+	if !ok {
+		return nil, errors.New("failed to interpret the fetched trigger resource")
+	}
+
+	resourceBytes, err := json.Marshal(fetchedResource)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal the redis trigger resource")
+	}
+	parameters := fetchedResource.Parameters
+	if parameters != nil {
+		updatedResourceBytes, err := triggers.ApplyParams(resourceBytes, parameters, events)
+		if err != nil {
+			return nil, err
+		}
+		var rt *v1alpha1.RedisTrigger
+		if err := json.Unmarshal(updatedResourceBytes, &rt); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal the updated redis trigger resource after applying resource parameters")
+		}
+		return rt, nil
+	}
+	return resource, nil
+}
+
+// Execute executes the trigger
+func (t *RedisTrigger) Execute(events map[string]*v1alpha1.Event, resource interface{}) (interface{}, error) {
+	trigger, ok := resource.(*v1alpha1.RedisTrigger)
+	if !ok {
+		return nil, errors.New("failed to interpret the trigger resource")
+	}
+
+	if trigger.Payload == nil {
+		return nil, errors.New("payload parameters are not specified")
+	}
+
+	payload, err := triggers.ConstructPayload(events, trigger.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	if trigger.Stream != "" {
+		values, err := streamValues(payload)
+		if err != nil {
+			return nil, err
+		}
+		id, err := t.Client.XAdd(&redis.XAddArgs{
+			Stream:       trigger.Stream,
+			MaxLenApprox: trigger.MaxStreamLength,
+			Values:       values,
+		}).Result()
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to add the entry to the stream %s", trigger.Stream)
+		}
+		return id, nil
+	}
+
+	if err := t.Client.Publish(trigger.Channel, payload).Err(); err != nil {
+		return nil, errors.Wrapf(err, "failed to publish the message to the channel %s", trigger.Channel)
+	}
+	return nil, nil
+}
+
+// streamValues returns the fields of the stream entry from the top-level fields of the payload.
+// The string values are added as is, the other values as JSON.
+func streamValues(payload []byte) (map[string]interface{}, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(payload, &fields); err != nil {
+		return nil, errors.Wrap(err, "failed to read the fields of the stream entry from the payload")
+	}
+	values := make(map[string]interface{}, len(fields))
+	for key, raw := range fields {
+		var value string
+		if err := json.Unmarshal(raw, &value); err != nil {
+			value = string(raw)
+		}
+		values[key] = value
+	}
+	return values, nil
+}
+
+// ApplyPolicy applies policy on the trigger
+func (t *RedisTrigger) ApplyPolicy(resource interface{}) error {
+	return nil
+}
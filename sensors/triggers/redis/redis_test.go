@@ -0,0 +1,178 @@
+/*
+Copyright 2018 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package redis
+
+import (
+	"testing"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/go-redis/redis"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-events/common/logging"
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+var sensorObj = &v1alpha1.Sensor{
+	ObjectMeta: metav1.ObjectMeta{
+		Name:      "fake-sensor",
+		Namespace: "fake",
+	},
+	Spec: v1alpha1.SensorSpec{
+		Triggers: []v1alpha1.Trigger{
+			{
+				Template: &v1alpha1.TriggerTemplate{
+					Name: "fake-trigger",
+					Redis: &v1alpha1.RedisTrigger{
+						HostAddress: "localhost:6379",
+						Channel:     "fake-channel",
+						Payload: []v1alpha1.TriggerParameter{
+							{
+								Src: &v1alpha1.TriggerParameterSource{
+									DependencyName: "fake-dependency",
+									DataKey:        "name",
+								},
+								Dest: "name",
+							},
+							{
+								Src: &v1alpha1.TriggerParameterSource{
+									DependencyName: "fake-dependency",
+									DataKey:        "count",
+								},
+								Dest: "count",
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+var testEvents = map[string]*v1alpha1.Event{
+	"fake-dependency": {
+		Context: &v1alpha1.EventContext{
+			ID:              "1",
+			Type:            "webhook",
+			Source:          "webhook-gateway",
+			DataContentType: "application/json",
+			SpecVersion:     cloudevents.VersionV1,
+			Subject:         "example-1",
+		},
+		Data: []byte(`{"name": "fake", "count": 2, "channel": "real-channel"}`),
+	},
+}
+
+type fakeClient struct {
+	redis.Cmdable
+	published map[string]interface{}
+	added     []*redis.XAddArgs
+	err       error
+}
+
+func (c *fakeClient) Publish(channel string, message interface{}) *redis.IntCmd {
+	if c.err != nil {
+		return redis.NewIntResult(0, c.err)
+	}
+	c.published[channel] = message
+	return redis.NewIntResult(1, nil)
+}
+
+func (c *fakeClient) XAdd(a *redis.XAddArgs) *redis.StringCmd {
+	if c.err != nil {
+		return redis.NewStringResult("", c.err)
+	}
+	c.added = append(c.added, a)
+	return redis.NewStringResult("1-0", nil)
+}
+
+func getRedisTrigger(client redis.Cmdable) *RedisTrigger {
+	return &RedisTrigger{
+		Sensor:  sensorObj.DeepCopy(),
+		Trigger: sensorObj.Spec.Triggers[0].DeepCopy(),
+		Client:  client,
+		Logger:  logging.NewArgoEventsLogger().Desugar(),
+	}
+}
+
+func TestRedisTrigger_FetchResource(t *testing.T) {
+	trigger := getRedisTrigger(&fakeClient{})
+	resource, err := trigger.FetchResource()
+	assert.Nil(t, err)
+	assert.NotNil(t, resource)
+
+	rt, ok := resource.(*v1alpha1.RedisTrigger)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, "fake-channel", rt.Channel)
+}
+
+func TestRedisTrigger_ApplyResourceParameters(t *testing.T) {
+	trigger := getRedisTrigger(&fakeClient{})
+	trigger.Trigger.Template.Redis.Parameters = []v1alpha1.TriggerParameter{
+		{
+			Src: &v1alpha1.TriggerParameterSource{
+				DependencyName: "fake-dependency",
+				DataKey:        "channel",
+			},
+			Dest: "channel",
+		},
+	}
+
+	response, err := trigger.ApplyResourceParameters(testEvents, trigger.Trigger.Template.Redis)
+	assert.Nil(t, err)
+	assert.NotNil(t, response)
+
+	updatedObj, ok := response.(*v1alpha1.RedisTrigger)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, "real-channel", updatedObj.Channel)
+}
+
+func TestRedisTrigger_Execute(t *testing.T) {
+	t.Run("publish to a channel", func(t *testing.T) {
+		client := &fakeClient{published: map[string]interface{}{}}
+		trigger := getRedisTrigger(client)
+
+		_, err := trigger.Execute(testEvents, trigger.Trigger.Template.Redis)
+		assert.Nil(t, err)
+		assert.Equal(t, []byte(`{"name":"fake","count":"2"}`), client.published["fake-channel"])
+
+		client.err = errors.New("fake error")
+		_, err = trigger.Execute(testEvents, trigger.Trigger.Template.Redis)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("add to a stream", func(t *testing.T) {
+		client := &fakeClient{}
+		trigger := getRedisTrigger(client)
+		resource := trigger.Trigger.Template.Redis
+		resource.Channel = ""
+		resource.Stream = "fake-stream"
+		resource.MaxStreamLength = 1000
+
+		id, err := trigger.Execute(testEvents, resource)
+		assert.Nil(t, err)
+		assert.Equal(t, "1-0", id)
+		assert.Equal(t, []*redis.XAddArgs{
+			{
+				Stream:       "fake-stream",
+				MaxLenApprox: 1000,
+				Values:       map[string]interface{}{"name": "fake", "count": "2"},
+			},
+		}, client.added)
+	})
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package triggers
+
+import (
+	"io"
+	"net"
+	"net/http"
+	"syscall"
+
+	"github.com/Shopify/sarama"
+	"github.com/apache/openwhisk-client-go/whisk"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	natslib "github.com/nats-io/go-nats"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/argoproj/argo-events/common"
+)
+
+// RetryableError marks a trigger execution error as transient, so that the execution can be retried.
+type RetryableError struct {
+	err error
+}
+
+// NewRetryableError wraps the given error as a retryable error
+func NewRetryableError(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &RetryableError{err: err}
+}
+
+func (e *RetryableError) Error() string {
+	return e.err.Error()
+}
+
+// Cause returns the underlying error
+func (e *RetryableError) Cause() error {
+	return e.err
+}
+
+// IsRetryableHTTPStatus returns true if the HTTP response status code indicates a transient failure
+func IsRetryableHTTPStatus(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests || statusCode >= http.StatusInternalServerError
+}
+
+// IsRetryableError returns true if the trigger execution error is transient and the execution can be retried.
+// Errors that are not known to be transient are treated as non-retryable.
+func IsRetryableError(err error) bool {
+	for err != nil {
+		if _, ok := err.(*RetryableError); ok {
+			return true
+		}
+		if retryable, known := classifyError(err); known {
+			return retryable
+		}
+		cause, ok := err.(interface{ Cause() error })
+		if !ok {
+			break
+		}
+		err = cause.Cause()
+	}
+	return false
+}
+
+// classifyError classifies a single error in the chain. The second return value is false
+// when the error does not carry enough information to make a decision.
+func classifyError(err error) (bool, bool) {
+	switch err {
+	case io.EOF, io.ErrUnexpectedEOF:
+		return true, true
+	case natslib.ErrConnectionClosed, natslib.ErrConnectionReconnecting, natslib.ErrTimeout,
+		natslib.ErrNoServers, natslib.ErrReconnectBufExceeded, natslib.ErrStaleConnection:
+		return true, true
+	case sarama.ErrOutOfBrokers, sarama.ErrNotConnected:
+		return true, true
+	}
+
+	switch e := err.(type) {
+	case sarama.KError:
+		switch e {
+		case sarama.ErrUnknownTopicOrPartition, sarama.ErrLeaderNotAvailable, sarama.ErrNotLeaderForPartition,
+			sarama.ErrRequestTimedOut, sarama.ErrBrokerNotAvailable, sarama.ErrReplicaNotAvailable,
+			sarama.ErrNetworkException, sarama.ErrNotEnoughReplicas, sarama.ErrNotEnoughReplicasAfterAppend,
+			sarama.ErrNotController, sarama.ErrKafkaStorageError:
+			return true, true
+		default:
+			return false, true
+		}
+	case *whisk.WskError:
+		if e.TimedOut {
+			return true, true
+		}
+		if e.RootErr != nil {
+			return IsRetryableError(e.RootErr), true
+		}
+		return false, true
+	case syscall.Errno:
+		return e == syscall.ECONNREFUSED || e == syscall.ECONNRESET || e == syscall.EPIPE, true
+	case net.Error:
+		if e.Timeout() {
+			return true, true
+		}
+		if opErr, ok := e.(*net.OpError); ok {
+			return IsRetryableError(opErr.Err), true
+		}
+		if urlErr, ok := e.(interface{ Unwrap() error }); ok {
+			return IsRetryableError(urlErr.Unwrap()), true
+		}
+		return false, true
+	}
+
+	if _, ok := err.(apierr.APIStatus); ok {
+		return common.IsRetryableKubeAPIError(err) && !apierr.IsAlreadyExists(err) && !apierr.IsConflict(err) && !apierr.IsBadRequest(err), true
+	}
+
+	if awsErr, ok := err.(awserr.Error); ok {
+		if reqErr, ok := awsErr.(awserr.RequestFailure); ok && IsRetryableHTTPStatus(reqErr.StatusCode()) {
+			return true, true
+		}
+		return request.IsErrorRetryable(awsErr) || request.IsErrorThrottle(awsErr), true
+	}
+
+	if s, ok := status.FromError(err); ok && s.Code() != codes.OK && s.Code() != codes.Unknown {
+		switch s.Code() {
+		case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted:
+			return true, true
+		default:
+			return false, true
+		}
+	}
+
+	return false, false
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package triggers
+
+import (
+	"net"
+	"net/url"
+	"syscall"
+	"testing"
+
+	"github.com/Shopify/sarama"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	natslib "github.com/nats-io/go-nats"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestIsRetryableError(t *testing.T) {
+	resource := schema.GroupResource{Group: "apps", Resource: "deployments"}
+	dialErr := &url.Error{
+		Op:  "Post",
+		URL: "http://fake.com",
+		Err: &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED},
+	}
+
+	tests := []struct {
+		name      string
+		err       error
+		retryable bool
+	}{
+		{"nil error", nil, false},
+		{"unknown error", errors.New("fake"), false},
+		{"explicit retryable error", NewRetryableError(errors.New("fake")), true},
+		{"wrapped retryable error", errors.Wrap(NewRetryableError(errors.New("fake")), "failed"), true},
+		{"connection refused", dialErr, true},
+		{"wrapped connection refused", errors.Wrap(dialErr, "failed"), true},
+		{"nats connection closed", natslib.ErrConnectionClosed, true},
+		{"nats invalid subject", natslib.ErrBadSubject, false},
+		{"kafka leader not available", errors.Wrap(sarama.ErrLeaderNotAvailable, "failed"), true},
+		{"kafka out of brokers", sarama.ErrOutOfBrokers, true},
+		{"kafka message too large", sarama.ErrMessageSizeTooLarge, false},
+		{"grpc unavailable", errors.Wrap(status.Error(codes.Unavailable, "down"), "failed"), true},
+		{"grpc invalid argument", status.Error(codes.InvalidArgument, "bad"), false},
+		{"k8s server timeout", apierr.NewServerTimeout(resource, "create", 1), true},
+		{"k8s not found", apierr.NewNotFound(resource, "fake"), false},
+		{"k8s already exists", apierr.NewAlreadyExists(resource, "fake"), false},
+		{"aws throttling", awserr.New("ThrottlingException", "slow down", nil), true},
+		{"aws service error", awserr.NewRequestFailure(awserr.New(lambda.ErrCodeServiceException, "down", nil), 500, "id"), true},
+		{"aws invalid request", awserr.NewRequestFailure(awserr.New(lambda.ErrCodeInvalidParameterValueException, "bad", nil), 400, "id"), false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.retryable, IsRetryableError(test.err))
+		})
+	}
+}
+
+func TestIsRetryableHTTPStatus(t *testing.T) {
+	assert.True(t, IsRetryableHTTPStatus(503))
+	assert.True(t, IsRetryableHTTPStatus(429))
+	assert.False(t, IsRetryableHTTPStatus(404))
+	assert.False(t, IsRetryableHTTPStatus(200))
+}
@@ -19,6 +19,7 @@ package standard_k8s
 import (
 	"fmt"
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/imdario/mergo"
@@ -40,6 +41,9 @@ import (
 	"github.com/argoproj/argo-events/store"
 )
 
+// defaultFieldManager is the field manager of the applied resources
+const defaultFieldManager = "argo-events"
+
 // StandardK8STrigger implements Trigger interface for standard Kubernetes resources
 type StandardK8sTrigger struct {
 	// K8sClient is kubernetes client
@@ -52,6 +56,8 @@ type StandardK8sTrigger struct {
 	Trigger *v1alpha1.Trigger
 	// logger to log stuff
 	Logger *zap.Logger
+	// ConcurrencyLock serializes the concurrency policy checks and the creation of the resources of the trigger
+	ConcurrencyLock sync.Locker
 
 	namespableDynamicClient dynamic.NamespaceableResourceInterface
 }
@@ -160,18 +166,75 @@ func (k8sTrigger *StandardK8sTrigger) Execute(events map[string]*v1alpha1.Event,
 
 	switch op {
 	case v1alpha1.Create:
+		k8sTrigger.setTriggerLabels(obj)
+		if k8sTrigger.ConcurrencyLock != nil {
+			k8sTrigger.ConcurrencyLock.Lock()
+			defer k8sTrigger.ConcurrencyLock.Unlock()
+		}
+		create, err := triggers.ApplyConcurrencyPolicy(k8sTrigger.namespableDynamicClient.Namespace(namespace), k8sTrigger.Sensor, trigger, trigger.Template.K8s.Concurrency, "", events, obj, k8sTrigger.Logger)
+		if err != nil {
+			return nil, err
+		}
+		if !create {
+			return nil, nil
+		}
 		k8sTrigger.Logger.Info("creating the object...")
-		// Add labels
-		labels := obj.GetLabels()
-		if labels == nil {
-			labels = make(map[string]string)
-		}
-		labels["events.argoproj.io/sensor"] = k8sTrigger.Sensor.Name
-		labels["events.argoproj.io/trigger"] = trigger.Template.Name
-		labels["events.argoproj.io/action-timestamp"] = strconv.Itoa(int(time.Now().UnixNano() / int64(time.Millisecond)))
-		obj.SetLabels(labels)
 		return k8sTrigger.namespableDynamicClient.Namespace(namespace).Create(obj, metav1.CreateOptions{})
 
+	case v1alpha1.CreateOrUpdate:
+		k8sTrigger.setTriggerLabels(obj)
+		oldObj, err := k8sTrigger.namespableDynamicClient.Namespace(namespace).Get(obj.GetName(), metav1.GetOptions{})
+		if err != nil && apierrors.IsNotFound(err) {
+			k8sTrigger.Logger.Info("creating the object...")
+			return k8sTrigger.namespableDynamicClient.Namespace(namespace).Create(obj, metav1.CreateOptions{})
+		} else if err != nil {
+			return nil, errors.Errorf("failed to retrieve existing object. err: %+v\n", err)
+		}
+		k8sTrigger.Logger.Info("replacing the object...")
+		obj.SetResourceVersion(oldObj.GetResourceVersion())
+		return k8sTrigger.namespableDynamicClient.Namespace(namespace).Update(obj, metav1.UpdateOptions{})
+
+	case v1alpha1.Apply:
+		k8sTrigger.Logger.Info("applying the object...")
+		k8sTrigger.setTriggerLabels(obj)
+		body, err := obj.MarshalJSON()
+		if err != nil {
+			return nil, errors.Errorf("failed to marshal object into JSON schema. err: %+v\n", err)
+		}
+		fieldManager := trigger.Template.K8s.FieldManager
+		if fieldManager == "" {
+			fieldManager = defaultFieldManager
+		}
+		opts := metav1.PatchOptions{FieldManager: fieldManager}
+		if trigger.Template.K8s.ForceConflicts {
+			force := true
+			opts.Force = &force
+		}
+		return k8sTrigger.namespableDynamicClient.Namespace(namespace).Patch(obj.GetName(), k8stypes.ApplyPatchType, body, opts)
+
+	case v1alpha1.Delete:
+		if selector := trigger.Template.K8s.LabelSelector; selector != "" {
+			k8sTrigger.Logger.Info("deleting the objects...", zap.String("label-selector", selector))
+			list, err := k8sTrigger.namespableDynamicClient.Namespace(namespace).List(metav1.ListOptions{LabelSelector: selector})
+			if err != nil {
+				return nil, errors.Errorf("failed to list the objects. err: %+v\n", err)
+			}
+			for _, item := range list.Items {
+				if err := k8sTrigger.deleteObject(namespace, item.GetName()); err != nil {
+					return nil, err
+				}
+			}
+			return list, nil
+		}
+		k8sTrigger.Logger.Info("deleting the object...")
+		if obj.GetName() == "" {
+			return nil, errors.New("failed to delete the object, neither name nor label selector is given")
+		}
+		if err := k8sTrigger.deleteObject(namespace, obj.GetName()); err != nil {
+			return nil, err
+		}
+		return obj, nil
+
 	case v1alpha1.Update:
 		k8sTrigger.Logger.Info("updating the object...")
 
@@ -216,11 +279,39 @@ func (k8sTrigger *StandardK8sTrigger) Execute(events map[string]*v1alpha1.Event,
 	}
 }
 
+// setTriggerLabels sets the labels of the sensor and the trigger on the object, and the owner reference if required
+func (k8sTrigger *StandardK8sTrigger) setTriggerLabels(obj *unstructured.Unstructured) {
+	labels := obj.GetLabels()
+	if labels == nil {
+		labels = make(map[string]string)
+	}
+	labels[triggers.LabelSensor] = k8sTrigger.Sensor.Name
+	labels[triggers.LabelTrigger] = k8sTrigger.Trigger.Template.Name
+	labels[triggers.LabelActionTimestamp] = strconv.Itoa(int(time.Now().UnixNano() / int64(time.Millisecond)))
+	obj.SetLabels(labels)
+	triggers.SetOwnerReference(obj, k8sTrigger.Sensor, k8sTrigger.Trigger.Template.K8s.GarbageCollection, k8sTrigger.Logger)
+}
+
+// deleteObject deletes the object, an object that is already gone is not an error
+func (k8sTrigger *StandardK8sTrigger) deleteObject(namespace, name string) error {
+	propagation := metav1.DeletePropagationBackground
+	err := k8sTrigger.namespableDynamicClient.Namespace(namespace).Delete(name, &metav1.DeleteOptions{PropagationPolicy: &propagation})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return errors.Errorf("failed to delete the object %s. err: %+v\n", name, err)
+	}
+	return nil
+}
+
 // ApplyPolicy applies the policy on the trigger
 func (k8sTrigger *StandardK8sTrigger) ApplyPolicy(resource interface{}) error {
 	trigger := k8sTrigger.Trigger
 
-	if trigger.Policy == nil || trigger.Policy.K8s == nil || trigger.Policy.K8s.Labels == nil {
+	// no resource is created when the concurrency policy skips it
+	if trigger.Policy == nil || trigger.Policy.K8s == nil || resource == nil {
+		return nil
+	}
+	// deleted resources have no state to watch
+	if trigger.Template.K8s.Operation == v1alpha1.Delete {
 		return nil
 	}
 
@@ -229,19 +320,17 @@ func (k8sTrigger *StandardK8sTrigger) ApplyPolicy(resource interface{}) error {
 		return errors.New("failed to interpret the trigger resource")
 	}
 
-	p := policy.NewResourceLabels(trigger, k8sTrigger.namespableDynamicClient, obj)
-	if p == nil {
-		return nil
+	err := policy.NewResourceLabels(trigger, k8sTrigger.namespableDynamicClient, obj).ApplyPolicy()
+	if err == nil {
+		err = policy.NewResourceCondition(trigger, k8sTrigger.namespableDynamicClient, obj).ApplyPolicy()
 	}
-
-	err := p.ApplyPolicy()
 	if err != nil {
 		switch err {
 		case wait.ErrWaitTimeout:
 			if trigger.Policy.K8s.ErrorOnBackoffTimeout {
 				return errors.Errorf("failed to determine status of the triggered resource. setting trigger state as failed")
 			}
-			return nil
+			return policy.ErrTimedOut
 		default:
 			return err
 		}
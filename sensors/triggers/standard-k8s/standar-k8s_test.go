@@ -23,11 +23,14 @@ import (
 	k8stypes "k8s.io/apimachinery/pkg/types"
 
 	"github.com/stretchr/testify/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	dynamicFake "k8s.io/client-go/dynamic/fake"
 	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
 
 	"github.com/argoproj/argo-events/common"
 	"github.com/argoproj/argo-events/common/logging"
@@ -192,3 +195,90 @@ func TestStandardK8sTrigger_Execute(t *testing.T) {
 	assert.Equal(t, true, ok)
 	assert.Equal(t, "bar", uObj.GetLabels()["foo"])
 }
+
+func TestStandardK8sTrigger_ExecuteCreateOrUpdate(t *testing.T) {
+	fakeSensor := sensorObj.DeepCopy()
+	fakeSensor.Spec.Triggers[0].Template.K8s.Operation = v1alpha1.CreateOrUpdate
+	client := dynamicFake.NewSimpleDynamicClient(runtime.NewScheme())
+	impl := NewStandardK8sTrigger(fake.NewSimpleClientset(), client, fakeSensor, &fakeSensor.Spec.Triggers[0], logging.NewArgoEventsLogger().Desugar())
+
+	resource, err := impl.Execute(nil, newUnstructured("apps/v1", "Deployment", "fake", "test"))
+	assert.Nil(t, err)
+	uObj := resource.(*unstructured.Unstructured)
+	assert.Equal(t, "fake-sensor", uObj.GetLabels()["events.argoproj.io/sensor"])
+
+	deployment := newUnstructured("apps/v1", "Deployment", "fake", "test")
+	deployment.Object["spec"] = map[string]interface{}{
+		"paused": true,
+	}
+	resource, err = impl.Execute(nil, deployment)
+	assert.Nil(t, err)
+	uObj = resource.(*unstructured.Unstructured)
+	spec, _, _ := unstructured.NestedMap(uObj.Object, "spec")
+	assert.Equal(t, map[string]interface{}{"paused": true}, spec)
+}
+
+func TestStandardK8sTrigger_ExecuteApply(t *testing.T) {
+	fakeSensor := sensorObj.DeepCopy()
+	fakeSensor.Spec.Triggers[0].Template.K8s.Operation = v1alpha1.Apply
+	fakeSensor.Spec.Triggers[0].Template.K8s.ForceConflicts = true
+	client := dynamicFake.NewSimpleDynamicClient(runtime.NewScheme())
+	var action k8stesting.PatchAction
+	client.PrependReactor("patch", "deployments", func(a k8stesting.Action) (bool, runtime.Object, error) {
+		action = a.(k8stesting.PatchAction)
+		return true, newUnstructured("apps/v1", "Deployment", "fake", "test"), nil
+	})
+	impl := NewStandardK8sTrigger(fake.NewSimpleClientset(), client, fakeSensor, &fakeSensor.Spec.Triggers[0], logging.NewArgoEventsLogger().Desugar())
+
+	resource, err := impl.Execute(nil, newUnstructured("apps/v1", "Deployment", "fake", "test"))
+	assert.Nil(t, err)
+	assert.NotNil(t, resource)
+	assert.NotNil(t, action)
+	assert.Equal(t, k8stypes.ApplyPatchType, action.GetPatchType())
+	assert.Equal(t, "test", action.GetName())
+}
+
+func TestStandardK8sTrigger_ExecuteDelete(t *testing.T) {
+	fakeSensor := sensorObj.DeepCopy()
+	fakeSensor.Spec.Triggers[0].Template.K8s.Operation = v1alpha1.Delete
+	gvr := schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+
+	t.Run("by name", func(t *testing.T) {
+		client := dynamicFake.NewSimpleDynamicClient(runtime.NewScheme(), newUnstructured("apps/v1", "Deployment", "fake", "test"))
+		impl := NewStandardK8sTrigger(fake.NewSimpleClientset(), client, fakeSensor, &fakeSensor.Spec.Triggers[0], logging.NewArgoEventsLogger().Desugar())
+		_, err := impl.Execute(nil, newUnstructured("apps/v1", "Deployment", "fake", "test"))
+		assert.Nil(t, err)
+		_, err = client.Resource(gvr).Namespace("fake").Get("test", metav1.GetOptions{})
+		assert.True(t, apierrors.IsNotFound(err))
+
+		// deleting a missing object is not an error
+		_, err = impl.Execute(nil, newUnstructured("apps/v1", "Deployment", "fake", "test"))
+		assert.Nil(t, err)
+	})
+
+	t.Run("by label selector", func(t *testing.T) {
+		client := dynamicFake.NewSimpleDynamicClient(runtime.NewScheme(),
+			newUnstructured("apps/v1", "Deployment", "fake", "test-1"),
+			newUnstructured("apps/v1", "Deployment", "fake", "test-2"),
+		)
+		trigger := fakeSensor.Spec.Triggers[0].DeepCopy()
+		trigger.Template.K8s.LabelSelector = "name=test-1"
+		impl := NewStandardK8sTrigger(fake.NewSimpleClientset(), client, fakeSensor, trigger, logging.NewArgoEventsLogger().Desugar())
+		resource, err := impl.Execute(nil, newUnstructured("apps/v1", "Deployment", "fake", ""))
+		assert.Nil(t, err)
+		list, ok := resource.(*unstructured.UnstructuredList)
+		assert.True(t, ok)
+		assert.Equal(t, 1, len(list.Items))
+		_, err = client.Resource(gvr).Namespace("fake").Get("test-1", metav1.GetOptions{})
+		assert.True(t, apierrors.IsNotFound(err))
+		_, err = client.Resource(gvr).Namespace("fake").Get("test-2", metav1.GetOptions{})
+		assert.Nil(t, err)
+	})
+
+	t.Run("without name", func(t *testing.T) {
+		client := dynamicFake.NewSimpleDynamicClient(runtime.NewScheme())
+		impl := NewStandardK8sTrigger(fake.NewSimpleClientset(), client, fakeSensor, &fakeSensor.Spec.Triggers[0], logging.NewArgoEventsLogger().Desugar())
+		_, err := impl.Execute(nil, newUnstructured("apps/v1", "Deployment", "fake", ""))
+		assert.NotNil(t, err)
+	})
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package triggers
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/antonmedv/expr"
+	"github.com/pkg/errors"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+// EvaluateWhen evaluates the trigger when expression against the events.
+// An empty expression evaluates to true.
+func EvaluateWhen(when string, events map[string]*v1alpha1.Event) (bool, error) {
+	if when == "" {
+		return true, nil
+	}
+	env := make(map[string]interface{}, len(events))
+	for name, event := range events {
+		value, err := whenVariable(event)
+		if err != nil {
+			return false, errors.Wrapf(err, "failed to resolve the event of dependency %s", name)
+		}
+		env[strings.ReplaceAll(name, "-", "_")] = value
+	}
+	program, err := expr.Compile(when, expr.Env(env), expr.AllowUndefinedVariables(), expr.AsBool())
+	if err != nil {
+		return false, errors.Wrap(err, "failed to compile the when expression")
+	}
+	result, err := expr.Run(program, env)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to evaluate the when expression")
+	}
+	return result.(bool), nil
+}
+
+// whenVariable converts the event into the variable available to the when expression.
+// Data that is not valid JSON is available as a string.
+func whenVariable(event *v1alpha1.Event) (map[string]interface{}, error) {
+	variable := map[string]interface{}{}
+	if event == nil {
+		return variable, nil
+	}
+	if event.Context != nil {
+		contextBytes, err := json.Marshal(event.Context)
+		if err != nil {
+			return nil, err
+		}
+		var context map[string]interface{}
+		if err := json.Unmarshal(contextBytes, &context); err != nil {
+			return nil, err
+		}
+		variable["context"] = context
+	}
+	var data interface{}
+	if err := json.Unmarshal(event.Data, &data); err != nil {
+		data = string(event.Data)
+	}
+	variable["data"] = data
+	return variable, nil
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package triggers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-events/pkg/apis/sensor/v1alpha1"
+)
+
+func TestEvaluateWhen(t *testing.T) {
+	events := map[string]*v1alpha1.Event{
+		"git-push": {
+			Context: &v1alpha1.EventContext{
+				Type:   "webhook",
+				Source: "github",
+			},
+			Data: []byte(`{"body": {"branch": "develop", "commits": 3}}`),
+		},
+		"plain": {
+			Context: &v1alpha1.EventContext{},
+			Data:    []byte("hello"),
+		},
+	}
+
+	tests := []struct {
+		name   string
+		when   string
+		result bool
+	}{
+		{"empty expression", "", true},
+		{"data match", `git_push.data.body.branch == "develop"`, true},
+		{"data mismatch", `git_push.data.body.branch == "main"`, false},
+		{"number comparison", `git_push.data.body.commits > 2`, true},
+		{"context match", `git_push.context.source == "github"`, true},
+		{"plain data", `plain.data == "hello"`, true},
+		{"missing dependency", `other != nil && other.data.body.branch == "main"`, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := EvaluateWhen(test.when, events)
+			assert.Nil(t, err)
+			assert.Equal(t, test.result, result)
+		})
+	}
+
+	_, err := EvaluateWhen(`git_push.data.body.branch`, events)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"context"
+)
+
+// triggerWorkerPool executes trigger actions on a fixed number of workers
+type triggerWorkerPool struct {
+	jobs chan func()
+	// stopped is closed once the pool is stopped
+	stopped <-chan struct{}
+	stop    context.CancelFunc
+}
+
+// newTriggerWorkerPool starts the workers, which exit once the context is done or the pool is stopped.
+func newTriggerWorkerPool(ctx context.Context, workers int) *triggerWorkerPool {
+	pctx, cancel := context.WithCancel(ctx)
+	pool := &triggerWorkerPool{
+		jobs:    make(chan func()),
+		stopped: pctx.Done(),
+		stop:    cancel,
+	}
+	for i := 0; i < workers; i++ {
+		go func() {
+			for {
+				select {
+				case <-pool.stopped:
+					return
+				case job := <-pool.jobs:
+					job()
+				}
+			}
+		}()
+	}
+	return pool
+}
+
+// submit blocks until a worker picks up the job. It returns false if the context is done or the pool is stopped
+// before that.
+func (pool *triggerWorkerPool) submit(ctx context.Context, job func()) bool {
+	// A stopped pool may still have idle workers, don't let them pick up the job.
+	select {
+	case <-ctx.Done():
+		return false
+	case <-pool.stopped:
+		return false
+	default:
+	}
+	select {
+	case <-ctx.Done():
+		return false
+	case <-pool.stopped:
+		return false
+	case pool.jobs <- job:
+		return true
+	}
+}
+
+// getWorkers returns the trigger worker pool, nil if the sensor doesn't limit the number of concurrent trigger executions.
+func (sensorCtx *SensorContext) getWorkers() *triggerWorkerPool {
+	sensorCtx.sensorLock.RLock()
+	defer sensorCtx.sensorLock.RUnlock()
+	return sensorCtx.workers
+}
+
+// setWorkers replaces the trigger worker pool and stops the previous one.
+// The jobs in flight on the previous pool run to completion.
+func (sensorCtx *SensorContext) setWorkers(workers *triggerWorkerPool) {
+	sensorCtx.sensorLock.Lock()
+	previous := sensorCtx.workers
+	sensorCtx.workers = workers
+	sensorCtx.sensorLock.Unlock()
+	if previous != nil {
+		previous.stop()
+	}
+}
+
+// dispatch runs the job on the trigger worker pool, or on a new goroutine if the sensor doesn't limit the
+// number of concurrent trigger executions.
+func (sensorCtx *SensorContext) dispatch(ctx context.Context, job func()) bool {
+	for {
+		workers := sensorCtx.getWorkers()
+		if workers == nil {
+			go job()
+			return true
+		}
+		if workers.submit(ctx, job) {
+			return true
+		}
+		if ctx.Err() != nil || sensorCtx.getWorkers() == workers {
+			return false
+		}
+		// The pool was replaced while the job was waiting for a worker, submit it to the new pool.
+	}
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensors
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTriggerWorkerPool(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sensorCtx := &SensorContext{
+		Sensor:  sensorObj.DeepCopy(),
+		workers: newTriggerWorkerPool(ctx, 2),
+	}
+
+	var inFlight, maxInFlight int32
+	started := make(chan struct{}, 6)
+	release := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(6)
+	go func() {
+		for i := 0; i < 6; i++ {
+			dispatched := sensorCtx.dispatch(ctx, func() {
+				defer wg.Done()
+				current := atomic.AddInt32(&inFlight, 1)
+				for {
+					max := atomic.LoadInt32(&maxInFlight)
+					if current <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, current) {
+						break
+					}
+				}
+				started <- struct{}{}
+				<-release
+				atomic.AddInt32(&inFlight, -1)
+			})
+			assert.True(t, dispatched)
+		}
+	}()
+	// Both workers are busy until the jobs are released.
+	<-started
+	<-started
+	assert.Equal(t, int32(2), atomic.LoadInt32(&inFlight))
+	close(release)
+	wg.Wait()
+	assert.Equal(t, int32(2), atomic.LoadInt32(&maxInFlight))
+
+	cancel()
+	assert.False(t, sensorCtx.dispatch(ctx, func() {}))
+}
+
+func TestDispatchWithoutLimit(t *testing.T) {
+	sensorCtx := &SensorContext{
+		Sensor: sensorObj.DeepCopy(),
+	}
+	done := make(chan struct{})
+	assert.True(t, sensorCtx.dispatch(context.Background(), func() { close(done) }))
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("job was not executed")
+	}
+}
@@ -3,6 +3,7 @@ package store
 import (
 	"crypto/tls"
 	"errors"
+	"fmt"
 	"io/ioutil"
 	"net/http"
 
@@ -41,7 +42,7 @@ func (reader *URLReader) Read() ([]byte, error) {
 
 	if resp.StatusCode != http.StatusOK {
 		log.Warnf("failed to read %s. status code: %d", reader.urlArtifact.Path, resp.StatusCode)
-		return nil, errors.New("status code " + string(resp.StatusCode))
+		return nil, fmt.Errorf("status code %d", resp.StatusCode)
 	}
 
 	content, err := ioutil.ReadAll(resp.Body)